@@ -0,0 +1,60 @@
+/*
+Copyright © 2024 hayashi kenta <k.hayashi@cresplanex.com>
+*/
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/ablankz/bloader/internal/report"
+)
+
+// reportFileName represents the default name of the report file in the output directory
+const reportFileName = "report.html"
+
+var reportOut string
+
+// reportCmd represents the report command
+var reportCmd = &cobra.Command{
+	Use:   "report <output-dir>",
+	Short: "Generate the HTML report of the outputs",
+	Long: `This command generates the HTML report of the outputs.
+It reads the csv, jsonl and parquet files written by the local output under the directory,
+with the summaries and the sources of the files, and renders a single static HTML file
+with the latency percentiles over time, the throughput, the status codes,
+the errors by terminate type, and the tabs of each flow and each slave.
+The databases of the sqlite output are not read.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// the usage is printed on the wrong arguments, not on the failure of the generation
+		cmd.SilenceUsage = true
+		out := reportOut
+		if out == "" {
+			out = filepath.Join(args[0], reportFileName)
+		}
+		var buf bytes.Buffer
+		if err := report.Generate(args[0], &buf); err != nil {
+			return fmt.Errorf("failed to generate the report: %w", err)
+		}
+		if err := os.MkdirAll(filepath.Dir(out), 0o755); err != nil {
+			return fmt.Errorf("failed to create the directory of the report: %w", err)
+		}
+		if err := os.WriteFile(out, buf.Bytes(), 0o644); err != nil {
+			return fmt.Errorf("failed to write the report: %w", err)
+		}
+		color.Green("Report generated successfully: %s", out)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(reportCmd)
+
+	reportCmd.Flags().StringVarP(&reportOut, "out", "o", "", "path of the report file (default is <output-dir>/report.html)")
+}
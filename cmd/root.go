@@ -213,6 +213,7 @@ func shouldSkipConfig() bool {
 		"completion": {},
 		"version":    {},
 		"help":       {},
+		"report":     {},
 	}
 
 	_, ok := commandsToSkip[os.Args[1]]
@@ -16,6 +16,7 @@ import (
 
 	"github.com/ablankz/bloader/internal/config"
 	"github.com/ablankz/bloader/internal/runner"
+	"github.com/ablankz/bloader/internal/slave"
 )
 
 var (
@@ -159,9 +160,13 @@ It sends requests to the specified server and measures the response time.`,
 			}
 		}
 
-		if err := runner.Run(ctr, runnerFile, data); err != nil {
+		if err := runner.Run(ctr, runnerFile, data, slave.NewInprocServe(ctr)); err != nil {
 			color.Red("Failed to run the load test: %v\n", err)
-			return
+			cancel()
+			if err := ctr.Close(); err != nil {
+				color.Red("Failed to close the container: %v\n", err)
+			}
+			os.Exit(1)
 		}
 	},
 }
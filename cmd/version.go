@@ -7,6 +7,8 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+
+	"github.com/ablankz/bloader/internal/runner"
 )
 
 var (
@@ -23,12 +25,15 @@ var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Displays the version",
 	Long: `This command displays the version of the application.
-It displays the version of the application and the version of the configuration.`,
+It displays the version of the application and the version of the configuration,
+and the protocol version checked between the master and the slaves.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Printf("Bloader Version: %s\nCommit: %s\nBuild Time: %s\n", Version, Commit, BuildTime)
+		fmt.Printf("Bloader Version: %s\nCommit: %s\nBuild Time: %s\nProtocol Version: %d\n",
+			Version, Commit, BuildTime, runner.ProtocolVersion)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(versionCmd)
+	runner.BuildVersion = Version
 }
@@ -0,0 +1,3760 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        (unknown)
+// source: cresplanex/bloader/v1/bloader.proto
+
+package bloaderv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SlaveCommandDefaultStoreType int32
+
+const (
+	SlaveCommandDefaultStoreType_SLAVE_COMMAND_DEFAULT_STORE_TYPE_UNSPECIFIED       SlaveCommandDefaultStoreType = 0
+	SlaveCommandDefaultStoreType_SLAVE_COMMAND_DEFAULT_STORE_TYPE_STORE             SlaveCommandDefaultStoreType = 1
+	SlaveCommandDefaultStoreType_SLAVE_COMMAND_DEFAULT_STORE_TYPE_THREAD_ONLY_STORE SlaveCommandDefaultStoreType = 2
+	SlaveCommandDefaultStoreType_SLAVE_COMMAND_DEFAULT_STORE_TYPE_SLAVE_VALUES      SlaveCommandDefaultStoreType = 3
+)
+
+// Enum value maps for SlaveCommandDefaultStoreType.
+var (
+	SlaveCommandDefaultStoreType_name = map[int32]string{
+		0: "SLAVE_COMMAND_DEFAULT_STORE_TYPE_UNSPECIFIED",
+		1: "SLAVE_COMMAND_DEFAULT_STORE_TYPE_STORE",
+		2: "SLAVE_COMMAND_DEFAULT_STORE_TYPE_THREAD_ONLY_STORE",
+		3: "SLAVE_COMMAND_DEFAULT_STORE_TYPE_SLAVE_VALUES",
+	}
+	SlaveCommandDefaultStoreType_value = map[string]int32{
+		"SLAVE_COMMAND_DEFAULT_STORE_TYPE_UNSPECIFIED":       0,
+		"SLAVE_COMMAND_DEFAULT_STORE_TYPE_STORE":             1,
+		"SLAVE_COMMAND_DEFAULT_STORE_TYPE_THREAD_ONLY_STORE": 2,
+		"SLAVE_COMMAND_DEFAULT_STORE_TYPE_SLAVE_VALUES":      3,
+	}
+)
+
+func (x SlaveCommandDefaultStoreType) Enum() *SlaveCommandDefaultStoreType {
+	p := new(SlaveCommandDefaultStoreType)
+	*p = x
+	return p
+}
+
+func (x SlaveCommandDefaultStoreType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SlaveCommandDefaultStoreType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cresplanex_bloader_v1_bloader_proto_enumTypes[0].Descriptor()
+}
+
+func (SlaveCommandDefaultStoreType) Type() protoreflect.EnumType {
+	return &file_cresplanex_bloader_v1_bloader_proto_enumTypes[0]
+}
+
+func (x SlaveCommandDefaultStoreType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SlaveCommandDefaultStoreType.Descriptor instead.
+func (SlaveCommandDefaultStoreType) EnumDescriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{0}
+}
+
+type CallExecOutputType int32
+
+const (
+	CallExecOutputType_CALL_EXEC_OUTPUT_TYPE_UNSPECIFIED CallExecOutputType = 0
+	CallExecOutputType_CALL_EXEC_OUTPUT_TYPE_HTTP        CallExecOutputType = 1
+	CallExecOutputType_CALL_EXEC_OUTPUT_TYPE_SUMMARY     CallExecOutputType = 2
+)
+
+// Enum value maps for CallExecOutputType.
+var (
+	CallExecOutputType_name = map[int32]string{
+		0: "CALL_EXEC_OUTPUT_TYPE_UNSPECIFIED",
+		1: "CALL_EXEC_OUTPUT_TYPE_HTTP",
+		2: "CALL_EXEC_OUTPUT_TYPE_SUMMARY",
+	}
+	CallExecOutputType_value = map[string]int32{
+		"CALL_EXEC_OUTPUT_TYPE_UNSPECIFIED": 0,
+		"CALL_EXEC_OUTPUT_TYPE_HTTP":        1,
+		"CALL_EXEC_OUTPUT_TYPE_SUMMARY":     2,
+	}
+)
+
+func (x CallExecOutputType) Enum() *CallExecOutputType {
+	p := new(CallExecOutputType)
+	*p = x
+	return p
+}
+
+func (x CallExecOutputType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CallExecOutputType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cresplanex_bloader_v1_bloader_proto_enumTypes[1].Descriptor()
+}
+
+func (CallExecOutputType) Type() protoreflect.EnumType {
+	return &file_cresplanex_bloader_v1_bloader_proto_enumTypes[1]
+}
+
+func (x CallExecOutputType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CallExecOutputType.Descriptor instead.
+func (CallExecOutputType) EnumDescriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{1}
+}
+
+type RequestType int32
+
+const (
+	RequestType_REQUEST_TYPE_UNSPECIFIED             RequestType = 0
+	RequestType_REQUEST_TYPE_REQUEST_RESOURCE_LOADER RequestType = 1
+	RequestType_REQUEST_TYPE_REQUEST_RESOURCE_AUTH   RequestType = 2
+	RequestType_REQUEST_TYPE_STORE                   RequestType = 3
+	RequestType_REQUEST_TYPE_REQUEST_RESOURCE_STORE  RequestType = 4
+	RequestType_REQUEST_TYPE_REQUEST_RESOURCE_TARGET RequestType = 5
+)
+
+// Enum value maps for RequestType.
+var (
+	RequestType_name = map[int32]string{
+		0: "REQUEST_TYPE_UNSPECIFIED",
+		1: "REQUEST_TYPE_REQUEST_RESOURCE_LOADER",
+		2: "REQUEST_TYPE_REQUEST_RESOURCE_AUTH",
+		3: "REQUEST_TYPE_STORE",
+		4: "REQUEST_TYPE_REQUEST_RESOURCE_STORE",
+		5: "REQUEST_TYPE_REQUEST_RESOURCE_TARGET",
+	}
+	RequestType_value = map[string]int32{
+		"REQUEST_TYPE_UNSPECIFIED":             0,
+		"REQUEST_TYPE_REQUEST_RESOURCE_LOADER": 1,
+		"REQUEST_TYPE_REQUEST_RESOURCE_AUTH":   2,
+		"REQUEST_TYPE_STORE":                   3,
+		"REQUEST_TYPE_REQUEST_RESOURCE_STORE":  4,
+		"REQUEST_TYPE_REQUEST_RESOURCE_TARGET": 5,
+	}
+)
+
+func (x RequestType) Enum() *RequestType {
+	p := new(RequestType)
+	*p = x
+	return p
+}
+
+func (x RequestType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RequestType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cresplanex_bloader_v1_bloader_proto_enumTypes[2].Descriptor()
+}
+
+func (RequestType) Type() protoreflect.EnumType {
+	return &file_cresplanex_bloader_v1_bloader_proto_enumTypes[2]
+}
+
+func (x RequestType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RequestType.Descriptor instead.
+func (RequestType) EnumDescriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{2}
+}
+
+type CommandTermReason int32
+
+const (
+	CommandTermReason_COMMAND_TERM_REASON_UNSPECIFIED CommandTermReason = 0
+	CommandTermReason_COMMAND_TERM_REASON_COMPLETED   CommandTermReason = 1
+	CommandTermReason_COMMAND_TERM_REASON_FAILED      CommandTermReason = 2
+	CommandTermReason_COMMAND_TERM_REASON_CANCELED    CommandTermReason = 3
+	CommandTermReason_COMMAND_TERM_REASON_FORCED      CommandTermReason = 4
+)
+
+// Enum value maps for CommandTermReason.
+var (
+	CommandTermReason_name = map[int32]string{
+		0: "COMMAND_TERM_REASON_UNSPECIFIED",
+		1: "COMMAND_TERM_REASON_COMPLETED",
+		2: "COMMAND_TERM_REASON_FAILED",
+		3: "COMMAND_TERM_REASON_CANCELED",
+		4: "COMMAND_TERM_REASON_FORCED",
+	}
+	CommandTermReason_value = map[string]int32{
+		"COMMAND_TERM_REASON_UNSPECIFIED": 0,
+		"COMMAND_TERM_REASON_COMPLETED":   1,
+		"COMMAND_TERM_REASON_FAILED":      2,
+		"COMMAND_TERM_REASON_CANCELED":    3,
+		"COMMAND_TERM_REASON_FORCED":      4,
+	}
+)
+
+func (x CommandTermReason) Enum() *CommandTermReason {
+	p := new(CommandTermReason)
+	*p = x
+	return p
+}
+
+func (x CommandTermReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommandTermReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_cresplanex_bloader_v1_bloader_proto_enumTypes[3].Descriptor()
+}
+
+func (CommandTermReason) Type() protoreflect.EnumType {
+	return &file_cresplanex_bloader_v1_bloader_proto_enumTypes[3]
+}
+
+func (x CommandTermReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommandTermReason.Descriptor instead.
+func (CommandTermReason) EnumDescriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{3}
+}
+
+type Capabilities struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BuildVersion    string                 `protobuf:"bytes,1,opt,name=build_version,json=buildVersion,proto3" json:"build_version,omitempty"`
+	ProtocolVersion int32                  `protobuf:"varint,2,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	RunnerKinds     []string               `protobuf:"bytes,3,rep,name=runner_kinds,json=runnerKinds,proto3" json:"runner_kinds,omitempty"`
+	ExecTypes       []string               `protobuf:"bytes,4,rep,name=exec_types,json=execTypes,proto3" json:"exec_types,omitempty"`
+	Features        []string               `protobuf:"bytes,5,rep,name=features,proto3" json:"features,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Capabilities) Reset() {
+	*x = Capabilities{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Capabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Capabilities) ProtoMessage() {}
+
+func (x *Capabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Capabilities.ProtoReflect.Descriptor instead.
+func (*Capabilities) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Capabilities) GetBuildVersion() string {
+	if x != nil {
+		return x.BuildVersion
+	}
+	return ""
+}
+
+func (x *Capabilities) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *Capabilities) GetRunnerKinds() []string {
+	if x != nil {
+		return x.RunnerKinds
+	}
+	return nil
+}
+
+func (x *Capabilities) GetExecTypes() []string {
+	if x != nil {
+		return x.ExecTypes
+	}
+	return nil
+}
+
+func (x *Capabilities) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type ConnectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Environment   string                 `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	Capabilities  *Capabilities          `protobuf:"bytes,2,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectRequest) Reset() {
+	*x = ConnectRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectRequest) ProtoMessage() {}
+
+func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectRequest.ProtoReflect.Descriptor instead.
+func (*ConnectRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConnectRequest) GetEnvironment() string {
+	if x != nil {
+		return x.Environment
+	}
+	return ""
+}
+
+func (x *ConnectRequest) GetCapabilities() *Capabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type ConnectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId  string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Capabilities  *Capabilities          `protobuf:"bytes,2,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Capacity      int32                  `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectResponse) Reset() {
+	*x = ConnectResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectResponse) ProtoMessage() {}
+
+func (x *ConnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectResponse.ProtoReflect.Descriptor instead.
+func (*ConnectResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConnectResponse) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *ConnectResponse) GetCapabilities() *Capabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *ConnectResponse) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+type DisconnectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId  string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectRequest) Reset() {
+	*x = DisconnectRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectRequest) ProtoMessage() {}
+
+func (x *DisconnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DisconnectRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+type DisconnectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectResponse) Reset() {
+	*x = DisconnectResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectResponse) ProtoMessage() {}
+
+func (x *DisconnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{4}
+}
+
+type SlaveCommandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId  string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	LoaderId      string                 `protobuf:"bytes,2,opt,name=loader_id,json=loaderId,proto3" json:"loader_id,omitempty"`
+	OutputRoot    string                 `protobuf:"bytes,3,opt,name=output_root,json=outputRoot,proto3" json:"output_root,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlaveCommandRequest) Reset() {
+	*x = SlaveCommandRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlaveCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlaveCommandRequest) ProtoMessage() {}
+
+func (x *SlaveCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlaveCommandRequest.ProtoReflect.Descriptor instead.
+func (*SlaveCommandRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SlaveCommandRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *SlaveCommandRequest) GetLoaderId() string {
+	if x != nil {
+		return x.LoaderId
+	}
+	return ""
+}
+
+func (x *SlaveCommandRequest) GetOutputRoot() string {
+	if x != nil {
+		return x.OutputRoot
+	}
+	return ""
+}
+
+type SlaveCommandResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommandId     string                 `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlaveCommandResponse) Reset() {
+	*x = SlaveCommandResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlaveCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlaveCommandResponse) ProtoMessage() {}
+
+func (x *SlaveCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlaveCommandResponse.ProtoReflect.Descriptor instead.
+func (*SlaveCommandResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SlaveCommandResponse) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+type SlaveCommandDefaultStoreRequest struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	ConnectionId  string                       `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	CommandId     string                       `protobuf:"bytes,2,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	StoreType     SlaveCommandDefaultStoreType `protobuf:"varint,3,opt,name=store_type,json=storeType,proto3,enum=cresplanex.bloader.v1.SlaveCommandDefaultStoreType" json:"store_type,omitempty"`
+	DefaultStore  []byte                       `protobuf:"bytes,4,opt,name=default_store,json=defaultStore,proto3" json:"default_store,omitempty"`
+	IsLastChunk   bool                         `protobuf:"varint,5,opt,name=is_last_chunk,json=isLastChunk,proto3" json:"is_last_chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlaveCommandDefaultStoreRequest) Reset() {
+	*x = SlaveCommandDefaultStoreRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlaveCommandDefaultStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlaveCommandDefaultStoreRequest) ProtoMessage() {}
+
+func (x *SlaveCommandDefaultStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlaveCommandDefaultStoreRequest.ProtoReflect.Descriptor instead.
+func (*SlaveCommandDefaultStoreRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SlaveCommandDefaultStoreRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *SlaveCommandDefaultStoreRequest) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+func (x *SlaveCommandDefaultStoreRequest) GetStoreType() SlaveCommandDefaultStoreType {
+	if x != nil {
+		return x.StoreType
+	}
+	return SlaveCommandDefaultStoreType_SLAVE_COMMAND_DEFAULT_STORE_TYPE_UNSPECIFIED
+}
+
+func (x *SlaveCommandDefaultStoreRequest) GetDefaultStore() []byte {
+	if x != nil {
+		return x.DefaultStore
+	}
+	return nil
+}
+
+func (x *SlaveCommandDefaultStoreRequest) GetIsLastChunk() bool {
+	if x != nil {
+		return x.IsLastChunk
+	}
+	return false
+}
+
+type SlaveCommandDefaultStoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlaveCommandDefaultStoreResponse) Reset() {
+	*x = SlaveCommandDefaultStoreResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlaveCommandDefaultStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlaveCommandDefaultStoreResponse) ProtoMessage() {}
+
+func (x *SlaveCommandDefaultStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlaveCommandDefaultStoreResponse.ProtoReflect.Descriptor instead.
+func (*SlaveCommandDefaultStoreResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{8}
+}
+
+type CallExecRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId    string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	CommandId       string                 `protobuf:"bytes,2,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	StartAtUnixNano int64                  `protobuf:"varint,3,opt,name=start_at_unix_nano,json=startAtUnixNano,proto3" json:"start_at_unix_nano,omitempty"`
+	FlowId          string                 `protobuf:"bytes,4,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CallExecRequest) Reset() {
+	*x = CallExecRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallExecRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallExecRequest) ProtoMessage() {}
+
+func (x *CallExecRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallExecRequest.ProtoReflect.Descriptor instead.
+func (*CallExecRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CallExecRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *CallExecRequest) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+func (x *CallExecRequest) GetStartAtUnixNano() int64 {
+	if x != nil {
+		return x.StartAtUnixNano
+	}
+	return 0
+}
+
+func (x *CallExecRequest) GetFlowId() string {
+	if x != nil {
+		return x.FlowId
+	}
+	return ""
+}
+
+type CallExecResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	OutputId   string                 `protobuf:"bytes,1,opt,name=output_id,json=outputId,proto3" json:"output_id,omitempty"`
+	OutputType CallExecOutputType     `protobuf:"varint,2,opt,name=output_type,json=outputType,proto3,enum=cresplanex.bloader.v1.CallExecOutputType" json:"output_type,omitempty"`
+	OutputRoot string                 `protobuf:"bytes,3,opt,name=output_root,json=outputRoot,proto3" json:"output_root,omitempty"`
+	// Types that are valid to be assigned to Output:
+	//
+	//	*CallExecResponse_OutputHttp
+	//	*CallExecResponse_OutputSummary
+	Output        isCallExecResponse_Output `protobuf_oneof:"output"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallExecResponse) Reset() {
+	*x = CallExecResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallExecResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallExecResponse) ProtoMessage() {}
+
+func (x *CallExecResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallExecResponse.ProtoReflect.Descriptor instead.
+func (*CallExecResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CallExecResponse) GetOutputId() string {
+	if x != nil {
+		return x.OutputId
+	}
+	return ""
+}
+
+func (x *CallExecResponse) GetOutputType() CallExecOutputType {
+	if x != nil {
+		return x.OutputType
+	}
+	return CallExecOutputType_CALL_EXEC_OUTPUT_TYPE_UNSPECIFIED
+}
+
+func (x *CallExecResponse) GetOutputRoot() string {
+	if x != nil {
+		return x.OutputRoot
+	}
+	return ""
+}
+
+func (x *CallExecResponse) GetOutput() isCallExecResponse_Output {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *CallExecResponse) GetOutputHttp() *CallExecOutputHTTP {
+	if x != nil {
+		if x, ok := x.Output.(*CallExecResponse_OutputHttp); ok {
+			return x.OutputHttp
+		}
+	}
+	return nil
+}
+
+func (x *CallExecResponse) GetOutputSummary() *CallExecOutputSummary {
+	if x != nil {
+		if x, ok := x.Output.(*CallExecResponse_OutputSummary); ok {
+			return x.OutputSummary
+		}
+	}
+	return nil
+}
+
+type isCallExecResponse_Output interface {
+	isCallExecResponse_Output()
+}
+
+type CallExecResponse_OutputHttp struct {
+	OutputHttp *CallExecOutputHTTP `protobuf:"bytes,4,opt,name=output_http,json=outputHttp,proto3,oneof"`
+}
+
+type CallExecResponse_OutputSummary struct {
+	OutputSummary *CallExecOutputSummary `protobuf:"bytes,5,opt,name=output_summary,json=outputSummary,proto3,oneof"`
+}
+
+func (*CallExecResponse_OutputHttp) isCallExecResponse_Output() {}
+
+func (*CallExecResponse_OutputSummary) isCallExecResponse_Output() {}
+
+type CallExecOutputHTTP struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []string               `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Values        []*CallExecOutputValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Source        *CallExecOutputSource  `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallExecOutputHTTP) Reset() {
+	*x = CallExecOutputHTTP{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallExecOutputHTTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallExecOutputHTTP) ProtoMessage() {}
+
+func (x *CallExecOutputHTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallExecOutputHTTP.ProtoReflect.Descriptor instead.
+func (*CallExecOutputHTTP) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CallExecOutputHTTP) GetData() []string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *CallExecOutputHTTP) GetValues() []*CallExecOutputValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *CallExecOutputHTTP) GetSource() *CallExecOutputSource {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+type CallExecOutputSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FlowId        string                 `protobuf:"bytes,1,opt,name=flow_id,json=flowId,proto3" json:"flow_id,omitempty"`
+	Loader        string                 `protobuf:"bytes,2,opt,name=loader,proto3" json:"loader,omitempty"`
+	RequestIndex  int64                  `protobuf:"varint,3,opt,name=request_index,json=requestIndex,proto3" json:"request_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallExecOutputSource) Reset() {
+	*x = CallExecOutputSource{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallExecOutputSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallExecOutputSource) ProtoMessage() {}
+
+func (x *CallExecOutputSource) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallExecOutputSource.ProtoReflect.Descriptor instead.
+func (*CallExecOutputSource) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CallExecOutputSource) GetFlowId() string {
+	if x != nil {
+		return x.FlowId
+	}
+	return ""
+}
+
+func (x *CallExecOutputSource) GetLoader() string {
+	if x != nil {
+		return x.Loader
+	}
+	return ""
+}
+
+func (x *CallExecOutputSource) GetRequestIndex() int64 {
+	if x != nil {
+		return x.RequestIndex
+	}
+	return 0
+}
+
+type CallExecOutputValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*CallExecOutputValue_StringValue
+	//	*CallExecOutputValue_BoolValue
+	//	*CallExecOutputValue_IntValue
+	//	*CallExecOutputValue_DoubleValue
+	//	*CallExecOutputValue_JsonValue
+	Value         isCallExecOutputValue_Value `protobuf_oneof:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallExecOutputValue) Reset() {
+	*x = CallExecOutputValue{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallExecOutputValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallExecOutputValue) ProtoMessage() {}
+
+func (x *CallExecOutputValue) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallExecOutputValue.ProtoReflect.Descriptor instead.
+func (*CallExecOutputValue) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CallExecOutputValue) GetValue() isCallExecOutputValue_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *CallExecOutputValue) GetStringValue() string {
+	if x != nil {
+		if x, ok := x.Value.(*CallExecOutputValue_StringValue); ok {
+			return x.StringValue
+		}
+	}
+	return ""
+}
+
+func (x *CallExecOutputValue) GetBoolValue() bool {
+	if x != nil {
+		if x, ok := x.Value.(*CallExecOutputValue_BoolValue); ok {
+			return x.BoolValue
+		}
+	}
+	return false
+}
+
+func (x *CallExecOutputValue) GetIntValue() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*CallExecOutputValue_IntValue); ok {
+			return x.IntValue
+		}
+	}
+	return 0
+}
+
+func (x *CallExecOutputValue) GetDoubleValue() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*CallExecOutputValue_DoubleValue); ok {
+			return x.DoubleValue
+		}
+	}
+	return 0
+}
+
+func (x *CallExecOutputValue) GetJsonValue() string {
+	if x != nil {
+		if x, ok := x.Value.(*CallExecOutputValue_JsonValue); ok {
+			return x.JsonValue
+		}
+	}
+	return ""
+}
+
+type isCallExecOutputValue_Value interface {
+	isCallExecOutputValue_Value()
+}
+
+type CallExecOutputValue_StringValue struct {
+	StringValue string `protobuf:"bytes,1,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type CallExecOutputValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,2,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type CallExecOutputValue_IntValue struct {
+	IntValue int64 `protobuf:"varint,3,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type CallExecOutputValue_DoubleValue struct {
+	DoubleValue float64 `protobuf:"fixed64,4,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
+type CallExecOutputValue_JsonValue struct {
+	JsonValue string `protobuf:"bytes,5,opt,name=json_value,json=jsonValue,proto3,oneof"`
+}
+
+func (*CallExecOutputValue_StringValue) isCallExecOutputValue_Value() {}
+
+func (*CallExecOutputValue_BoolValue) isCallExecOutputValue_Value() {}
+
+func (*CallExecOutputValue_IntValue) isCallExecOutputValue_Value() {}
+
+func (*CallExecOutputValue_DoubleValue) isCallExecOutputValue_Value() {}
+
+func (*CallExecOutputValue_JsonValue) isCallExecOutputValue_Value() {}
+
+type CallExecOutputSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CallExecOutputSummary) Reset() {
+	*x = CallExecOutputSummary{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CallExecOutputSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallExecOutputSummary) ProtoMessage() {}
+
+func (x *CallExecOutputSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallExecOutputSummary.ProtoReflect.Descriptor instead.
+func (*CallExecOutputSummary) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CallExecOutputSummary) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ReceiveChanelConnectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId  string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveChanelConnectRequest) Reset() {
+	*x = ReceiveChanelConnectRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveChanelConnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveChanelConnectRequest) ProtoMessage() {}
+
+func (x *ReceiveChanelConnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveChanelConnectRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveChanelConnectRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReceiveChanelConnectRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+type ReceiveChanelConnectResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	RequestId   string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	RequestType RequestType            `protobuf:"varint,2,opt,name=request_type,json=requestType,proto3,enum=cresplanex.bloader.v1.RequestType" json:"request_type,omitempty"`
+	// Types that are valid to be assigned to Request:
+	//
+	//	*ReceiveChanelConnectResponse_LoaderResourceRequest
+	//	*ReceiveChanelConnectResponse_AuthResourceRequest
+	//	*ReceiveChanelConnectResponse_Store
+	//	*ReceiveChanelConnectResponse_StoreResourceRequest
+	//	*ReceiveChanelConnectResponse_TargetResourceRequest
+	Request       isReceiveChanelConnectResponse_Request `protobuf_oneof:"request"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveChanelConnectResponse) Reset() {
+	*x = ReceiveChanelConnectResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveChanelConnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveChanelConnectResponse) ProtoMessage() {}
+
+func (x *ReceiveChanelConnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveChanelConnectResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveChanelConnectResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReceiveChanelConnectResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ReceiveChanelConnectResponse) GetRequestType() RequestType {
+	if x != nil {
+		return x.RequestType
+	}
+	return RequestType_REQUEST_TYPE_UNSPECIFIED
+}
+
+func (x *ReceiveChanelConnectResponse) GetRequest() isReceiveChanelConnectResponse_Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ReceiveChanelConnectResponse) GetLoaderResourceRequest() *ReceiveChanelConnectLoaderResourceRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReceiveChanelConnectResponse_LoaderResourceRequest); ok {
+			return x.LoaderResourceRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReceiveChanelConnectResponse) GetAuthResourceRequest() *ReceiveChanelConnectAuthResourceRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReceiveChanelConnectResponse_AuthResourceRequest); ok {
+			return x.AuthResourceRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReceiveChanelConnectResponse) GetStore() *ReceiveChanelConnectStore {
+	if x != nil {
+		if x, ok := x.Request.(*ReceiveChanelConnectResponse_Store); ok {
+			return x.Store
+		}
+	}
+	return nil
+}
+
+func (x *ReceiveChanelConnectResponse) GetStoreResourceRequest() *ReceiveChanelConnectStoreResourceRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReceiveChanelConnectResponse_StoreResourceRequest); ok {
+			return x.StoreResourceRequest
+		}
+	}
+	return nil
+}
+
+func (x *ReceiveChanelConnectResponse) GetTargetResourceRequest() *ReceiveChanelConnectTargetResourceRequest {
+	if x != nil {
+		if x, ok := x.Request.(*ReceiveChanelConnectResponse_TargetResourceRequest); ok {
+			return x.TargetResourceRequest
+		}
+	}
+	return nil
+}
+
+type isReceiveChanelConnectResponse_Request interface {
+	isReceiveChanelConnectResponse_Request()
+}
+
+type ReceiveChanelConnectResponse_LoaderResourceRequest struct {
+	LoaderResourceRequest *ReceiveChanelConnectLoaderResourceRequest `protobuf:"bytes,3,opt,name=loader_resource_request,json=loaderResourceRequest,proto3,oneof"`
+}
+
+type ReceiveChanelConnectResponse_AuthResourceRequest struct {
+	AuthResourceRequest *ReceiveChanelConnectAuthResourceRequest `protobuf:"bytes,4,opt,name=auth_resource_request,json=authResourceRequest,proto3,oneof"`
+}
+
+type ReceiveChanelConnectResponse_Store struct {
+	Store *ReceiveChanelConnectStore `protobuf:"bytes,5,opt,name=store,proto3,oneof"`
+}
+
+type ReceiveChanelConnectResponse_StoreResourceRequest struct {
+	StoreResourceRequest *ReceiveChanelConnectStoreResourceRequest `protobuf:"bytes,6,opt,name=store_resource_request,json=storeResourceRequest,proto3,oneof"`
+}
+
+type ReceiveChanelConnectResponse_TargetResourceRequest struct {
+	TargetResourceRequest *ReceiveChanelConnectTargetResourceRequest `protobuf:"bytes,7,opt,name=target_resource_request,json=targetResourceRequest,proto3,oneof"`
+}
+
+func (*ReceiveChanelConnectResponse_LoaderResourceRequest) isReceiveChanelConnectResponse_Request() {}
+
+func (*ReceiveChanelConnectResponse_AuthResourceRequest) isReceiveChanelConnectResponse_Request() {}
+
+func (*ReceiveChanelConnectResponse_Store) isReceiveChanelConnectResponse_Request() {}
+
+func (*ReceiveChanelConnectResponse_StoreResourceRequest) isReceiveChanelConnectResponse_Request() {}
+
+func (*ReceiveChanelConnectResponse_TargetResourceRequest) isReceiveChanelConnectResponse_Request() {}
+
+type ReceiveChanelConnectLoaderResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LoaderId      string                 `protobuf:"bytes,1,opt,name=loader_id,json=loaderId,proto3" json:"loader_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveChanelConnectLoaderResourceRequest) Reset() {
+	*x = ReceiveChanelConnectLoaderResourceRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveChanelConnectLoaderResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveChanelConnectLoaderResourceRequest) ProtoMessage() {}
+
+func (x *ReceiveChanelConnectLoaderResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveChanelConnectLoaderResourceRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveChanelConnectLoaderResourceRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReceiveChanelConnectLoaderResourceRequest) GetLoaderId() string {
+	if x != nil {
+		return x.LoaderId
+	}
+	return ""
+}
+
+type ReceiveChanelConnectAuthResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthId        string                 `protobuf:"bytes,1,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveChanelConnectAuthResourceRequest) Reset() {
+	*x = ReceiveChanelConnectAuthResourceRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveChanelConnectAuthResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveChanelConnectAuthResourceRequest) ProtoMessage() {}
+
+func (x *ReceiveChanelConnectAuthResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveChanelConnectAuthResourceRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveChanelConnectAuthResourceRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReceiveChanelConnectAuthResourceRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *ReceiveChanelConnectAuthResourceRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type ReceiveChanelConnectStore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	IsLastChunk   bool                   `protobuf:"varint,3,opt,name=is_last_chunk,json=isLastChunk,proto3" json:"is_last_chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveChanelConnectStore) Reset() {
+	*x = ReceiveChanelConnectStore{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveChanelConnectStore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveChanelConnectStore) ProtoMessage() {}
+
+func (x *ReceiveChanelConnectStore) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveChanelConnectStore.ProtoReflect.Descriptor instead.
+func (*ReceiveChanelConnectStore) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReceiveChanelConnectStore) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ReceiveChanelConnectStore) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ReceiveChanelConnectStore) GetIsLastChunk() bool {
+	if x != nil {
+		return x.IsLastChunk
+	}
+	return false
+}
+
+type ReceiveChanelConnectStoreResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uid           string                 `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	IsLastChunk   bool                   `protobuf:"varint,3,opt,name=is_last_chunk,json=isLastChunk,proto3" json:"is_last_chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveChanelConnectStoreResourceRequest) Reset() {
+	*x = ReceiveChanelConnectStoreResourceRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveChanelConnectStoreResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveChanelConnectStoreResourceRequest) ProtoMessage() {}
+
+func (x *ReceiveChanelConnectStoreResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveChanelConnectStoreResourceRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveChanelConnectStoreResourceRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReceiveChanelConnectStoreResourceRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ReceiveChanelConnectStoreResourceRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ReceiveChanelConnectStoreResourceRequest) GetIsLastChunk() bool {
+	if x != nil {
+		return x.IsLastChunk
+	}
+	return false
+}
+
+type ReceiveChanelConnectTargetResourceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveChanelConnectTargetResourceRequest) Reset() {
+	*x = ReceiveChanelConnectTargetResourceRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveChanelConnectTargetResourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveChanelConnectTargetResourceRequest) ProtoMessage() {}
+
+func (x *ReceiveChanelConnectTargetResourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveChanelConnectTargetResourceRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveChanelConnectTargetResourceRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReceiveChanelConnectTargetResourceRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type SendLoaderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	LoaderId      string                 `protobuf:"bytes,2,opt,name=loader_id,json=loaderId,proto3" json:"loader_id,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	IsLastChunk   bool                   `protobuf:"varint,4,opt,name=is_last_chunk,json=isLastChunk,proto3" json:"is_last_chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendLoaderRequest) Reset() {
+	*x = SendLoaderRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendLoaderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendLoaderRequest) ProtoMessage() {}
+
+func (x *SendLoaderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendLoaderRequest.ProtoReflect.Descriptor instead.
+func (*SendLoaderRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SendLoaderRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SendLoaderRequest) GetLoaderId() string {
+	if x != nil {
+		return x.LoaderId
+	}
+	return ""
+}
+
+func (x *SendLoaderRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *SendLoaderRequest) GetIsLastChunk() bool {
+	if x != nil {
+		return x.IsLastChunk
+	}
+	return false
+}
+
+type SendLoaderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendLoaderResponse) Reset() {
+	*x = SendLoaderResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendLoaderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendLoaderResponse) ProtoMessage() {}
+
+func (x *SendLoaderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendLoaderResponse.ProtoReflect.Descriptor instead.
+func (*SendLoaderResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{23}
+}
+
+type SendAuthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	AuthId        string                 `protobuf:"bytes,2,opt,name=auth_id,json=authId,proto3" json:"auth_id,omitempty"`
+	Auth          *Auth                  `protobuf:"bytes,3,opt,name=auth,proto3" json:"auth,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,4,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendAuthRequest) Reset() {
+	*x = SendAuthRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendAuthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAuthRequest) ProtoMessage() {}
+
+func (x *SendAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAuthRequest.ProtoReflect.Descriptor instead.
+func (*SendAuthRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SendAuthRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SendAuthRequest) GetAuthId() string {
+	if x != nil {
+		return x.AuthId
+	}
+	return ""
+}
+
+func (x *SendAuthRequest) GetAuth() *Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *SendAuthRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type SendAuthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendAuthResponse) Reset() {
+	*x = SendAuthResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendAuthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAuthResponse) ProtoMessage() {}
+
+func (x *SendAuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAuthResponse.ProtoReflect.Descriptor instead.
+func (*SendAuthResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{25}
+}
+
+type SendStoreDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	IsLastChunk   bool                   `protobuf:"varint,3,opt,name=is_last_chunk,json=isLastChunk,proto3" json:"is_last_chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendStoreDataRequest) Reset() {
+	*x = SendStoreDataRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendStoreDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendStoreDataRequest) ProtoMessage() {}
+
+func (x *SendStoreDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendStoreDataRequest.ProtoReflect.Descriptor instead.
+func (*SendStoreDataRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SendStoreDataRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SendStoreDataRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SendStoreDataRequest) GetIsLastChunk() bool {
+	if x != nil {
+		return x.IsLastChunk
+	}
+	return false
+}
+
+type SendStoreDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendStoreDataResponse) Reset() {
+	*x = SendStoreDataResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendStoreDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendStoreDataResponse) ProtoMessage() {}
+
+func (x *SendStoreDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendStoreDataResponse.ProtoReflect.Descriptor instead.
+func (*SendStoreDataResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{27}
+}
+
+type SendStoreOkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendStoreOkRequest) Reset() {
+	*x = SendStoreOkRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendStoreOkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendStoreOkRequest) ProtoMessage() {}
+
+func (x *SendStoreOkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendStoreOkRequest.ProtoReflect.Descriptor instead.
+func (*SendStoreOkRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SendStoreOkRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type SendStoreOkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendStoreOkResponse) Reset() {
+	*x = SendStoreOkResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendStoreOkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendStoreOkResponse) ProtoMessage() {}
+
+func (x *SendStoreOkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendStoreOkResponse.ProtoReflect.Descriptor instead.
+func (*SendStoreOkResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{29}
+}
+
+type SendTargetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Target        *Target                `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTargetRequest) Reset() {
+	*x = SendTargetRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTargetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTargetRequest) ProtoMessage() {}
+
+func (x *SendTargetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTargetRequest.ProtoReflect.Descriptor instead.
+func (*SendTargetRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SendTargetRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SendTargetRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *SendTargetRequest) GetTarget() *Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+type SendTargetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendTargetResponse) Reset() {
+	*x = SendTargetResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendTargetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTargetResponse) ProtoMessage() {}
+
+func (x *SendTargetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTargetResponse.ProtoReflect.Descriptor instead.
+func (*SendTargetResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{31}
+}
+
+type ReceiveLoadTermChannelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId  string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	CommandId     string                 `protobuf:"bytes,2,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveLoadTermChannelRequest) Reset() {
+	*x = ReceiveLoadTermChannelRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveLoadTermChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveLoadTermChannelRequest) ProtoMessage() {}
+
+func (x *ReceiveLoadTermChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveLoadTermChannelRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveLoadTermChannelRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReceiveLoadTermChannelRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *ReceiveLoadTermChannelRequest) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+type ReceiveLoadTermChannelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Reason        CommandTermReason      `protobuf:"varint,2,opt,name=reason,proto3,enum=cresplanex.bloader.v1.CommandTermReason" json:"reason,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Stats         []byte                 `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveLoadTermChannelResponse) Reset() {
+	*x = ReceiveLoadTermChannelResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveLoadTermChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveLoadTermChannelResponse) ProtoMessage() {}
+
+func (x *ReceiveLoadTermChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveLoadTermChannelResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveLoadTermChannelResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReceiveLoadTermChannelResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReceiveLoadTermChannelResponse) GetReason() CommandTermReason {
+	if x != nil {
+		return x.Reason
+	}
+	return CommandTermReason_COMMAND_TERM_REASON_UNSPECIFIED
+}
+
+func (x *ReceiveLoadTermChannelResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReceiveLoadTermChannelResponse) GetStats() []byte {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type CancelCommandRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId  string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	CommandId     string                 `protobuf:"bytes,2,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	GracePeriodMs int64                  `protobuf:"varint,4,opt,name=grace_period_ms,json=gracePeriodMs,proto3" json:"grace_period_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCommandRequest) Reset() {
+	*x = CancelCommandRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCommandRequest) ProtoMessage() {}
+
+func (x *CancelCommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCommandRequest.ProtoReflect.Descriptor instead.
+func (*CancelCommandRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CancelCommandRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *CancelCommandRequest) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+func (x *CancelCommandRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CancelCommandRequest) GetGracePeriodMs() int64 {
+	if x != nil {
+		return x.GracePeriodMs
+	}
+	return 0
+}
+
+type CancelCommandResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        CommandTermReason      `protobuf:"varint,1,opt,name=reason,proto3,enum=cresplanex.bloader.v1.CommandTermReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelCommandResponse) Reset() {
+	*x = CancelCommandResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelCommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCommandResponse) ProtoMessage() {}
+
+func (x *CancelCommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCommandResponse.ProtoReflect.Descriptor instead.
+func (*CancelCommandResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CancelCommandResponse) GetReason() CommandTermReason {
+	if x != nil {
+		return x.Reason
+	}
+	return CommandTermReason_COMMAND_TERM_REASON_UNSPECIFIED
+}
+
+type HeartbeatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId  string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatRequest) Reset() {
+	*x = HeartbeatRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatRequest) ProtoMessage() {}
+
+func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *HeartbeatRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+type HeartbeatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HeartbeatResponse) Reset() {
+	*x = HeartbeatResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeartbeatResponse) ProtoMessage() {}
+
+func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{37}
+}
+
+type SyncClockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId  string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncClockRequest) Reset() {
+	*x = SyncClockRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncClockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncClockRequest) ProtoMessage() {}
+
+func (x *SyncClockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncClockRequest.ProtoReflect.Descriptor instead.
+func (*SyncClockRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SyncClockRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+type SyncClockResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ReceiveTimeUnixNano int64                  `protobuf:"varint,1,opt,name=receive_time_unix_nano,json=receiveTimeUnixNano,proto3" json:"receive_time_unix_nano,omitempty"`
+	SendTimeUnixNano    int64                  `protobuf:"varint,2,opt,name=send_time_unix_nano,json=sendTimeUnixNano,proto3" json:"send_time_unix_nano,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SyncClockResponse) Reset() {
+	*x = SyncClockResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncClockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncClockResponse) ProtoMessage() {}
+
+func (x *SyncClockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncClockResponse.ProtoReflect.Descriptor instead.
+func (*SyncClockResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SyncClockResponse) GetReceiveTimeUnixNano() int64 {
+	if x != nil {
+		return x.ReceiveTimeUnixNano
+	}
+	return 0
+}
+
+func (x *SyncClockResponse) GetSendTimeUnixNano() int64 {
+	if x != nil {
+		return x.SendTimeUnixNano
+	}
+	return 0
+}
+
+type StreamMetricsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId  string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	IntervalMs    int64                  `protobuf:"varint,2,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamMetricsRequest) Reset() {
+	*x = StreamMetricsRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMetricsRequest) ProtoMessage() {}
+
+func (x *StreamMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMetricsRequest.ProtoReflect.Descriptor instead.
+func (*StreamMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StreamMetricsRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *StreamMetricsRequest) GetIntervalMs() int64 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+type StreamMetricsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TimestampMs     int64                  `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	Requests        int64                  `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`
+	Success         int64                  `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Errors          int64                  `protobuf:"varint,4,opt,name=errors,proto3" json:"errors,omitempty"`
+	LatencyBoundsMs []float64              `protobuf:"fixed64,5,rep,packed,name=latency_bounds_ms,json=latencyBoundsMs,proto3" json:"latency_bounds_ms,omitempty"`
+	LatencyBuckets  []int64                `protobuf:"varint,6,rep,packed,name=latency_buckets,json=latencyBuckets,proto3" json:"latency_buckets,omitempty"`
+	ActiveThreads   int32                  `protobuf:"varint,7,opt,name=active_threads,json=activeThreads,proto3" json:"active_threads,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StreamMetricsResponse) Reset() {
+	*x = StreamMetricsResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMetricsResponse) ProtoMessage() {}
+
+func (x *StreamMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMetricsResponse.ProtoReflect.Descriptor instead.
+func (*StreamMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *StreamMetricsResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse) GetSuccess() int64 {
+	if x != nil {
+		return x.Success
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *StreamMetricsResponse) GetLatencyBoundsMs() []float64 {
+	if x != nil {
+		return x.LatencyBoundsMs
+	}
+	return nil
+}
+
+func (x *StreamMetricsResponse) GetLatencyBuckets() []int64 {
+	if x != nil {
+		return x.LatencyBuckets
+	}
+	return nil
+}
+
+func (x *StreamMetricsResponse) GetActiveThreads() int32 {
+	if x != nil {
+		return x.ActiveThreads
+	}
+	return 0
+}
+
+type StreamResourcesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionId  string                 `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	IntervalMs    int64                  `protobuf:"varint,2,opt,name=interval_ms,json=intervalMs,proto3" json:"interval_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamResourcesRequest) Reset() {
+	*x = StreamResourcesRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResourcesRequest) ProtoMessage() {}
+
+func (x *StreamResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResourcesRequest.ProtoReflect.Descriptor instead.
+func (*StreamResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *StreamResourcesRequest) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *StreamResourcesRequest) GetIntervalMs() int64 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+type StreamResourcesResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TimestampMs        int64                  `protobuf:"varint,1,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+	ProcessCpuPercent  float64                `protobuf:"fixed64,2,opt,name=process_cpu_percent,json=processCpuPercent,proto3" json:"process_cpu_percent,omitempty"`
+	HostCpuPercent     float64                `protobuf:"fixed64,3,opt,name=host_cpu_percent,json=hostCpuPercent,proto3" json:"host_cpu_percent,omitempty"`
+	RssBytes           uint64                 `protobuf:"varint,4,opt,name=rss_bytes,json=rssBytes,proto3" json:"rss_bytes,omitempty"`
+	Goroutines         int32                  `protobuf:"varint,5,opt,name=goroutines,proto3" json:"goroutines,omitempty"`
+	OpenFds            int32                  `protobuf:"varint,6,opt,name=open_fds,json=openFds,proto3" json:"open_fds,omitempty"`
+	NetSentBytesPerSec float64                `protobuf:"fixed64,7,opt,name=net_sent_bytes_per_sec,json=netSentBytesPerSec,proto3" json:"net_sent_bytes_per_sec,omitempty"`
+	NetRecvBytesPerSec float64                `protobuf:"fixed64,8,opt,name=net_recv_bytes_per_sec,json=netRecvBytesPerSec,proto3" json:"net_recv_bytes_per_sec,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *StreamResourcesResponse) Reset() {
+	*x = StreamResourcesResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResourcesResponse) ProtoMessage() {}
+
+func (x *StreamResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamResourcesResponse.ProtoReflect.Descriptor instead.
+func (*StreamResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StreamResourcesResponse) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+func (x *StreamResourcesResponse) GetProcessCpuPercent() float64 {
+	if x != nil {
+		return x.ProcessCpuPercent
+	}
+	return 0
+}
+
+func (x *StreamResourcesResponse) GetHostCpuPercent() float64 {
+	if x != nil {
+		return x.HostCpuPercent
+	}
+	return 0
+}
+
+func (x *StreamResourcesResponse) GetRssBytes() uint64 {
+	if x != nil {
+		return x.RssBytes
+	}
+	return 0
+}
+
+func (x *StreamResourcesResponse) GetGoroutines() int32 {
+	if x != nil {
+		return x.Goroutines
+	}
+	return 0
+}
+
+func (x *StreamResourcesResponse) GetOpenFds() int32 {
+	if x != nil {
+		return x.OpenFds
+	}
+	return 0
+}
+
+func (x *StreamResourcesResponse) GetNetSentBytesPerSec() float64 {
+	if x != nil {
+		return x.NetSentBytesPerSec
+	}
+	return 0
+}
+
+func (x *StreamResourcesResponse) GetNetRecvBytesPerSec() float64 {
+	if x != nil {
+		return x.NetRecvBytesPerSec
+	}
+	return 0
+}
+
+type RegisterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlaveId       string                 `protobuf:"bytes,1,opt,name=slave_id,json=slaveId,proto3" json:"slave_id,omitempty"`
+	Uri           string                 `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Capacity      int32                  `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	LeaseSeconds  int64                  `protobuf:"varint,5,opt,name=lease_seconds,json=leaseSeconds,proto3" json:"lease_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RegisterRequest) GetSlaveId() string {
+	if x != nil {
+		return x.SlaveId
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *RegisterRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *RegisterRequest) GetLeaseSeconds() int64 {
+	if x != nil {
+		return x.LeaseSeconds
+	}
+	return 0
+}
+
+type RegisterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterResponse) Reset() {
+	*x = RegisterResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResponse) ProtoMessage() {}
+
+func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{45}
+}
+
+type DeregisterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlaveId       string                 `protobuf:"bytes,1,opt,name=slave_id,json=slaveId,proto3" json:"slave_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeregisterRequest) Reset() {
+	*x = DeregisterRequest{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeregisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterRequest) ProtoMessage() {}
+
+func (x *DeregisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterRequest.ProtoReflect.Descriptor instead.
+func (*DeregisterRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DeregisterRequest) GetSlaveId() string {
+	if x != nil {
+		return x.SlaveId
+	}
+	return ""
+}
+
+type DeregisterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeregisterResponse) Reset() {
+	*x = DeregisterResponse{}
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeregisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterResponse) ProtoMessage() {}
+
+func (x *DeregisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_bloader_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterResponse.ProtoReflect.Descriptor instead.
+func (*DeregisterResponse) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP(), []int{47}
+}
+
+var File_cresplanex_bloader_v1_bloader_proto protoreflect.FileDescriptor
+
+var file_cresplanex_bloader_v1_bloader_proto_rawDesc = []byte{
+	0x0a, 0x23, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2f, 0x62, 0x6c, 0x6f,
+	0x61, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x63, 0x72,
+	0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2f, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x22,
+	0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2f, 0x62, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xbc, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x6b, 0x69,
+	0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x22, 0x7b, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x72,
+	0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x9b,
+	0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x22, 0x38, 0x0a, 0x11,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x13,
+	0x53, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x35, 0x0a, 0x14, 0x53, 0x6c, 0x61, 0x76, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x82, 0x02,
+	0x0a, 0x1f, 0x53, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x52, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x33, 0x2e, 0x63, 0x72, 0x65, 0x73,
+	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x22,
+	0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x22, 0x22, 0x0a, 0x20, 0x53, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9b, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x6c, 0x6c, 0x45,
+	0x78, 0x65, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x2b,
+	0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f,
+	0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x41, 0x74, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x66,
+	0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6c,
+	0x6f, 0x77, 0x49, 0x64, 0x22, 0xcb, 0x02, 0x0a, 0x10, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78, 0x65,
+	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x49, 0x64, 0x12, 0x4a, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63, 0x72,
+	0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
+	0x6f, 0x6f, 0x74, 0x12, 0x4c, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x68, 0x74,
+	0x74, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48,
+	0x54, 0x54, 0x50, 0x48, 0x00, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x74, 0x74,
+	0x70, 0x12, 0x55, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x72, 0x65, 0x73,
+	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x12, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x48, 0x54, 0x54, 0x50, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x42, 0x0a,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62,
+	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78,
+	0x65, 0x63, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x6c, 0x0a, 0x14, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78,
+	0x65, 0x63, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x22, 0xc9, 0x01, 0x0a, 0x13, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78, 0x65,
+	0x63, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x23, 0x0a, 0x0c, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6a, 0x73,
+	0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x2b, 0x0a, 0x15, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x42, 0x0a,
+	0x1b, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x65, 0x6c, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0xc0, 0x05, 0x0a, 0x1c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x45, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c,
+	0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x7a, 0x0a, 0x17, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x72, 0x65, 0x73,
+	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x65, 0x6c, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x15, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x74, 0x0a, 0x15, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78,
+	0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x13, 0x61, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x05, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x72, 0x65, 0x73,
+	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x65, 0x6c, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x48, 0x00, 0x52, 0x05, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x12, 0x77, 0x0a, 0x16, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x14, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7a, 0x0a,
+	0x17, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40,
+	0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x15, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x29, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x61,
+	0x0a, 0x27, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x65, 0x6c, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x22, 0x65, 0x0a, 0x19, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x4c,
+	0x61, 0x73, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x74, 0x0a, 0x28, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73,
+	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x48,
+	0x0a, 0x29, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x65, 0x6c, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x6e,
+	0x64, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x4c,
+	0x61, 0x73, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64,
+	0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x99,
+	0x01, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x61, 0x75,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x73, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x73, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x65,
+	0x6e, 0x64, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6d,
+	0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x69, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x17, 0x0a,
+	0x15, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x4f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x53,
+	0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x53,
+	0x65, 0x6e, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x63, 0x0a, 0x1d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x64,
+	0x54, 0x65, 0x72, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x22, 0xac, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x72, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78,
+	0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x54, 0x65, 0x72, 0x6d, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x72,
+	0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x4d, 0x73, 0x22, 0x59, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x63, 0x72,
+	0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x54, 0x65, 0x72, 0x6d, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x37, 0x0a,
+	0x10, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x0a, 0x10, 0x53,
+	0x79, 0x6e, 0x63, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x77, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6e,
+	0x61, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x12, 0x2d,
+	0x0a, 0x13, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x78,
+	0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x55, 0x6e, 0x69, 0x78, 0x4e, 0x61, 0x6e, 0x6f, 0x22, 0x5c, 0x0a,
+	0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x22, 0x84, 0x02, 0x0a, 0x15,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x01, 0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73,
+	0x4d, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x22, 0x5e, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x4d, 0x73, 0x22, 0xd6, 0x02, 0x0a, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x70, 0x75,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x43, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x68, 0x6f, 0x73,
+	0x74, 0x43, 0x70, 0x75, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72,
+	0x73, 0x73, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x72, 0x73, 0x73, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x67, 0x6f,
+	0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x6e,
+	0x5f, 0x66, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e,
+	0x46, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x16, 0x6e, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x12, 0x6e, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x32, 0x0a, 0x16, 0x6e, 0x65, 0x74, 0x5f, 0x72,
+	0x65, 0x63, 0x76, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x63, 0x76,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x22, 0x86, 0x02, 0x0a, 0x0f,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x73, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x73, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x4a, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x63,
+	0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x12, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x11, 0x44, 0x65, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0xe7,
+	0x01, 0x0a, 0x1c, 0x53, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x30, 0x0a, 0x2c, 0x53, 0x4c, 0x41, 0x56, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44,
+	0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x2a, 0x0a, 0x26, 0x53, 0x4c, 0x41, 0x56, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41,
+	0x4e, 0x44, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x01, 0x12, 0x36, 0x0a,
+	0x32, 0x53, 0x4c, 0x41, 0x56, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x44,
+	0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x53, 0x54,
+	0x4f, 0x52, 0x45, 0x10, 0x02, 0x12, 0x31, 0x0a, 0x2d, 0x53, 0x4c, 0x41, 0x56, 0x45, 0x5f, 0x43,
+	0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x53,
+	0x54, 0x4f, 0x52, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4c, 0x41, 0x56, 0x45, 0x5f,
+	0x56, 0x41, 0x4c, 0x55, 0x45, 0x53, 0x10, 0x03, 0x2a, 0x7e, 0x0a, 0x12, 0x43, 0x61, 0x6c, 0x6c,
+	0x45, 0x78, 0x65, 0x63, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25,
+	0x0a, 0x21, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x5f, 0x4f, 0x55, 0x54, 0x50,
+	0x55, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x45, 0x58,
+	0x45, 0x43, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48,
+	0x54, 0x54, 0x50, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x41, 0x4c, 0x4c, 0x5f, 0x45, 0x58,
+	0x45, 0x43, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
+	0x55, 0x4d, 0x4d, 0x41, 0x52, 0x59, 0x10, 0x02, 0x2a, 0xe8, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52,
+	0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4c, 0x4f, 0x41, 0x44, 0x45, 0x52, 0x10, 0x01,
+	0x12, 0x26, 0x0a, 0x22, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x03,
+	0x12, 0x27, 0x0a, 0x23, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x04, 0x12, 0x28, 0x0a, 0x24, 0x52, 0x45, 0x51,
+	0x55, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45,
+	0x54, 0x10, 0x05, 0x2a, 0xbd, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x54,
+	0x65, 0x72, 0x6d, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x1f, 0x43, 0x4f, 0x4d,
+	0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21,
+	0x0a, 0x1d, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x52, 0x4d, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x52,
+	0x4d, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x45, 0x52,
+	0x4d, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x54,
+	0x45, 0x52, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45,
+	0x44, 0x10, 0x04, 0x32, 0xbd, 0x0e, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x53,
+	0x6c, 0x61, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x07, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61,
+	0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78,
+	0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x0c, 0x53, 0x6c, 0x61, 0x76,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x2a, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x61,
+	0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x8d, 0x01, 0x0a, 0x18, 0x53, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x36,
+	0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61,
+	0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
+	0x01, 0x12, 0x5d, 0x0a, 0x08, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x12, 0x26, 0x2e,
+	0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6c, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
+	0x12, 0x81, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x32, 0x2e, 0x63, 0x72, 0x65, 0x73,
+	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x65, 0x6c, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e,
+	0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x30, 0x01, 0x12, 0x63, 0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e,
+	0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4c,
+	0x6f, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63,
+	0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x5b, 0x0a, 0x08, 0x53, 0x65, 0x6e,
+	0x64, 0x41, 0x75, 0x74, 0x68, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c,
+	0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x28, 0x01, 0x12, 0x64, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x4f, 0x6b, 0x12, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78,
+	0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x4f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x4f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0a, 0x53, 0x65,
+	0x6e, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e,
+	0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01,
+	0x0a, 0x16, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x72,
+	0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x34, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x72, 0x6d,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35,
+	0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4c, 0x6f,
+	0x61, 0x64, 0x54, 0x65, 0x72, 0x6d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x12, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e,
+	0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x72,
+	0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61,
+	0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78,
+	0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x30, 0x01, 0x12, 0x72, 0x0a, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61,
+	0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x5e, 0x0a, 0x09, 0x53, 0x79, 0x6e, 0x63, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e,
+	0x63, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x2b, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x32, 0xd6, 0x01, 0x0a, 0x14, 0x42, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x4d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5b, 0x0a, 0x08,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0a, 0x44, 0x65, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c,
+	0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62,
+	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cresplanex_bloader_v1_bloader_proto_rawDescOnce sync.Once
+	file_cresplanex_bloader_v1_bloader_proto_rawDescData = file_cresplanex_bloader_v1_bloader_proto_rawDesc
+)
+
+func file_cresplanex_bloader_v1_bloader_proto_rawDescGZIP() []byte {
+	file_cresplanex_bloader_v1_bloader_proto_rawDescOnce.Do(func() {
+		file_cresplanex_bloader_v1_bloader_proto_rawDescData = protoimpl.X.CompressGZIP(file_cresplanex_bloader_v1_bloader_proto_rawDescData)
+	})
+	return file_cresplanex_bloader_v1_bloader_proto_rawDescData
+}
+
+var file_cresplanex_bloader_v1_bloader_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_cresplanex_bloader_v1_bloader_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_cresplanex_bloader_v1_bloader_proto_goTypes = []any{
+	(SlaveCommandDefaultStoreType)(0),                 // 0: cresplanex.bloader.v1.SlaveCommandDefaultStoreType
+	(CallExecOutputType)(0),                           // 1: cresplanex.bloader.v1.CallExecOutputType
+	(RequestType)(0),                                  // 2: cresplanex.bloader.v1.RequestType
+	(CommandTermReason)(0),                            // 3: cresplanex.bloader.v1.CommandTermReason
+	(*Capabilities)(nil),                              // 4: cresplanex.bloader.v1.Capabilities
+	(*ConnectRequest)(nil),                            // 5: cresplanex.bloader.v1.ConnectRequest
+	(*ConnectResponse)(nil),                           // 6: cresplanex.bloader.v1.ConnectResponse
+	(*DisconnectRequest)(nil),                         // 7: cresplanex.bloader.v1.DisconnectRequest
+	(*DisconnectResponse)(nil),                        // 8: cresplanex.bloader.v1.DisconnectResponse
+	(*SlaveCommandRequest)(nil),                       // 9: cresplanex.bloader.v1.SlaveCommandRequest
+	(*SlaveCommandResponse)(nil),                      // 10: cresplanex.bloader.v1.SlaveCommandResponse
+	(*SlaveCommandDefaultStoreRequest)(nil),           // 11: cresplanex.bloader.v1.SlaveCommandDefaultStoreRequest
+	(*SlaveCommandDefaultStoreResponse)(nil),          // 12: cresplanex.bloader.v1.SlaveCommandDefaultStoreResponse
+	(*CallExecRequest)(nil),                           // 13: cresplanex.bloader.v1.CallExecRequest
+	(*CallExecResponse)(nil),                          // 14: cresplanex.bloader.v1.CallExecResponse
+	(*CallExecOutputHTTP)(nil),                        // 15: cresplanex.bloader.v1.CallExecOutputHTTP
+	(*CallExecOutputSource)(nil),                      // 16: cresplanex.bloader.v1.CallExecOutputSource
+	(*CallExecOutputValue)(nil),                       // 17: cresplanex.bloader.v1.CallExecOutputValue
+	(*CallExecOutputSummary)(nil),                     // 18: cresplanex.bloader.v1.CallExecOutputSummary
+	(*ReceiveChanelConnectRequest)(nil),               // 19: cresplanex.bloader.v1.ReceiveChanelConnectRequest
+	(*ReceiveChanelConnectResponse)(nil),              // 20: cresplanex.bloader.v1.ReceiveChanelConnectResponse
+	(*ReceiveChanelConnectLoaderResourceRequest)(nil), // 21: cresplanex.bloader.v1.ReceiveChanelConnectLoaderResourceRequest
+	(*ReceiveChanelConnectAuthResourceRequest)(nil),   // 22: cresplanex.bloader.v1.ReceiveChanelConnectAuthResourceRequest
+	(*ReceiveChanelConnectStore)(nil),                 // 23: cresplanex.bloader.v1.ReceiveChanelConnectStore
+	(*ReceiveChanelConnectStoreResourceRequest)(nil),  // 24: cresplanex.bloader.v1.ReceiveChanelConnectStoreResourceRequest
+	(*ReceiveChanelConnectTargetResourceRequest)(nil), // 25: cresplanex.bloader.v1.ReceiveChanelConnectTargetResourceRequest
+	(*SendLoaderRequest)(nil),                         // 26: cresplanex.bloader.v1.SendLoaderRequest
+	(*SendLoaderResponse)(nil),                        // 27: cresplanex.bloader.v1.SendLoaderResponse
+	(*SendAuthRequest)(nil),                           // 28: cresplanex.bloader.v1.SendAuthRequest
+	(*SendAuthResponse)(nil),                          // 29: cresplanex.bloader.v1.SendAuthResponse
+	(*SendStoreDataRequest)(nil),                      // 30: cresplanex.bloader.v1.SendStoreDataRequest
+	(*SendStoreDataResponse)(nil),                     // 31: cresplanex.bloader.v1.SendStoreDataResponse
+	(*SendStoreOkRequest)(nil),                        // 32: cresplanex.bloader.v1.SendStoreOkRequest
+	(*SendStoreOkResponse)(nil),                       // 33: cresplanex.bloader.v1.SendStoreOkResponse
+	(*SendTargetRequest)(nil),                         // 34: cresplanex.bloader.v1.SendTargetRequest
+	(*SendTargetResponse)(nil),                        // 35: cresplanex.bloader.v1.SendTargetResponse
+	(*ReceiveLoadTermChannelRequest)(nil),             // 36: cresplanex.bloader.v1.ReceiveLoadTermChannelRequest
+	(*ReceiveLoadTermChannelResponse)(nil),            // 37: cresplanex.bloader.v1.ReceiveLoadTermChannelResponse
+	(*CancelCommandRequest)(nil),                      // 38: cresplanex.bloader.v1.CancelCommandRequest
+	(*CancelCommandResponse)(nil),                     // 39: cresplanex.bloader.v1.CancelCommandResponse
+	(*HeartbeatRequest)(nil),                          // 40: cresplanex.bloader.v1.HeartbeatRequest
+	(*HeartbeatResponse)(nil),                         // 41: cresplanex.bloader.v1.HeartbeatResponse
+	(*SyncClockRequest)(nil),                          // 42: cresplanex.bloader.v1.SyncClockRequest
+	(*SyncClockResponse)(nil),                         // 43: cresplanex.bloader.v1.SyncClockResponse
+	(*StreamMetricsRequest)(nil),                      // 44: cresplanex.bloader.v1.StreamMetricsRequest
+	(*StreamMetricsResponse)(nil),                     // 45: cresplanex.bloader.v1.StreamMetricsResponse
+	(*StreamResourcesRequest)(nil),                    // 46: cresplanex.bloader.v1.StreamResourcesRequest
+	(*StreamResourcesResponse)(nil),                   // 47: cresplanex.bloader.v1.StreamResourcesResponse
+	(*RegisterRequest)(nil),                           // 48: cresplanex.bloader.v1.RegisterRequest
+	(*RegisterResponse)(nil),                          // 49: cresplanex.bloader.v1.RegisterResponse
+	(*DeregisterRequest)(nil),                         // 50: cresplanex.bloader.v1.DeregisterRequest
+	(*DeregisterResponse)(nil),                        // 51: cresplanex.bloader.v1.DeregisterResponse
+	nil,                                               // 52: cresplanex.bloader.v1.RegisterRequest.LabelsEntry
+	(*Auth)(nil),                                      // 53: cresplanex.bloader.v1.Auth
+	(*Target)(nil),                                    // 54: cresplanex.bloader.v1.Target
+}
+var file_cresplanex_bloader_v1_bloader_proto_depIdxs = []int32{
+	4,  // 0: cresplanex.bloader.v1.ConnectRequest.capabilities:type_name -> cresplanex.bloader.v1.Capabilities
+	4,  // 1: cresplanex.bloader.v1.ConnectResponse.capabilities:type_name -> cresplanex.bloader.v1.Capabilities
+	0,  // 2: cresplanex.bloader.v1.SlaveCommandDefaultStoreRequest.store_type:type_name -> cresplanex.bloader.v1.SlaveCommandDefaultStoreType
+	1,  // 3: cresplanex.bloader.v1.CallExecResponse.output_type:type_name -> cresplanex.bloader.v1.CallExecOutputType
+	15, // 4: cresplanex.bloader.v1.CallExecResponse.output_http:type_name -> cresplanex.bloader.v1.CallExecOutputHTTP
+	18, // 5: cresplanex.bloader.v1.CallExecResponse.output_summary:type_name -> cresplanex.bloader.v1.CallExecOutputSummary
+	17, // 6: cresplanex.bloader.v1.CallExecOutputHTTP.values:type_name -> cresplanex.bloader.v1.CallExecOutputValue
+	16, // 7: cresplanex.bloader.v1.CallExecOutputHTTP.source:type_name -> cresplanex.bloader.v1.CallExecOutputSource
+	2,  // 8: cresplanex.bloader.v1.ReceiveChanelConnectResponse.request_type:type_name -> cresplanex.bloader.v1.RequestType
+	21, // 9: cresplanex.bloader.v1.ReceiveChanelConnectResponse.loader_resource_request:type_name -> cresplanex.bloader.v1.ReceiveChanelConnectLoaderResourceRequest
+	22, // 10: cresplanex.bloader.v1.ReceiveChanelConnectResponse.auth_resource_request:type_name -> cresplanex.bloader.v1.ReceiveChanelConnectAuthResourceRequest
+	23, // 11: cresplanex.bloader.v1.ReceiveChanelConnectResponse.store:type_name -> cresplanex.bloader.v1.ReceiveChanelConnectStore
+	24, // 12: cresplanex.bloader.v1.ReceiveChanelConnectResponse.store_resource_request:type_name -> cresplanex.bloader.v1.ReceiveChanelConnectStoreResourceRequest
+	25, // 13: cresplanex.bloader.v1.ReceiveChanelConnectResponse.target_resource_request:type_name -> cresplanex.bloader.v1.ReceiveChanelConnectTargetResourceRequest
+	53, // 14: cresplanex.bloader.v1.SendAuthRequest.auth:type_name -> cresplanex.bloader.v1.Auth
+	54, // 15: cresplanex.bloader.v1.SendTargetRequest.target:type_name -> cresplanex.bloader.v1.Target
+	3,  // 16: cresplanex.bloader.v1.ReceiveLoadTermChannelResponse.reason:type_name -> cresplanex.bloader.v1.CommandTermReason
+	3,  // 17: cresplanex.bloader.v1.CancelCommandResponse.reason:type_name -> cresplanex.bloader.v1.CommandTermReason
+	52, // 18: cresplanex.bloader.v1.RegisterRequest.labels:type_name -> cresplanex.bloader.v1.RegisterRequest.LabelsEntry
+	5,  // 19: cresplanex.bloader.v1.BloaderSlaveService.Connect:input_type -> cresplanex.bloader.v1.ConnectRequest
+	7,  // 20: cresplanex.bloader.v1.BloaderSlaveService.Disconnect:input_type -> cresplanex.bloader.v1.DisconnectRequest
+	9,  // 21: cresplanex.bloader.v1.BloaderSlaveService.SlaveCommand:input_type -> cresplanex.bloader.v1.SlaveCommandRequest
+	11, // 22: cresplanex.bloader.v1.BloaderSlaveService.SlaveCommandDefaultStore:input_type -> cresplanex.bloader.v1.SlaveCommandDefaultStoreRequest
+	13, // 23: cresplanex.bloader.v1.BloaderSlaveService.CallExec:input_type -> cresplanex.bloader.v1.CallExecRequest
+	19, // 24: cresplanex.bloader.v1.BloaderSlaveService.ReceiveChanelConnect:input_type -> cresplanex.bloader.v1.ReceiveChanelConnectRequest
+	26, // 25: cresplanex.bloader.v1.BloaderSlaveService.SendLoader:input_type -> cresplanex.bloader.v1.SendLoaderRequest
+	28, // 26: cresplanex.bloader.v1.BloaderSlaveService.SendAuth:input_type -> cresplanex.bloader.v1.SendAuthRequest
+	30, // 27: cresplanex.bloader.v1.BloaderSlaveService.SendStoreData:input_type -> cresplanex.bloader.v1.SendStoreDataRequest
+	32, // 28: cresplanex.bloader.v1.BloaderSlaveService.SendStoreOk:input_type -> cresplanex.bloader.v1.SendStoreOkRequest
+	34, // 29: cresplanex.bloader.v1.BloaderSlaveService.SendTarget:input_type -> cresplanex.bloader.v1.SendTargetRequest
+	36, // 30: cresplanex.bloader.v1.BloaderSlaveService.ReceiveLoadTermChannel:input_type -> cresplanex.bloader.v1.ReceiveLoadTermChannelRequest
+	40, // 31: cresplanex.bloader.v1.BloaderSlaveService.Heartbeat:input_type -> cresplanex.bloader.v1.HeartbeatRequest
+	44, // 32: cresplanex.bloader.v1.BloaderSlaveService.StreamMetrics:input_type -> cresplanex.bloader.v1.StreamMetricsRequest
+	46, // 33: cresplanex.bloader.v1.BloaderSlaveService.StreamResources:input_type -> cresplanex.bloader.v1.StreamResourcesRequest
+	42, // 34: cresplanex.bloader.v1.BloaderSlaveService.SyncClock:input_type -> cresplanex.bloader.v1.SyncClockRequest
+	38, // 35: cresplanex.bloader.v1.BloaderSlaveService.CancelCommand:input_type -> cresplanex.bloader.v1.CancelCommandRequest
+	48, // 36: cresplanex.bloader.v1.BloaderMasterService.Register:input_type -> cresplanex.bloader.v1.RegisterRequest
+	50, // 37: cresplanex.bloader.v1.BloaderMasterService.Deregister:input_type -> cresplanex.bloader.v1.DeregisterRequest
+	6,  // 38: cresplanex.bloader.v1.BloaderSlaveService.Connect:output_type -> cresplanex.bloader.v1.ConnectResponse
+	8,  // 39: cresplanex.bloader.v1.BloaderSlaveService.Disconnect:output_type -> cresplanex.bloader.v1.DisconnectResponse
+	10, // 40: cresplanex.bloader.v1.BloaderSlaveService.SlaveCommand:output_type -> cresplanex.bloader.v1.SlaveCommandResponse
+	12, // 41: cresplanex.bloader.v1.BloaderSlaveService.SlaveCommandDefaultStore:output_type -> cresplanex.bloader.v1.SlaveCommandDefaultStoreResponse
+	14, // 42: cresplanex.bloader.v1.BloaderSlaveService.CallExec:output_type -> cresplanex.bloader.v1.CallExecResponse
+	20, // 43: cresplanex.bloader.v1.BloaderSlaveService.ReceiveChanelConnect:output_type -> cresplanex.bloader.v1.ReceiveChanelConnectResponse
+	27, // 44: cresplanex.bloader.v1.BloaderSlaveService.SendLoader:output_type -> cresplanex.bloader.v1.SendLoaderResponse
+	29, // 45: cresplanex.bloader.v1.BloaderSlaveService.SendAuth:output_type -> cresplanex.bloader.v1.SendAuthResponse
+	31, // 46: cresplanex.bloader.v1.BloaderSlaveService.SendStoreData:output_type -> cresplanex.bloader.v1.SendStoreDataResponse
+	33, // 47: cresplanex.bloader.v1.BloaderSlaveService.SendStoreOk:output_type -> cresplanex.bloader.v1.SendStoreOkResponse
+	35, // 48: cresplanex.bloader.v1.BloaderSlaveService.SendTarget:output_type -> cresplanex.bloader.v1.SendTargetResponse
+	37, // 49: cresplanex.bloader.v1.BloaderSlaveService.ReceiveLoadTermChannel:output_type -> cresplanex.bloader.v1.ReceiveLoadTermChannelResponse
+	41, // 50: cresplanex.bloader.v1.BloaderSlaveService.Heartbeat:output_type -> cresplanex.bloader.v1.HeartbeatResponse
+	45, // 51: cresplanex.bloader.v1.BloaderSlaveService.StreamMetrics:output_type -> cresplanex.bloader.v1.StreamMetricsResponse
+	47, // 52: cresplanex.bloader.v1.BloaderSlaveService.StreamResources:output_type -> cresplanex.bloader.v1.StreamResourcesResponse
+	43, // 53: cresplanex.bloader.v1.BloaderSlaveService.SyncClock:output_type -> cresplanex.bloader.v1.SyncClockResponse
+	39, // 54: cresplanex.bloader.v1.BloaderSlaveService.CancelCommand:output_type -> cresplanex.bloader.v1.CancelCommandResponse
+	49, // 55: cresplanex.bloader.v1.BloaderMasterService.Register:output_type -> cresplanex.bloader.v1.RegisterResponse
+	51, // 56: cresplanex.bloader.v1.BloaderMasterService.Deregister:output_type -> cresplanex.bloader.v1.DeregisterResponse
+	38, // [38:57] is the sub-list for method output_type
+	19, // [19:38] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_cresplanex_bloader_v1_bloader_proto_init() }
+func file_cresplanex_bloader_v1_bloader_proto_init() {
+	if File_cresplanex_bloader_v1_bloader_proto != nil {
+		return
+	}
+	file_cresplanex_bloader_v1_auth_proto_init()
+	file_cresplanex_bloader_v1_target_proto_init()
+	file_cresplanex_bloader_v1_bloader_proto_msgTypes[10].OneofWrappers = []any{
+		(*CallExecResponse_OutputHttp)(nil),
+		(*CallExecResponse_OutputSummary)(nil),
+	}
+	file_cresplanex_bloader_v1_bloader_proto_msgTypes[13].OneofWrappers = []any{
+		(*CallExecOutputValue_StringValue)(nil),
+		(*CallExecOutputValue_BoolValue)(nil),
+		(*CallExecOutputValue_IntValue)(nil),
+		(*CallExecOutputValue_DoubleValue)(nil),
+		(*CallExecOutputValue_JsonValue)(nil),
+	}
+	file_cresplanex_bloader_v1_bloader_proto_msgTypes[16].OneofWrappers = []any{
+		(*ReceiveChanelConnectResponse_LoaderResourceRequest)(nil),
+		(*ReceiveChanelConnectResponse_AuthResourceRequest)(nil),
+		(*ReceiveChanelConnectResponse_Store)(nil),
+		(*ReceiveChanelConnectResponse_StoreResourceRequest)(nil),
+		(*ReceiveChanelConnectResponse_TargetResourceRequest)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cresplanex_bloader_v1_bloader_proto_rawDesc,
+			NumEnums:      4,
+			NumMessages:   49,
+			NumExtensions: 0,
+			NumServices:   2,
+		},
+		GoTypes:           file_cresplanex_bloader_v1_bloader_proto_goTypes,
+		DependencyIndexes: file_cresplanex_bloader_v1_bloader_proto_depIdxs,
+		EnumInfos:         file_cresplanex_bloader_v1_bloader_proto_enumTypes,
+		MessageInfos:      file_cresplanex_bloader_v1_bloader_proto_msgTypes,
+	}.Build()
+	File_cresplanex_bloader_v1_bloader_proto = out.File
+	file_cresplanex_bloader_v1_bloader_proto_rawDesc = nil
+	file_cresplanex_bloader_v1_bloader_proto_goTypes = nil
+	file_cresplanex_bloader_v1_bloader_proto_depIdxs = nil
+}
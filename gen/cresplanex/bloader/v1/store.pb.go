@@ -0,0 +1,454 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        (unknown)
+// source: cresplanex/bloader/v1/store.proto
+
+package bloaderv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StoreDataList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*StoreData           `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreDataList) Reset() {
+	*x = StoreDataList{}
+	mi := &file_cresplanex_bloader_v1_store_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreDataList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreDataList) ProtoMessage() {}
+
+func (x *StoreDataList) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_store_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreDataList.ProtoReflect.Descriptor instead.
+func (*StoreDataList) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_store_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StoreDataList) GetData() []*StoreData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type StoreData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketId      string                 `protobuf:"bytes,1,opt,name=bucket_id,json=bucketId,proto3" json:"bucket_id,omitempty"`
+	StoreKey      string                 `protobuf:"bytes,2,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Encryption    *Encryption            `protobuf:"bytes,4,opt,name=encryption,proto3" json:"encryption,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreData) Reset() {
+	*x = StoreData{}
+	mi := &file_cresplanex_bloader_v1_store_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreData) ProtoMessage() {}
+
+func (x *StoreData) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_store_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreData.ProtoReflect.Descriptor instead.
+func (*StoreData) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_store_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StoreData) GetBucketId() string {
+	if x != nil {
+		return x.BucketId
+	}
+	return ""
+}
+
+func (x *StoreData) GetStoreKey() string {
+	if x != nil {
+		return x.StoreKey
+	}
+	return ""
+}
+
+func (x *StoreData) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *StoreData) GetEncryption() *Encryption {
+	if x != nil {
+		return x.Encryption
+	}
+	return nil
+}
+
+type StoreExportDataList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*StoreExportData     `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreExportDataList) Reset() {
+	*x = StoreExportDataList{}
+	mi := &file_cresplanex_bloader_v1_store_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreExportDataList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreExportDataList) ProtoMessage() {}
+
+func (x *StoreExportDataList) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_store_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreExportDataList.ProtoReflect.Descriptor instead.
+func (*StoreExportDataList) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_store_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StoreExportDataList) GetData() []*StoreExportData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type StoreExportData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketId      string                 `protobuf:"bytes,1,opt,name=bucket_id,json=bucketId,proto3" json:"bucket_id,omitempty"`
+	StoreKey      string                 `protobuf:"bytes,2,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreExportData) Reset() {
+	*x = StoreExportData{}
+	mi := &file_cresplanex_bloader_v1_store_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreExportData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreExportData) ProtoMessage() {}
+
+func (x *StoreExportData) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_store_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreExportData.ProtoReflect.Descriptor instead.
+func (*StoreExportData) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_store_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StoreExportData) GetBucketId() string {
+	if x != nil {
+		return x.BucketId
+	}
+	return ""
+}
+
+func (x *StoreExportData) GetStoreKey() string {
+	if x != nil {
+		return x.StoreKey
+	}
+	return ""
+}
+
+func (x *StoreExportData) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type StoreImportRequestList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*StoreImportRequest  `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreImportRequestList) Reset() {
+	*x = StoreImportRequestList{}
+	mi := &file_cresplanex_bloader_v1_store_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreImportRequestList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreImportRequestList) ProtoMessage() {}
+
+func (x *StoreImportRequestList) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_store_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreImportRequestList.ProtoReflect.Descriptor instead.
+func (*StoreImportRequestList) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_store_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StoreImportRequestList) GetData() []*StoreImportRequest {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type StoreImportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketId      string                 `protobuf:"bytes,1,opt,name=bucket_id,json=bucketId,proto3" json:"bucket_id,omitempty"`
+	StoreKey      string                 `protobuf:"bytes,2,opt,name=store_key,json=storeKey,proto3" json:"store_key,omitempty"`
+	Encryption    *Encryption            `protobuf:"bytes,4,opt,name=encryption,proto3" json:"encryption,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreImportRequest) Reset() {
+	*x = StoreImportRequest{}
+	mi := &file_cresplanex_bloader_v1_store_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreImportRequest) ProtoMessage() {}
+
+func (x *StoreImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_store_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreImportRequest.ProtoReflect.Descriptor instead.
+func (*StoreImportRequest) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_store_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StoreImportRequest) GetBucketId() string {
+	if x != nil {
+		return x.BucketId
+	}
+	return ""
+}
+
+func (x *StoreImportRequest) GetStoreKey() string {
+	if x != nil {
+		return x.StoreKey
+	}
+	return ""
+}
+
+func (x *StoreImportRequest) GetEncryption() *Encryption {
+	if x != nil {
+		return x.Encryption
+	}
+	return nil
+}
+
+var File_cresplanex_bloader_v1_store_proto protoreflect.FileDescriptor
+
+var file_cresplanex_bloader_v1_store_proto_rawDesc = []byte{
+	0x0a, 0x21, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2f, 0x62, 0x6c, 0x6f,
+	0x61, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x15, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e,
+	0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x23, 0x63, 0x72, 0x65, 0x73,
+	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2f, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2f, 0x76,
+	0x31, 0x2f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x45, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x34, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9c, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x41, 0x0a, 0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61,
+	0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x72, 0x65,
+	0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x5f, 0x0a, 0x0f, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x57, 0x0a, 0x16, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62,
+	0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x91, 0x01, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x4b, 0x65, 0x79, 0x12, 0x41, 0x0a, 0x0a, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c,
+	0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cresplanex_bloader_v1_store_proto_rawDescOnce sync.Once
+	file_cresplanex_bloader_v1_store_proto_rawDescData = file_cresplanex_bloader_v1_store_proto_rawDesc
+)
+
+func file_cresplanex_bloader_v1_store_proto_rawDescGZIP() []byte {
+	file_cresplanex_bloader_v1_store_proto_rawDescOnce.Do(func() {
+		file_cresplanex_bloader_v1_store_proto_rawDescData = protoimpl.X.CompressGZIP(file_cresplanex_bloader_v1_store_proto_rawDescData)
+	})
+	return file_cresplanex_bloader_v1_store_proto_rawDescData
+}
+
+var file_cresplanex_bloader_v1_store_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_cresplanex_bloader_v1_store_proto_goTypes = []any{
+	(*StoreDataList)(nil),          // 0: cresplanex.bloader.v1.StoreDataList
+	(*StoreData)(nil),              // 1: cresplanex.bloader.v1.StoreData
+	(*StoreExportDataList)(nil),    // 2: cresplanex.bloader.v1.StoreExportDataList
+	(*StoreExportData)(nil),        // 3: cresplanex.bloader.v1.StoreExportData
+	(*StoreImportRequestList)(nil), // 4: cresplanex.bloader.v1.StoreImportRequestList
+	(*StoreImportRequest)(nil),     // 5: cresplanex.bloader.v1.StoreImportRequest
+	(*Encryption)(nil),             // 6: cresplanex.bloader.v1.Encryption
+}
+var file_cresplanex_bloader_v1_store_proto_depIdxs = []int32{
+	1, // 0: cresplanex.bloader.v1.StoreDataList.data:type_name -> cresplanex.bloader.v1.StoreData
+	6, // 1: cresplanex.bloader.v1.StoreData.encryption:type_name -> cresplanex.bloader.v1.Encryption
+	3, // 2: cresplanex.bloader.v1.StoreExportDataList.data:type_name -> cresplanex.bloader.v1.StoreExportData
+	5, // 3: cresplanex.bloader.v1.StoreImportRequestList.data:type_name -> cresplanex.bloader.v1.StoreImportRequest
+	6, // 4: cresplanex.bloader.v1.StoreImportRequest.encryption:type_name -> cresplanex.bloader.v1.Encryption
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_cresplanex_bloader_v1_store_proto_init() }
+func file_cresplanex_bloader_v1_store_proto_init() {
+	if File_cresplanex_bloader_v1_store_proto != nil {
+		return
+	}
+	file_cresplanex_bloader_v1_encrypt_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cresplanex_bloader_v1_store_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cresplanex_bloader_v1_store_proto_goTypes,
+		DependencyIndexes: file_cresplanex_bloader_v1_store_proto_depIdxs,
+		MessageInfos:      file_cresplanex_bloader_v1_store_proto_msgTypes,
+	}.Build()
+	File_cresplanex_bloader_v1_store_proto = out.File
+	file_cresplanex_bloader_v1_store_proto_rawDesc = nil
+	file_cresplanex_bloader_v1_store_proto_goTypes = nil
+	file_cresplanex_bloader_v1_store_proto_depIdxs = nil
+}
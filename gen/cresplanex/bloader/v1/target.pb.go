@@ -0,0 +1,487 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        (unknown)
+// source: cresplanex/bloader/v1/target.proto
+
+package bloaderv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TargetType int32
+
+const (
+	TargetType_TARGET_TYPE_UNSPECIFIED TargetType = 0
+	TargetType_TARGET_TYPE_HTTP        TargetType = 1
+	TargetType_TARGET_TYPE_GRPC        TargetType = 2
+	TargetType_TARGET_TYPE_TCP         TargetType = 3
+	TargetType_TARGET_TYPE_UDP         TargetType = 4
+)
+
+// Enum value maps for TargetType.
+var (
+	TargetType_name = map[int32]string{
+		0: "TARGET_TYPE_UNSPECIFIED",
+		1: "TARGET_TYPE_HTTP",
+		2: "TARGET_TYPE_GRPC",
+		3: "TARGET_TYPE_TCP",
+		4: "TARGET_TYPE_UDP",
+	}
+	TargetType_value = map[string]int32{
+		"TARGET_TYPE_UNSPECIFIED": 0,
+		"TARGET_TYPE_HTTP":        1,
+		"TARGET_TYPE_GRPC":        2,
+		"TARGET_TYPE_TCP":         3,
+		"TARGET_TYPE_UDP":         4,
+	}
+)
+
+func (x TargetType) Enum() *TargetType {
+	p := new(TargetType)
+	*p = x
+	return p
+}
+
+func (x TargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_cresplanex_bloader_v1_target_proto_enumTypes[0].Descriptor()
+}
+
+func (TargetType) Type() protoreflect.EnumType {
+	return &file_cresplanex_bloader_v1_target_proto_enumTypes[0]
+}
+
+func (x TargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TargetType.Descriptor instead.
+func (TargetType) EnumDescriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_target_proto_rawDescGZIP(), []int{0}
+}
+
+type Target struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  TargetType             `protobuf:"varint,1,opt,name=type,proto3,enum=cresplanex.bloader.v1.TargetType" json:"type,omitempty"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*Target_Http
+	//	*Target_Grpc
+	//	*Target_Tcp
+	//	*Target_Udp
+	Target        isTarget_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Target) Reset() {
+	*x = Target{}
+	mi := &file_cresplanex_bloader_v1_target_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Target) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Target) ProtoMessage() {}
+
+func (x *Target) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_target_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Target.ProtoReflect.Descriptor instead.
+func (*Target) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_target_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Target) GetType() TargetType {
+	if x != nil {
+		return x.Type
+	}
+	return TargetType_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *Target) GetTarget() isTarget_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *Target) GetHttp() *TargetHTTPData {
+	if x != nil {
+		if x, ok := x.Target.(*Target_Http); ok {
+			return x.Http
+		}
+	}
+	return nil
+}
+
+func (x *Target) GetGrpc() *TargetGRPCData {
+	if x != nil {
+		if x, ok := x.Target.(*Target_Grpc); ok {
+			return x.Grpc
+		}
+	}
+	return nil
+}
+
+func (x *Target) GetTcp() *TargetTCPData {
+	if x != nil {
+		if x, ok := x.Target.(*Target_Tcp); ok {
+			return x.Tcp
+		}
+	}
+	return nil
+}
+
+func (x *Target) GetUdp() *TargetUDPData {
+	if x != nil {
+		if x, ok := x.Target.(*Target_Udp); ok {
+			return x.Udp
+		}
+	}
+	return nil
+}
+
+type isTarget_Target interface {
+	isTarget_Target()
+}
+
+type Target_Http struct {
+	Http *TargetHTTPData `protobuf:"bytes,2,opt,name=http,proto3,oneof"`
+}
+
+type Target_Grpc struct {
+	Grpc *TargetGRPCData `protobuf:"bytes,3,opt,name=grpc,proto3,oneof"`
+}
+
+type Target_Tcp struct {
+	Tcp *TargetTCPData `protobuf:"bytes,4,opt,name=tcp,proto3,oneof"`
+}
+
+type Target_Udp struct {
+	Udp *TargetUDPData `protobuf:"bytes,5,opt,name=udp,proto3,oneof"`
+}
+
+func (*Target_Http) isTarget_Target() {}
+
+func (*Target_Grpc) isTarget_Target() {}
+
+func (*Target_Tcp) isTarget_Target() {}
+
+func (*Target_Udp) isTarget_Target() {}
+
+type TargetHTTPData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetHTTPData) Reset() {
+	*x = TargetHTTPData{}
+	mi := &file_cresplanex_bloader_v1_target_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetHTTPData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetHTTPData) ProtoMessage() {}
+
+func (x *TargetHTTPData) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_target_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetHTTPData.ProtoReflect.Descriptor instead.
+func (*TargetHTTPData) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_target_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TargetHTTPData) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type TargetGRPCData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetGRPCData) Reset() {
+	*x = TargetGRPCData{}
+	mi := &file_cresplanex_bloader_v1_target_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetGRPCData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetGRPCData) ProtoMessage() {}
+
+func (x *TargetGRPCData) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_target_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetGRPCData.ProtoReflect.Descriptor instead.
+func (*TargetGRPCData) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_target_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TargetGRPCData) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type TargetTCPData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetTCPData) Reset() {
+	*x = TargetTCPData{}
+	mi := &file_cresplanex_bloader_v1_target_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetTCPData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetTCPData) ProtoMessage() {}
+
+func (x *TargetTCPData) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_target_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetTCPData.ProtoReflect.Descriptor instead.
+func (*TargetTCPData) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_target_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TargetTCPData) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type TargetUDPData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TargetUDPData) Reset() {
+	*x = TargetUDPData{}
+	mi := &file_cresplanex_bloader_v1_target_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TargetUDPData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetUDPData) ProtoMessage() {}
+
+func (x *TargetUDPData) ProtoReflect() protoreflect.Message {
+	mi := &file_cresplanex_bloader_v1_target_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetUDPData.ProtoReflect.Descriptor instead.
+func (*TargetUDPData) Descriptor() ([]byte, []int) {
+	return file_cresplanex_bloader_v1_target_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TargetUDPData) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+var File_cresplanex_bloader_v1_target_proto protoreflect.FileDescriptor
+
+var file_cresplanex_bloader_v1_target_proto_rawDesc = []byte{
+	0x0a, 0x22, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2f, 0x62, 0x6c, 0x6f,
+	0x61, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78,
+	0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x22, 0xb7, 0x02, 0x0a, 0x06,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a,
+	0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x72,
+	0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x54, 0x54, 0x50, 0x44, 0x61,
+	0x74, 0x61, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x3b, 0x0a, 0x04, 0x67, 0x72,
+	0x70, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x52, 0x50, 0x43, 0x44, 0x61, 0x74, 0x61, 0x48,
+	0x00, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x12, 0x38, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x54, 0x43, 0x50, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63,
+	0x70, 0x12, 0x38, 0x0a, 0x03, 0x75, 0x64, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x63, 0x72, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x78, 0x2e, 0x62, 0x6c, 0x6f, 0x61,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x44, 0x50,
+	0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x03, 0x75, 0x64, 0x70, 0x42, 0x08, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x22, 0x0a, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48,
+	0x54, 0x54, 0x50, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x22, 0x0a, 0x0e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x47, 0x52, 0x50, 0x43, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x21, 0x0a,
+	0x0d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x43, 0x50, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x22, 0x21, 0x0a, 0x0d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x44, 0x50, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x2a, 0x7f, 0x0a, 0x0a, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1b, 0x0a, 0x17, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48, 0x54,
+	0x54, 0x50, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x47, 0x52, 0x50, 0x43, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x41,
+	0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x43, 0x50, 0x10, 0x03, 0x12,
+	0x13, 0x0a, 0x0f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
+	0x44, 0x50, 0x10, 0x04, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_cresplanex_bloader_v1_target_proto_rawDescOnce sync.Once
+	file_cresplanex_bloader_v1_target_proto_rawDescData = file_cresplanex_bloader_v1_target_proto_rawDesc
+)
+
+func file_cresplanex_bloader_v1_target_proto_rawDescGZIP() []byte {
+	file_cresplanex_bloader_v1_target_proto_rawDescOnce.Do(func() {
+		file_cresplanex_bloader_v1_target_proto_rawDescData = protoimpl.X.CompressGZIP(file_cresplanex_bloader_v1_target_proto_rawDescData)
+	})
+	return file_cresplanex_bloader_v1_target_proto_rawDescData
+}
+
+var file_cresplanex_bloader_v1_target_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_cresplanex_bloader_v1_target_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cresplanex_bloader_v1_target_proto_goTypes = []any{
+	(TargetType)(0),        // 0: cresplanex.bloader.v1.TargetType
+	(*Target)(nil),         // 1: cresplanex.bloader.v1.Target
+	(*TargetHTTPData)(nil), // 2: cresplanex.bloader.v1.TargetHTTPData
+	(*TargetGRPCData)(nil), // 3: cresplanex.bloader.v1.TargetGRPCData
+	(*TargetTCPData)(nil),  // 4: cresplanex.bloader.v1.TargetTCPData
+	(*TargetUDPData)(nil),  // 5: cresplanex.bloader.v1.TargetUDPData
+}
+var file_cresplanex_bloader_v1_target_proto_depIdxs = []int32{
+	0, // 0: cresplanex.bloader.v1.Target.type:type_name -> cresplanex.bloader.v1.TargetType
+	2, // 1: cresplanex.bloader.v1.Target.http:type_name -> cresplanex.bloader.v1.TargetHTTPData
+	3, // 2: cresplanex.bloader.v1.Target.grpc:type_name -> cresplanex.bloader.v1.TargetGRPCData
+	4, // 3: cresplanex.bloader.v1.Target.tcp:type_name -> cresplanex.bloader.v1.TargetTCPData
+	5, // 4: cresplanex.bloader.v1.Target.udp:type_name -> cresplanex.bloader.v1.TargetUDPData
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_cresplanex_bloader_v1_target_proto_init() }
+func file_cresplanex_bloader_v1_target_proto_init() {
+	if File_cresplanex_bloader_v1_target_proto != nil {
+		return
+	}
+	file_cresplanex_bloader_v1_target_proto_msgTypes[0].OneofWrappers = []any{
+		(*Target_Http)(nil),
+		(*Target_Grpc)(nil),
+		(*Target_Tcp)(nil),
+		(*Target_Udp)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_cresplanex_bloader_v1_target_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cresplanex_bloader_v1_target_proto_goTypes,
+		DependencyIndexes: file_cresplanex_bloader_v1_target_proto_depIdxs,
+		EnumInfos:         file_cresplanex_bloader_v1_target_proto_enumTypes,
+		MessageInfos:      file_cresplanex_bloader_v1_target_proto_msgTypes,
+	}.Build()
+	File_cresplanex_bloader_v1_target_proto = out.File
+	file_cresplanex_bloader_v1_target_proto_rawDesc = nil
+	file_cresplanex_bloader_v1_target_proto_goTypes = nil
+	file_cresplanex_bloader_v1_target_proto_depIdxs = nil
+}
@@ -5,8 +5,7 @@ import (
 	"context"
 	"net/http"
 
-	pb "buf.build/gen/go/cresplanex/bloader/protocolbuffers/go/cresplanex/bloader/v1"
-
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
 	"github.com/ablankz/bloader/internal/config"
 	"github.com/ablankz/bloader/internal/store"
 )
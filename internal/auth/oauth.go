@@ -8,11 +8,10 @@ import (
 	"net/http"
 	"time"
 
-	pb "buf.build/gen/go/cresplanex/bloader/protocolbuffers/go/cresplanex/bloader/v1"
-
 	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
 	"github.com/ablankz/bloader/internal/config"
 	"github.com/ablankz/bloader/internal/store"
 	"github.com/ablankz/bloader/internal/utils"
@@ -58,6 +58,7 @@ type Config struct {
 	Language     *LanguageConfig     `mapstructure:"language"`
 	Override     *OverrideConfig     `mapstructure:"override"`
 	SlaveSetting *SlaveSettingConfig `mapstructure:"slave_setting"`
+	Tracing      *TracingConfig      `mapstructure:"tracing"`
 }
 
 // ValidConfig represents the application configuration
@@ -76,6 +77,7 @@ type ValidConfig struct {
 	Language     ValidLanguageConfig
 	Override     ValidOverrideConfig
 	SlaveSetting ValidSlaveSettingConfig
+	Tracing      ValidTracingConfig
 }
 
 // Validate validates the configuration
@@ -173,6 +175,21 @@ func (c Config) validateMaster(valid *ValidConfig) error {
 	}
 	valid.Server = validServer
 
+	if validServer.Registration.Enabled && !validServer.RedirectPort.Enabled {
+		for _, auth := range validAuth {
+			if auth.Type == AuthTypeOAuth2 {
+				return ErrServerRegistrationRedirectPortRequired
+			}
+		}
+	}
+	if validServer.Metrics.Enabled && validServer.Metrics.Port == validServer.Port && !validServer.RedirectPort.Enabled {
+		for _, auth := range validAuth {
+			if auth.Type == AuthTypeOAuth2 {
+				return ErrServerMetricsRedirectPortRequired
+			}
+		}
+	}
+
 	if c.Logging == nil {
 		return ErrLoggingRequired
 	}
@@ -209,6 +226,10 @@ func (c Config) validateMaster(valid *ValidConfig) error {
 	}
 	valid.Override = validOverride
 
+	if err := c.validateTracing(valid); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -272,5 +293,22 @@ func (c Config) validateSlave(valid *ValidConfig) error {
 	}
 	valid.SlaveSetting = validSlaveSetting
 
+	if err := c.validateTracing(valid); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTracing validates the tracing configuration, which is optional on both the master and the slave
+func (c Config) validateTracing(valid *ValidConfig) error {
+	if c.Tracing == nil {
+		return nil
+	}
+	validTracing, err := c.Tracing.Validate()
+	if err != nil {
+		return err
+	}
+	valid.Tracing = validTracing
 	return nil
 }
@@ -35,6 +35,14 @@ var (
 	ErrSlaveSettingRequired = fmt.Errorf("slave setting is required")
 	// ErrServerPortRequired is the error for the required server port.
 	ErrServerPortRequired = fmt.Errorf("server port is required")
+	// ErrServerRegistrationTokenRequired is the error for the required registration token.
+	ErrServerRegistrationTokenRequired = fmt.Errorf("server registration token is required")
+	// ErrServerRegistrationCertRequired is the error for the required registration certificate.
+	ErrServerRegistrationCertRequired = fmt.Errorf("server registration certificate cert is required")
+	// ErrServerRegistrationKeyRequired is the error for the required registration certificate key.
+	ErrServerRegistrationKeyRequired = fmt.Errorf("server registration certificate key is required")
+	// ErrServerRegistrationRedirectPortRequired is the error for the required redirect port with the registration.
+	ErrServerRegistrationRedirectPortRequired = fmt.Errorf("server redirect port is required for oauth2 when the registration is enabled")
 	// ErrLoggingOutputTypeRequired is the error for the required logging output type.
 	ErrLoggingOutputTypeRequired = fmt.Errorf("logging output type is required")
 	// ErrLoggingOutputFormatRequired is the error for the required logging output format.
@@ -201,10 +209,54 @@ var (
 	ErrLoaderBasePathRequired = fmt.Errorf("loader base path is required")
 	// ErrSlaveSettingPortRequired is the error for the required slave setting port.
 	ErrSlaveSettingPortRequired = fmt.Errorf("slave setting port is required")
+	// ErrSlaveSettingCapacityInvalid is the error for the invalid slave setting capacity.
+	ErrSlaveSettingCapacityInvalid = fmt.Errorf("slave setting capacity must be greater than 0")
 	// ErrSlaveCertificateSlaveCertPathRequired is the error for the required slave certificate slave certificate path.
 	ErrSlaveCertificateSlaveCertPathRequired = fmt.Errorf("slave certificate slave certificate path is required")
 	// ErrSlaveCertificateSlaveKeyPathRequired is the error for the required slave certificate slave key path.
 	ErrSlaveCertificateSlaveKeyPathRequired = fmt.Errorf("slave certificate slave key path is required")
 	// ErrSlaveSettingEncryptIDRequired is the error for the required slave setting encrypt ID.
 	ErrSlaveSettingEncryptIDRequired = fmt.Errorf("slave setting encrypt ID is required")
+	// ErrSlaveRegisterMasterAddressRequired is the error for the required slave register master address.
+	ErrSlaveRegisterMasterAddressRequired = fmt.Errorf("slave register master address is required")
+	// ErrSlaveRegisterCapacityInvalid is the error for the invalid slave register capacity.
+	ErrSlaveRegisterCapacityInvalid = fmt.Errorf("slave register capacity must be greater than 0")
+	// ErrSlaveRegisterIntervalInvalid is the error for the invalid slave register interval.
+	ErrSlaveRegisterIntervalInvalid = fmt.Errorf("slave register interval is invalid")
+	// ErrSlaveRegisterTokenRequired is the error for the required slave register token.
+	ErrSlaveRegisterTokenRequired = fmt.Errorf("slave register token is required")
+	// ErrSlaveRegisterCACertRequired is the error for the required slave register CA certificate.
+	ErrSlaveRegisterCACertRequired = fmt.Errorf("slave register certificate ca_cert is required")
+	// ErrSlaveRegisterClientCertInvalid is the error for the client certificate without the key or the key without the certificate.
+	ErrSlaveRegisterClientCertInvalid = fmt.Errorf("slave register certificate client_cert and client_key must be set together")
+	// ErrSlaveAuthTokenRequired is the error for the required slave auth token.
+	ErrSlaveAuthTokenRequired = fmt.Errorf("slave auth token is required")
+	// ErrSlaveSettingMetricsPortConflict is the error for the slave metrics port same as the slave port.
+	ErrSlaveSettingMetricsPortConflict = fmt.Errorf("slave setting metrics port must differ from the slave port")
+	// ErrServerMetricsPortConflict is the error for the server metrics port shared with the registration server.
+	ErrServerMetricsPortConflict = fmt.Errorf("server metrics port must differ from the server port when the registration is enabled")
+	// ErrServerMetricsRedirectPortRequired is the error for the required redirect port with the metrics on the server port.
+	ErrServerMetricsRedirectPortRequired = fmt.Errorf("server redirect port is required for oauth2 when the metrics are served on the server port")
+	// ErrMetricsPortRequired is the error for the required metrics port.
+	ErrMetricsPortRequired = fmt.Errorf("metrics port is required")
+	// ErrMetricsPathInvalid is the error for the invalid metrics path.
+	ErrMetricsPathInvalid = fmt.Errorf("metrics path must start with /")
+	// ErrMetricsLabelInvalid is the error for the invalid metrics label.
+	ErrMetricsLabelInvalid = fmt.Errorf("metrics label name is invalid or reserved")
+	// ErrMetricsRemoteWriteURLRequired is the error for the required metrics remote write URL.
+	ErrMetricsRemoteWriteURLRequired = fmt.Errorf("metrics remote write URL is required")
+	// ErrMetricsRemoteWriteIntervalInvalid is the error for the invalid metrics remote write interval.
+	ErrMetricsRemoteWriteIntervalInvalid = fmt.Errorf("metrics remote write interval must be a duration of at least 1s")
+	// ErrTracingExporterRequired is the error for the required tracing exporter.
+	ErrTracingExporterRequired = fmt.Errorf("tracing exporter is required")
+	// ErrTracingExporterInvalid is the error for the invalid tracing exporter.
+	ErrTracingExporterInvalid = fmt.Errorf("tracing exporter is invalid")
+	// ErrTracingOTLPProtocolInvalid is the error for the invalid tracing otlp protocol.
+	ErrTracingOTLPProtocolInvalid = fmt.Errorf("tracing otlp protocol is invalid")
+	// ErrTracingOTLPEndpointInvalid is the error for the invalid tracing otlp endpoint.
+	ErrTracingOTLPEndpointInvalid = fmt.Errorf("tracing otlp endpoint must be a http or https URL")
+	// ErrTracingFilePathRequired is the error for the required tracing file path.
+	ErrTracingFilePathRequired = fmt.Errorf("tracing file path is required")
+	// ErrTracingSampleRatioInvalid is the error for the invalid tracing sample ratio.
+	ErrTracingSampleRatioInvalid = fmt.Errorf("tracing sample ratio must be between 0 and 1")
 )
@@ -0,0 +1,118 @@
+package config
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultMetricsPath represents the default path of the metrics endpoint
+	DefaultMetricsPath = "/metrics"
+	// DefaultMetricsRemoteWriteInterval represents the default interval of the remote write
+	DefaultMetricsRemoteWriteInterval = 15 * time.Second
+	// MinMetricsRemoteWriteInterval represents the minimum interval of the remote write
+	MinMetricsRemoteWriteInterval = time.Second
+)
+
+// metricsLabelPattern represents the valid name of the Prometheus label
+var metricsLabelPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// metricsReservedLabels represents the label names used by the metrics of the requests
+var metricsReservedLabels = map[string]struct{}{
+	"target":      {},
+	"endpoint":    {},
+	"status_code": {},
+	"success":     {},
+	"runner":      {},
+	"type":        {},
+	"le":          {},
+	"quantile":    {},
+}
+
+// MetricsConfig represents the configuration for the Prometheus metrics of the node
+type MetricsConfig struct {
+	Enabled     bool                     `mapstructure:"enabled"`
+	Port        *int                     `mapstructure:"port"`
+	Path        *string                  `mapstructure:"path"`
+	Labels      map[string]string        `mapstructure:"labels"`
+	RemoteWrite MetricsRemoteWriteConfig `mapstructure:"remote_write"`
+}
+
+// MetricsRemoteWriteConfig represents the configuration for pushing the metrics to the remote write endpoint
+type MetricsRemoteWriteConfig struct {
+	Enabled  bool              `mapstructure:"enabled"`
+	URL      *string           `mapstructure:"url"`
+	Interval *string           `mapstructure:"interval"`
+	Headers  map[string]string `mapstructure:"headers"`
+}
+
+// ValidMetricsConfig represents the valid metrics configuration.
+// Enabled is whether the metrics endpoint is served.
+type ValidMetricsConfig struct {
+	Enabled     bool
+	Port        int
+	Path        string
+	Labels      map[string]string
+	RemoteWrite ValidMetricsRemoteWriteConfig
+}
+
+// ValidMetricsRemoteWriteConfig represents the valid remote write configuration
+type ValidMetricsRemoteWriteConfig struct {
+	Enabled  bool
+	URL      string
+	Interval time.Duration
+	Headers  map[string]string
+}
+
+// Active returns whether the metrics are collected, to be served or pushed
+func (c ValidMetricsConfig) Active() bool {
+	return c.Enabled || c.RemoteWrite.Enabled
+}
+
+// Validate validates the metrics configuration.
+// The port defaults to the defaultPort, and is required when the defaultPort is 0.
+func (c MetricsConfig) Validate(defaultPort int) (ValidMetricsConfig, error) {
+	var valid ValidMetricsConfig
+	if c.Enabled {
+		valid.Enabled = true
+		valid.Port = defaultPort
+		if c.Port != nil {
+			valid.Port = *c.Port
+		}
+		if valid.Port == 0 {
+			return ValidMetricsConfig{}, ErrMetricsPortRequired
+		}
+		valid.Path = DefaultMetricsPath
+		if c.Path != nil {
+			if !strings.HasPrefix(*c.Path, "/") {
+				return ValidMetricsConfig{}, ErrMetricsPathInvalid
+			}
+			valid.Path = *c.Path
+		}
+	}
+	for name := range c.Labels {
+		if _, ok := metricsReservedLabels[name]; ok ||
+			!metricsLabelPattern.MatchString(name) || strings.HasPrefix(name, "__") {
+			return ValidMetricsConfig{}, ErrMetricsLabelInvalid
+		}
+	}
+	valid.Labels = c.Labels
+	if c.RemoteWrite.Enabled {
+		valid.RemoteWrite.Enabled = true
+		if c.RemoteWrite.URL == nil {
+			return ValidMetricsConfig{}, ErrMetricsRemoteWriteURLRequired
+		}
+		valid.RemoteWrite.URL = *c.RemoteWrite.URL
+		valid.RemoteWrite.Interval = DefaultMetricsRemoteWriteInterval
+		if c.RemoteWrite.Interval != nil {
+			interval, err := time.ParseDuration(*c.RemoteWrite.Interval)
+			if err != nil || interval < MinMetricsRemoteWriteInterval {
+				return ValidMetricsConfig{}, ErrMetricsRemoteWriteIntervalInvalid
+			}
+			valid.RemoteWrite.Interval = interval
+		}
+		valid.RemoteWrite.Headers = c.RemoteWrite.Headers
+	}
+	return valid, nil
+}
@@ -8,6 +8,8 @@ type OutputType string
 const (
 	// OutputTypeLocal represents the Local output service
 	OutputTypeLocal OutputType = "local"
+	// OutputTypeSQLite represents the SQLite output service
+	OutputTypeSQLite OutputType = "sqlite"
 )
 
 // OutputFormat represents the format of the output service
@@ -16,6 +18,10 @@ type OutputFormat string
 const (
 	// OutputFormatCSV represents the CSV output service
 	OutputFormatCSV OutputFormat = "csv"
+	// OutputFormatJSONL represents the JSON Lines output service
+	OutputFormatJSONL OutputFormat = "jsonl"
+	// OutputFormatParquet represents the Parquet output service
+	OutputFormatParquet OutputFormat = "parquet"
 )
 
 // OutputRespectiveValueConfig represents the configuration for the output respective service value
@@ -52,8 +58,8 @@ func (c OutputRespectiveValueConfig) Validate() (ValidOutputRespectiveValueConfi
 			return ValidOutputRespectiveValueConfig{}, ErrOutputValueFormatRequired
 		}
 		switch OutputFormat(*c.Format) {
-		case OutputFormatCSV:
-			valid.Format = OutputFormatCSV
+		case OutputFormatCSV, OutputFormatJSONL, OutputFormatParquet:
+			valid.Format = OutputFormat(*c.Format)
 		default:
 			return ValidOutputRespectiveValueConfig{}, ErrOutputValueFormatInvalid
 		}
@@ -61,6 +67,12 @@ func (c OutputRespectiveValueConfig) Validate() (ValidOutputRespectiveValueConfi
 			return ValidOutputRespectiveValueConfig{}, ErrOutputValueBasePathRequired
 		}
 		valid.BasePath = *c.BasePath
+	case OutputTypeSQLite:
+		valid.Type = OutputTypeSQLite
+		if c.BasePath == nil {
+			return ValidOutputRespectiveValueConfig{}, ErrOutputValueBasePathRequired
+		}
+		valid.BasePath = *c.BasePath
 	default:
 		return ValidOutputRespectiveValueConfig{}, ErrOutputValueTypeInvalid
 	}
@@ -2,8 +2,10 @@ package config
 
 // ServerConfig represents the server configuration
 type ServerConfig struct {
-	Port         *int `mapstructure:"port"`
-	RedirectPort *int `mapstructure:"redirect_port"`
+	Port         *int                     `mapstructure:"port"`
+	RedirectPort *int                     `mapstructure:"redirect_port"`
+	Registration ServerRegistrationConfig `mapstructure:"registration"`
+	Metrics      MetricsConfig            `mapstructure:"metrics"`
 }
 
 // ValidServerConfig represents the valid server configuration
@@ -13,6 +15,8 @@ type ValidServerConfig struct {
 		Enabled bool
 		Port    int
 	}
+	Registration ValidServerRegistrationConfig
+	Metrics      ValidMetricsConfig
 }
 
 // Validate validates the server configuration
@@ -26,5 +30,73 @@ func (s ServerConfig) Validate() (ValidServerConfig, error) {
 		valid.RedirectPort.Enabled = true
 		valid.RedirectPort.Port = *s.RedirectPort
 	}
+	validRegistration, err := s.Registration.Validate()
+	if err != nil {
+		return ValidServerConfig{}, err
+	}
+	valid.Registration = validRegistration
+	validMetrics, err := s.Metrics.Validate(valid.Port)
+	if err != nil {
+		return ValidServerConfig{}, err
+	}
+	if valid.Registration.Enabled && validMetrics.Enabled && validMetrics.Port == valid.Port {
+		return ValidServerConfig{}, ErrServerMetricsPortConflict
+	}
+	valid.Metrics = validMetrics
+	return valid, nil
+}
+
+// ServerRegistrationConfig represents the configuration for the slave registration endpoint
+type ServerRegistrationConfig struct {
+	Enabled     bool                                `mapstructure:"enabled"`
+	Token       *string                             `mapstructure:"token"`
+	Certificate ServerRegistrationCertificateConfig `mapstructure:"certificate"`
+}
+
+// ServerRegistrationCertificateConfig represents the TLS certificate of the slave registration endpoint
+type ServerRegistrationCertificateConfig struct {
+	Cert         *string `mapstructure:"cert"`
+	Key          *string `mapstructure:"key"`
+	ClientCACert *string `mapstructure:"client_ca_cert"`
+}
+
+// ValidServerRegistrationConfig represents the valid slave registration endpoint configuration
+type ValidServerRegistrationConfig struct {
+	Enabled     bool
+	Token       string
+	Certificate ValidServerRegistrationCertificateConfig
+}
+
+// ValidServerRegistrationCertificateConfig represents the valid TLS certificate of the slave registration endpoint.
+// ClientCACert is set when the client certificate of the slaves is required.
+type ValidServerRegistrationCertificateConfig struct {
+	Cert         string
+	Key          string
+	ClientCACert string
+}
+
+// Validate validates the slave registration endpoint configuration.
+// The token and the certificate are required, so that the token is never sent in plaintext.
+func (c ServerRegistrationConfig) Validate() (ValidServerRegistrationConfig, error) {
+	var valid ValidServerRegistrationConfig
+	if !c.Enabled {
+		return valid, nil
+	}
+	valid.Enabled = true
+	if c.Token == nil || *c.Token == "" {
+		return ValidServerRegistrationConfig{}, ErrServerRegistrationTokenRequired
+	}
+	valid.Token = *c.Token
+	if c.Certificate.Cert == nil {
+		return ValidServerRegistrationConfig{}, ErrServerRegistrationCertRequired
+	}
+	valid.Certificate.Cert = *c.Certificate.Cert
+	if c.Certificate.Key == nil {
+		return ValidServerRegistrationConfig{}, ErrServerRegistrationKeyRequired
+	}
+	valid.Certificate.Key = *c.Certificate.Key
+	if c.Certificate.ClientCACert != nil {
+		valid.Certificate.ClientCACert = *c.Certificate.ClientCACert
+	}
 	return valid, nil
 }
@@ -1,17 +1,39 @@
 package config
 
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+)
+
+const (
+	// DefaultSlaveRegisterCapacity represents the default capacity registered on the master
+	DefaultSlaveRegisterCapacity = 1
+	// DefaultSlaveRegisterInterval represents the default interval of the registration refresh
+	DefaultSlaveRegisterInterval = 10 * time.Second
+)
+
 // SlaveSettingConfig represents the configuration for the slave setting
 type SlaveSettingConfig struct {
 	Port        *int                    `mapstructure:"port"`
+	Capacity    *int                    `mapstructure:"capacity"`
 	Certificate SlaveCertificateConfig  `mapstructure:"certificate"`
 	Encrypt     CredentialEncryptConfig `mapstructure:"encrypt"`
+	Register    SlaveRegisterConfig     `mapstructure:"register"`
+	Auth        SlaveAuthConfig         `mapstructure:"auth"`
+	Metrics     MetricsConfig           `mapstructure:"metrics"`
 }
 
 // ValidSlaveSettingConfig represents the valid slave setting configuration
 type ValidSlaveSettingConfig struct {
 	Port        int
+	Capacity    int
 	Certificate ValidSlaveCertificateConfig
 	Encrypt     ValidCredentialEncryptConfig
+	Register    ValidSlaveRegisterConfig
+	Auth        ValidSlaveAuthConfig
+	Metrics     ValidMetricsConfig
 }
 
 // Validate validates the slave setting configuration.
@@ -22,6 +44,13 @@ func (c SlaveSettingConfig) Validate() (ValidSlaveSettingConfig, error) {
 		return ValidSlaveSettingConfig{}, ErrSlaveSettingPortRequired
 	}
 	valid.Port = *c.Port
+	valid.Capacity = runtime.NumCPU()
+	if c.Capacity != nil {
+		if *c.Capacity <= 0 {
+			return ValidSlaveSettingConfig{}, ErrSlaveSettingCapacityInvalid
+		}
+		valid.Capacity = *c.Capacity
+	}
 	valid.Certificate, err = c.Certificate.Validate()
 	if err != nil {
 		return ValidSlaveSettingConfig{}, err
@@ -33,21 +62,169 @@ func (c SlaveSettingConfig) Validate() (ValidSlaveSettingConfig, error) {
 		valid.Encrypt.Enabled = c.Encrypt.Enabled
 		valid.Encrypt.EncryptID = *c.Encrypt.EncryptID
 	}
+	valid.Register, err = c.Register.Validate(valid.Port)
+	if err != nil {
+		return ValidSlaveSettingConfig{}, err
+	}
+	valid.Auth, err = c.Auth.Validate()
+	if err != nil {
+		return ValidSlaveSettingConfig{}, err
+	}
+	valid.Metrics, err = c.Metrics.Validate(0)
+	if err != nil {
+		return ValidSlaveSettingConfig{}, err
+	}
+	if valid.Metrics.Enabled && valid.Metrics.Port == valid.Port {
+		return ValidSlaveSettingConfig{}, ErrSlaveSettingMetricsPortConflict
+	}
+	return valid, nil
+}
+
+// SlaveAuthConfig represents the configuration for the authentication of the master on the slave
+type SlaveAuthConfig struct {
+	Enabled bool    `mapstructure:"enabled"`
+	Token   *string `mapstructure:"token"`
+}
+
+// ValidSlaveAuthConfig represents the valid slave authentication configuration
+type ValidSlaveAuthConfig struct {
+	Enabled bool
+	Token   string
+}
+
+// Validate validates the slave authentication configuration.
+func (c SlaveAuthConfig) Validate() (ValidSlaveAuthConfig, error) {
+	var valid ValidSlaveAuthConfig
+	if !c.Enabled {
+		return valid, nil
+	}
+	if c.Token == nil || *c.Token == "" {
+		return ValidSlaveAuthConfig{}, ErrSlaveAuthTokenRequired
+	}
+	valid.Enabled = true
+	valid.Token = *c.Token
+	return valid, nil
+}
+
+// SlaveRegisterConfig represents the configuration for the registration on the master
+type SlaveRegisterConfig struct {
+	Enabled       bool                           `mapstructure:"enabled"`
+	MasterAddress *string                        `mapstructure:"master_address"`
+	ID            *string                        `mapstructure:"id"`
+	URI           *string                        `mapstructure:"uri"`
+	Labels        map[string]string              `mapstructure:"labels"`
+	Capacity      *int                           `mapstructure:"capacity"`
+	Interval      *string                        `mapstructure:"interval"`
+	Token         *string                        `mapstructure:"token"`
+	Certificate   SlaveRegisterCertificateConfig `mapstructure:"certificate"`
+}
+
+// SlaveRegisterCertificateConfig represents the TLS configuration of the connection to the registration endpoint
+type SlaveRegisterCertificateConfig struct {
+	CACert             *string `mapstructure:"ca_cert"`
+	ServerNameOverride *string `mapstructure:"server_name_override"`
+	ClientCert         *string `mapstructure:"client_cert"`
+	ClientKey          *string `mapstructure:"client_key"`
+}
+
+// ValidSlaveRegisterConfig represents the valid registration configuration
+type ValidSlaveRegisterConfig struct {
+	Enabled       bool
+	MasterAddress string
+	ID            string
+	URI           string
+	Labels        map[string]string
+	Capacity      int
+	Interval      time.Duration
+	Token         string
+	Certificate   ValidSlaveRegisterCertificateConfig
+}
+
+// ValidSlaveRegisterCertificateConfig represents the valid TLS configuration of the connection to the registration endpoint.
+// ClientCert and ClientKey are set when the master requires the client certificate.
+type ValidSlaveRegisterCertificateConfig struct {
+	CACert             string
+	ServerNameOverride string
+	ClientCert         string
+	ClientKey          string
+}
+
+// Validate validates the registration configuration.
+// The ID defaults to the hostname, and the URI defaults to the hostname and the slave port.
+// The token and the CA certificate are required, so that the token is never sent in plaintext.
+func (c SlaveRegisterConfig) Validate(port int) (ValidSlaveRegisterConfig, error) {
+	var valid ValidSlaveRegisterConfig
+	if !c.Enabled {
+		return valid, nil
+	}
+	valid.Enabled = true
+	if c.MasterAddress == nil {
+		return ValidSlaveRegisterConfig{}, ErrSlaveRegisterMasterAddressRequired
+	}
+	valid.MasterAddress = *c.MasterAddress
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "localhost"
+	}
+	valid.ID = hostname
+	if c.ID != nil {
+		valid.ID = *c.ID
+	}
+	valid.URI = fmt.Sprintf("dns:%s:%d", hostname, port)
+	if c.URI != nil {
+		valid.URI = *c.URI
+	}
+	valid.Labels = c.Labels
+	valid.Capacity = DefaultSlaveRegisterCapacity
+	if c.Capacity != nil {
+		if *c.Capacity <= 0 {
+			return ValidSlaveRegisterConfig{}, ErrSlaveRegisterCapacityInvalid
+		}
+		valid.Capacity = *c.Capacity
+	}
+	valid.Interval = DefaultSlaveRegisterInterval
+	if c.Interval != nil {
+		valid.Interval, err = time.ParseDuration(*c.Interval)
+		if err != nil || valid.Interval <= 0 {
+			return ValidSlaveRegisterConfig{}, ErrSlaveRegisterIntervalInvalid
+		}
+	}
+	if c.Token == nil || *c.Token == "" {
+		return ValidSlaveRegisterConfig{}, ErrSlaveRegisterTokenRequired
+	}
+	valid.Token = *c.Token
+	if c.Certificate.CACert == nil {
+		return ValidSlaveRegisterConfig{}, ErrSlaveRegisterCACertRequired
+	}
+	valid.Certificate.CACert = *c.Certificate.CACert
+	if c.Certificate.ServerNameOverride != nil {
+		valid.Certificate.ServerNameOverride = *c.Certificate.ServerNameOverride
+	}
+	switch {
+	case c.Certificate.ClientCert != nil && c.Certificate.ClientKey != nil:
+		valid.Certificate.ClientCert = *c.Certificate.ClientCert
+		valid.Certificate.ClientKey = *c.Certificate.ClientKey
+	case c.Certificate.ClientCert != nil || c.Certificate.ClientKey != nil:
+		return ValidSlaveRegisterConfig{}, ErrSlaveRegisterClientCertInvalid
+	}
 	return valid, nil
 }
 
 // SlaveCertificateConfig represents the configuration for the slave certificate
 type SlaveCertificateConfig struct {
-	Enabled   bool    `mapstructure:"enabled"`
-	SlaveCert *string `mapstructure:"slave_cert"`
-	SlaveKey  *string `mapstructure:"slave_key"`
+	Enabled      bool    `mapstructure:"enabled"`
+	SlaveCert    *string `mapstructure:"slave_cert"`
+	SlaveKey     *string `mapstructure:"slave_key"`
+	ClientCACert *string `mapstructure:"client_ca_cert"`
 }
 
-// ValidSlaveCertificateConfig represents the valid slave certificate configuration
+// ValidSlaveCertificateConfig represents the valid slave certificate configuration.
+// ClientCACert is set when the client certificate of the master is required.
 type ValidSlaveCertificateConfig struct {
-	Enabled   bool
-	SlaveCert string
-	SlaveKey  string
+	Enabled      bool
+	SlaveCert    string
+	SlaveKey     string
+	ClientCACert string
 }
 
 // Validate validates the slave certificate configuration.
@@ -62,6 +239,9 @@ func (c SlaveCertificateConfig) Validate() (ValidSlaveCertificateConfig, error)
 			return ValidSlaveCertificateConfig{}, ErrSlaveCertificateSlaveKeyPathRequired
 		}
 		valid.SlaveKey = *c.SlaveKey
+		if c.ClientCACert != nil {
+			valid.ClientCACert = *c.ClientCACert
+		}
 	}
 	valid.Enabled = c.Enabled
 	return valid, nil
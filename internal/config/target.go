@@ -8,6 +8,12 @@ type TargetType string
 const (
 	// TargetTypeHTTP represents the HTTP target service
 	TargetTypeHTTP TargetType = "http"
+	// TargetTypeGRPC represents the gRPC target service
+	TargetTypeGRPC TargetType = "grpc"
+	// TargetTypeTCP represents the raw TCP target service
+	TargetTypeTCP TargetType = "tcp"
+	// TargetTypeUDP represents the raw UDP target service
+	TargetTypeUDP TargetType = "udp"
 )
 
 // TargetRespectiveValueConfig represents the configuration for the target respective service value
@@ -79,6 +85,12 @@ func (c TargetConfig) Validate() (ValidTargetConfig, error) {
 		switch *target.Type {
 		case string(TargetTypeHTTP):
 			validRespective.Type = TargetTypeHTTP
+		case string(TargetTypeGRPC):
+			validRespective.Type = TargetTypeGRPC
+		case string(TargetTypeTCP):
+			validRespective.Type = TargetTypeTCP
+		case string(TargetTypeUDP):
+			validRespective.Type = TargetTypeUDP
 		default:
 			return ValidTargetConfig{}, fmt.Errorf("target[%d].type: %w", i, ErrTargetTypeInvalid)
 		}
@@ -0,0 +1,130 @@
+package config
+
+import "net/url"
+
+// TracingExporterType represents the type of the exporter of the traces
+type TracingExporterType string
+
+const (
+	// TracingExporterTypeOTLP represents the OTLP exporter
+	TracingExporterTypeOTLP TracingExporterType = "otlp"
+	// TracingExporterTypeFile represents the exporter writing the spans to a JSON Lines file
+	TracingExporterTypeFile TracingExporterType = "file"
+)
+
+// TracingOTLPProtocol represents the protocol of the OTLP exporter
+type TracingOTLPProtocol string
+
+const (
+	// TracingOTLPProtocolGRPC represents the OTLP over gRPC
+	TracingOTLPProtocolGRPC TracingOTLPProtocol = "grpc"
+	// TracingOTLPProtocolHTTP represents the OTLP over HTTP with protobuf
+	TracingOTLPProtocolHTTP TracingOTLPProtocol = "http"
+)
+
+const (
+	// DefaultTracingServiceName represents the default service name of the traces
+	DefaultTracingServiceName = "bloader"
+	// DefaultTracingSampleRatio represents the default ratio of the sampled requests
+	DefaultTracingSampleRatio = 1.0
+	// DefaultTracingOTLPProtocol represents the default protocol of the OTLP exporter
+	DefaultTracingOTLPProtocol = TracingOTLPProtocolHTTP
+)
+
+// TracingConfig represents the configuration for the OpenTelemetry traces of the requests
+type TracingConfig struct {
+	Enabled     bool              `mapstructure:"enabled"`
+	Exporter    *string           `mapstructure:"exporter"`
+	ServiceName *string           `mapstructure:"service_name"`
+	SampleRatio *float64          `mapstructure:"sample_ratio"`
+	OTLP        TracingOTLPConfig `mapstructure:"otlp"`
+	File        TracingFileConfig `mapstructure:"file"`
+}
+
+// TracingOTLPConfig represents the configuration for the OTLP exporter
+type TracingOTLPConfig struct {
+	Endpoint *string           `mapstructure:"endpoint"`
+	Protocol *string           `mapstructure:"protocol"`
+	Headers  map[string]string `mapstructure:"headers"`
+}
+
+// TracingFileConfig represents the configuration for the file exporter
+type TracingFileConfig struct {
+	Path *string `mapstructure:"path"`
+}
+
+// ValidTracingConfig represents the valid tracing configuration
+type ValidTracingConfig struct {
+	Enabled     bool
+	Exporter    TracingExporterType
+	ServiceName string
+	SampleRatio float64
+	OTLP        ValidTracingOTLPConfig
+	File        ValidTracingFileConfig
+}
+
+// ValidTracingOTLPConfig represents the valid OTLP exporter configuration.
+// The empty endpoint leaves it to the OTEL_EXPORTER_OTLP_* environment variables or the default of the protocol.
+type ValidTracingOTLPConfig struct {
+	Endpoint string
+	Protocol TracingOTLPProtocol
+	Headers  map[string]string
+}
+
+// ValidTracingFileConfig represents the valid file exporter configuration
+type ValidTracingFileConfig struct {
+	Path string
+}
+
+// Validate validates the tracing configuration
+func (c TracingConfig) Validate() (ValidTracingConfig, error) {
+	var valid ValidTracingConfig
+	if !c.Enabled {
+		return valid, nil
+	}
+	valid.Enabled = true
+	if c.Exporter == nil {
+		return ValidTracingConfig{}, ErrTracingExporterRequired
+	}
+	switch TracingExporterType(*c.Exporter) {
+	case TracingExporterTypeOTLP:
+		valid.Exporter = TracingExporterTypeOTLP
+		valid.OTLP.Protocol = DefaultTracingOTLPProtocol
+		if c.OTLP.Protocol != nil {
+			switch TracingOTLPProtocol(*c.OTLP.Protocol) {
+			case TracingOTLPProtocolGRPC, TracingOTLPProtocolHTTP:
+				valid.OTLP.Protocol = TracingOTLPProtocol(*c.OTLP.Protocol)
+			default:
+				return ValidTracingConfig{}, ErrTracingOTLPProtocolInvalid
+			}
+		}
+		if c.OTLP.Endpoint != nil {
+			u, err := url.Parse(*c.OTLP.Endpoint)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return ValidTracingConfig{}, ErrTracingOTLPEndpointInvalid
+			}
+			valid.OTLP.Endpoint = *c.OTLP.Endpoint
+		}
+		valid.OTLP.Headers = c.OTLP.Headers
+	case TracingExporterTypeFile:
+		valid.Exporter = TracingExporterTypeFile
+		if c.File.Path == nil {
+			return ValidTracingConfig{}, ErrTracingFilePathRequired
+		}
+		valid.File.Path = *c.File.Path
+	default:
+		return ValidTracingConfig{}, ErrTracingExporterInvalid
+	}
+	valid.ServiceName = DefaultTracingServiceName
+	if c.ServiceName != nil {
+		valid.ServiceName = *c.ServiceName
+	}
+	valid.SampleRatio = DefaultTracingSampleRatio
+	if c.SampleRatio != nil {
+		if *c.SampleRatio < 0 || *c.SampleRatio > 1 {
+			return ValidTracingConfig{}, ErrTracingSampleRatioInvalid
+		}
+		valid.SampleRatio = *c.SampleRatio
+	}
+	return valid, nil
+}
@@ -0,0 +1,188 @@
+package grpcexec
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/grpc"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// DescriptorSource resolves the method descriptors of the gRPC services
+type DescriptorSource interface {
+	// FindMethod finds the method descriptor of the service
+	FindMethod(ctx context.Context, service, method string) (protoreflect.MethodDescriptor, error)
+}
+
+type descriptorResolver interface {
+	FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error)
+}
+
+// staticSource represents the descriptor source built from local files
+type staticSource struct {
+	resolver descriptorResolver
+}
+
+// NewProtoFileSource creates a new DescriptorSource by compiling the .proto files
+func NewProtoFileSource(ctx context.Context, protoFiles, importPaths []string) (DescriptorSource, error) {
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			ImportPaths: importPaths,
+		}),
+	}
+	files, err := compiler.Compile(ctx, protoFiles...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile proto files: %w", err)
+	}
+	return staticSource{resolver: files.AsResolver()}, nil
+}
+
+// NewDescriptorSetSource creates a new DescriptorSource from the descriptor set file (protoc --descriptor_set_out)
+func NewDescriptorSetSource(path string) (DescriptorSource, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read descriptor set: %w", err)
+	}
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal descriptor set: %w", err)
+	}
+	files, err := protodesc.NewFiles(&set)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create files from descriptor set: %w", err)
+	}
+	return staticSource{resolver: files}, nil
+}
+
+// FindMethod finds the method descriptor of the service
+func (s staticSource) FindMethod(_ context.Context, service, method string) (protoreflect.MethodDescriptor, error) {
+	return findMethod(s.resolver, service, method)
+}
+
+func findMethod(resolver descriptorResolver, service, method string) (protoreflect.MethodDescriptor, error) {
+	desc, err := resolver.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find service %s: %w", service, err)
+	}
+	sd, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", service)
+	}
+	md := sd.Methods().ByName(protoreflect.Name(method))
+	if md == nil {
+		return nil, fmt.Errorf("method %s not found in service %s", method, service)
+	}
+	return md, nil
+}
+
+// reflectionSource represents the descriptor source resolved by the server reflection
+type reflectionSource struct {
+	client rpb.ServerReflectionClient
+	mu     sync.Mutex
+	cache  map[string]descriptorResolver
+}
+
+// NewReflectionSource creates a new DescriptorSource which uses the server reflection of the connection
+func NewReflectionSource(conn grpc.ClientConnInterface) DescriptorSource {
+	return &reflectionSource{
+		client: rpb.NewServerReflectionClient(conn),
+		cache:  make(map[string]descriptorResolver),
+	}
+}
+
+// FindMethod finds the method descriptor of the service, the resolved files are cached per service
+func (s *reflectionSource) FindMethod(
+	ctx context.Context,
+	service, method string,
+) (protoreflect.MethodDescriptor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resolver, ok := s.cache[service]
+	if !ok {
+		var err error
+		if resolver, err = s.resolve(ctx, service); err != nil {
+			return nil, err
+		}
+		s.cache[service] = resolver
+	}
+	return findMethod(resolver, service, method)
+}
+
+// resolve fetches the file containing the service and all of its dependencies
+func (s *reflectionSource) resolve(ctx context.Context, service string) (descriptorResolver, error) {
+	stream, err := s.client.ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start server reflection: %w", err)
+	}
+	defer func() {
+		_ = stream.CloseSend()
+	}()
+
+	files := make(map[string]*descriptorpb.FileDescriptorProto)
+	requested := make(map[string]struct{})
+	var ordered []*descriptorpb.FileDescriptorProto
+	request := &rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{
+			FileContainingSymbol: service,
+		},
+	}
+	for request != nil {
+		if err := stream.Send(request); err != nil {
+			return nil, fmt.Errorf("failed to send reflection request: %w", err)
+		}
+		res, err := stream.Recv()
+		if err != nil {
+			return nil, fmt.Errorf("failed to receive reflection response: %w", err)
+		}
+		if errRes := res.GetErrorResponse(); errRes != nil {
+			return nil, fmt.Errorf("server reflection error: %s", errRes.GetErrorMessage())
+		}
+		for _, b := range res.GetFileDescriptorResponse().GetFileDescriptorProto() {
+			var fd descriptorpb.FileDescriptorProto
+			if err := proto.Unmarshal(b, &fd); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal file descriptor: %w", err)
+			}
+			if _, ok := files[fd.GetName()]; ok {
+				continue
+			}
+			files[fd.GetName()] = &fd
+			ordered = append(ordered, &fd)
+		}
+
+		request = nil
+		for _, fd := range ordered {
+			for _, dep := range fd.GetDependency() {
+				if _, ok := files[dep]; ok {
+					continue
+				}
+				if _, ok := requested[dep]; ok {
+					return nil, fmt.Errorf("dependency %s not found by server reflection", dep)
+				}
+				requested[dep] = struct{}{}
+				request = &rpb.ServerReflectionRequest{
+					MessageRequest: &rpb.ServerReflectionRequest_FileByFilename{
+						FileByFilename: dep,
+					},
+				}
+				break
+			}
+			if request != nil {
+				break
+			}
+		}
+	}
+
+	resolver, err := protodesc.NewFiles(&descriptorpb.FileDescriptorSet{File: ordered})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create files from reflection: %w", err)
+	}
+	return resolver, nil
+}
@@ -0,0 +1,295 @@
+// Package grpcexec provides the executor for the gRPC request.
+package grpcexec
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/ablankz/bloader/internal/executor/httpexec"
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+const (
+	// SchemeTLS represents the scheme of the target using TLS
+	SchemeTLS = "grpcs://"
+	// SchemePlaintext represents the scheme of the target using plaintext
+	SchemePlaintext = "grpc://"
+
+	// requestTimeout is the timeout of a single call, the same as the HTTP client
+	requestTimeout = 10 * time.Minute
+)
+
+// Request represents the gRPC request
+type Request struct {
+	Service string
+	Method  string
+	// Body is the message decoded from JSON/YAML, a slice of messages for client streaming methods
+	Body     any
+	Metadata metadata.MD
+}
+
+// ExecReq represents the request executor
+type ExecReq interface {
+	// CreateRequest creates the Request object for the call
+	CreateRequest(ctx context.Context, log logger.Logger, count, stage int) (*Request, error)
+}
+
+// Dial creates the client connection to the target.
+// The target prefixed with "grpcs://" uses TLS, otherwise plaintext is used.
+func Dial(target string) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	switch {
+	case strings.HasPrefix(target, SchemeTLS):
+		target = strings.TrimPrefix(target, SchemeTLS)
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	case strings.HasPrefix(target, SchemePlaintext):
+		target = strings.TrimPrefix(target, SchemePlaintext)
+	}
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	return conn, nil
+}
+
+// RequestContent represents the request content
+type RequestContent[Req ExecReq] struct {
+	Req    Req
+	Conn   grpc.ClientConnInterface
+	Source DescriptorSource
+	// Count is passed to the request creation, used as the loop count
+	Count int
+}
+
+// RequestExecute executes the request
+func (q RequestContent[Req]) RequestExecute(
+	ctx context.Context,
+	log logger.Logger,
+) (httpexec.ResponseContent, error) {
+	req, err := q.Req.CreateRequest(ctx, log, q.Count, 0)
+	if err != nil {
+		log.Error(ctx, "failed to create request",
+			logger.Value("error", err), logger.Value("on", "RequestContent.RequestExecute"))
+		return httpexec.ResponseContent{}, fmt.Errorf("failed to create request: %w", err)
+	}
+	md, err := q.Source.FindMethod(ctx, req.Service, req.Method)
+	if err != nil {
+		return httpexec.ResponseContent{}, fmt.Errorf("failed to find method: %w", err)
+	}
+	return invoke(ctx, log, q.Conn, md, req), nil
+}
+
+// fullMethodName returns the method name used on the wire, such as "/pkg.Service/Method"
+func fullMethodName(md protoreflect.MethodDescriptor) string {
+	return fmt.Sprintf("/%s/%s", md.Parent().FullName(), md.Name())
+}
+
+// newMessages converts the body into the input messages of the method
+func newMessages(md protoreflect.MethodDescriptor, body any) ([]proto.Message, error) {
+	bodies := []any{body}
+	if md.IsStreamingClient() {
+		arr, ok := body.([]any)
+		if !ok && body != nil {
+			return nil, fmt.Errorf("body of the client streaming method must be an array")
+		}
+		bodies = arr
+	}
+	messages := make([]proto.Message, 0, len(bodies))
+	for _, b := range bodies {
+		msg := dynamicpb.NewMessage(md.Input())
+		if b != nil {
+			data, err := json.Marshal(b)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal body: %w", err)
+			}
+			if err := protojson.Unmarshal(data, msg); err != nil {
+				return nil, fmt.Errorf("failed to convert body into %s: %w", md.Input().FullName(), err)
+			}
+		}
+		messages = append(messages, msg)
+	}
+	return messages, nil
+}
+
+// call sends the messages and receives the replies of the method
+func call(
+	ctx context.Context,
+	conn grpc.ClientConnInterface,
+	md protoreflect.MethodDescriptor,
+	messages []proto.Message,
+) ([]proto.Message, error) {
+	if !md.IsStreamingClient() && !md.IsStreamingServer() {
+		reply := dynamicpb.NewMessage(md.Output())
+		if err := conn.Invoke(ctx, fullMethodName(md), messages[0], reply); err != nil {
+			return nil, err
+		}
+		return []proto.Message{reply}, nil
+	}
+
+	stream, err := conn.NewStream(ctx, &grpc.StreamDesc{
+		StreamName:    string(md.Name()),
+		ServerStreams: md.IsStreamingServer(),
+		ClientStreams: md.IsStreamingClient(),
+	}, fullMethodName(md))
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range messages {
+		if err := stream.SendMsg(msg); err != nil {
+			if errors.Is(err, io.EOF) {
+				// the actual error is returned by RecvMsg
+				break
+			}
+			return nil, err
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+	var replies []proto.Message
+	for {
+		reply := dynamicpb.NewMessage(md.Output())
+		if err := stream.RecvMsg(reply); err != nil {
+			if errors.Is(err, io.EOF) {
+				return replies, nil
+			}
+			return replies, err
+		}
+		replies = append(replies, reply)
+	}
+}
+
+// invoke calls the method and converts the result into the ResponseContent.
+// The status code is the gRPC status code, and the reply is decoded as JSON
+// (an array of the replies for server streaming methods).
+func invoke(
+	ctx context.Context,
+	log logger.Logger,
+	conn grpc.ClientConnInterface,
+	md protoreflect.MethodDescriptor,
+	req *Request,
+) httpexec.ResponseContent {
+	messages, err := newMessages(md, req.Body)
+	if err != nil {
+		log.Error(ctx, "failed to create message",
+			logger.Value("error", err), logger.Value("on", "grpcexec.invoke"), logger.Value("method", fullMethodName(md)))
+		return httpexec.ResponseContent{
+			Success:      false,
+			HasSystemErr: true,
+		}
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	if len(req.Metadata) > 0 {
+		callCtx = metadata.NewOutgoingContext(callCtx, req.Metadata)
+	}
+
+	log.Debug(ctx, "sending request",
+		logger.Value("on", "grpcexec.invoke"), logger.Value("method", fullMethodName(md)))
+	startTime := time.Now()
+	replies, err := call(callCtx, conn, md, messages)
+	endTime := time.Now()
+	log.Debug(ctx, "received response",
+		logger.Value("on", "grpcexec.invoke"), logger.Value("method", fullMethodName(md)))
+
+	if err != nil {
+		st, ok := status.FromError(err)
+		if !ok {
+			log.Error(ctx, "response error",
+				logger.Value("error", err), logger.Value("on", "grpcexec.invoke"), logger.Value("method", fullMethodName(md)))
+			return httpexec.ResponseContent{
+				Success:      false,
+				StartTime:    startTime,
+				EndTime:      endTime,
+				ResponseTime: endTime.Sub(startTime).Milliseconds(),
+				HasSystemErr: true,
+			}
+		}
+		log.Debug(ctx, "response status error",
+			logger.Value("error", err), logger.Value("on", "grpcexec.invoke"), logger.Value("method", fullMethodName(md)))
+		return httpexec.ResponseContent{
+			Success: false,
+			Res: map[string]any{
+				"code":    st.Code().String(),
+				"message": st.Message(),
+			},
+			StartTime:    startTime,
+			EndTime:      endTime,
+			ResponseTime: endTime.Sub(startTime).Milliseconds(),
+			StatusCode:   int(st.Code()),
+		}
+	}
+
+	var responseByte []byte
+	if md.IsStreamingServer() {
+		raw := make([]json.RawMessage, 0, len(replies))
+		for _, reply := range replies {
+			b, err := protojson.Marshal(reply)
+			if err != nil {
+				return parseErrorContent(ctx, log, md, err, startTime, endTime)
+			}
+			raw = append(raw, b)
+		}
+		responseByte, err = json.Marshal(raw)
+	} else {
+		responseByte, err = protojson.Marshal(replies[0])
+	}
+	if err != nil {
+		return parseErrorContent(ctx, log, md, err, startTime, endTime)
+	}
+	var response any
+	if err := json.Unmarshal(responseByte, &response); err != nil {
+		return parseErrorContent(ctx, log, md, err, startTime, endTime)
+	}
+
+	log.Debug(ctx, "response OK",
+		logger.Value("on", "grpcexec.invoke"), logger.Value("method", fullMethodName(md)))
+	return httpexec.ResponseContent{
+		Success:      true,
+		ByteResponse: responseByte,
+		Res:          response,
+		StartTime:    startTime,
+		EndTime:      endTime,
+		ResponseTime: endTime.Sub(startTime).Milliseconds(),
+		StatusCode:   int(codes.OK),
+	}
+}
+
+func parseErrorContent(
+	ctx context.Context,
+	log logger.Logger,
+	md protoreflect.MethodDescriptor,
+	err error,
+	startTime, endTime time.Time,
+) httpexec.ResponseContent {
+	log.Error(ctx, "failed to parse response",
+		logger.Value("error", err), logger.Value("on", "grpcexec.invoke"), logger.Value("method", fullMethodName(md)))
+	return httpexec.ResponseContent{
+		Success:        false,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		ResponseTime:   endTime.Sub(startTime).Milliseconds(),
+		StatusCode:     int(codes.OK),
+		ParseResHasErr: true,
+	}
+}
+
+var _ httpexec.RequestExecutor = RequestContent[ExecReq]{} // ensure that RequestContent implements RequestExecutor
@@ -0,0 +1,80 @@
+package grpcexec
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/ablankz/bloader/internal/executor/httpexec"
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+// MassRequestContent represents the request content
+type MassRequestContent[Req ExecReq] struct {
+	Req          Req
+	Conn         grpc.ClientConnInterface
+	Source       DescriptorSource
+	Interval     time.Duration
+	ResponseWait bool
+	ResChan      chan<- httpexec.ResponseContent
+	CountLimit   httpexec.RequestCountLimit
+	ArrivalRate  httpexec.ArrivalRate
+}
+
+// MassRequestExecute executes the request
+func (q MassRequestContent[Req]) MassRequestExecute(
+	ctx context.Context,
+	log logger.Logger,
+) error {
+	httpexec.Schedule{
+		Interval:     q.Interval,
+		ResponseWait: q.ResponseWait,
+		CountLimit:   q.CountLimit,
+		ArrivalRate:  q.ArrivalRate,
+	}.Run(ctx, log, func(count, stage int, countOver bool) {
+		q.send(ctx, log, count, stage, countOver)
+	})
+
+	return nil
+}
+
+// send sends a single request and delivers the response to ResChan
+func (q MassRequestContent[Req]) send(
+	ctx context.Context,
+	log logger.Logger,
+	countInternal int,
+	stageInternal int,
+	countOver bool,
+) {
+	var response httpexec.ResponseContent
+	req, err := q.Req.CreateRequest(ctx, log, countInternal, stageInternal)
+	if err == nil {
+		md, findErr := q.Source.FindMethod(ctx, req.Service, req.Method)
+		if findErr == nil {
+			response = invoke(ctx, log, q.Conn, md, req)
+		}
+		err = findErr
+	}
+	if err != nil {
+		log.Error(ctx, "failed to create request",
+			logger.Value("error", err), logger.Value("on", "MassRequestContent.send"))
+		response = httpexec.ResponseContent{
+			Success:      false,
+			HasSystemErr: true,
+		}
+	}
+	response.Count = countInternal
+	response.Stage = stageInternal
+	response.WithCountLimit = countOver
+
+	select {
+	case q.ResChan <- response:
+	case <-ctx.Done():
+		log.Info(ctx, "request processing is interrupted due to context termination",
+			logger.Value("on", "MassRequestContent.send"))
+		return
+	}
+}
+
+var _ httpexec.MassRequestExecutor = MassRequestContent[ExecReq]{}
@@ -0,0 +1,41 @@
+package httpexec
+
+import (
+	"context"
+	"net/http"
+)
+
+type graphQLOperationKey struct{}
+
+// WithGraphQLOperation marks the request as a GraphQL request of the operation.
+// The response of the marked request is tagged with the operation,
+// and it fails when the errors array of the response is not empty.
+func WithGraphQLOperation(req *http.Request, operation string) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), graphQLOperationKey{}, operation))
+}
+
+// graphQLOperation returns the operation of the request, false if it is not a GraphQL request
+func graphQLOperation(req *http.Request) (string, bool) {
+	operation, ok := req.Context().Value(graphQLOperationKey{}).(string)
+	return operation, ok
+}
+
+// applyGraphQL tags the response with the operation, and marks it as failed if the response has errors
+func applyGraphQL(req *http.Request, content *ResponseContent) {
+	operation, ok := graphQLOperation(req)
+	if !ok {
+		return
+	}
+	content.Operation = operation
+	if !content.Success {
+		return
+	}
+	res, ok := content.Res.(map[string]any)
+	if !ok {
+		return
+	}
+	if errs, ok := res["errors"].([]any); ok && len(errs) > 0 {
+		content.Success = false
+		content.HasGraphQLErr = true
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/tracing"
 	"github.com/ablankz/bloader/internal/utils"
 )
 
@@ -20,6 +21,10 @@ import (
 type RequestContent[Req ExecReq] struct {
 	Req          Req
 	ResponseType ResponseType
+	// Count is passed to the request creation, used as the loop count
+	Count int
+	// Client is the http client to use, the default client is used if nil
+	Client *http.Client
 }
 
 // RequestExecute executes the request
@@ -27,25 +32,33 @@ func (q RequestContent[Req]) RequestExecute(
 	ctx context.Context,
 	log logger.Logger,
 ) (ResponseContent, error) {
-	req, err := q.Req.CreateRequest(ctx, log, 0)
+	req, err := q.Req.CreateRequest(ctx, log, q.Count, 0)
 	if err != nil {
 		log.Error(ctx, "failed to create request",
 			logger.Value("error", err), logger.Value("on", "RequestContent.QueryExecute"))
 		return ResponseContent{}, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	client := &http.Client{
-		Timeout: 10 * time.Minute,
-		Transport: &utils.DelayedTransport{
-			Transport: http.DefaultTransport,
-			// Delay:     2 * time.Second,
-		},
+	client := q.Client
+	if client == nil {
+		client = &http.Client{
+			Timeout: 10 * time.Minute,
+			Transport: &utils.DelayedTransport{
+				Transport: http.DefaultTransport,
+				// Delay:     2 * time.Second,
+			},
+		}
+	}
+
+	var firstByteTime *time.Time
+	if q.ResponseType.IsStream() {
+		req, firstByteTime = withFirstByteTrace(req)
 	}
 
 	log.Debug(ctx, "sending request",
 		logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
 	startTime := time.Now()
-	resp, err := client.Do(req)
+	resp, err := tracing.Do(client, req)
 	endTime := time.Now()
 	log.Debug(ctx, "received response",
 		logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
@@ -63,6 +76,12 @@ func (q RequestContent[Req]) RequestExecute(
 	}
 	defer resp.Body.Close()
 
+	if q.ResponseType.IsStream() {
+		responseContent := readStream(ctx, log, resp, q.ResponseType, startTime, *firstByteTime, nil)
+		applyGraphQL(req, &responseContent)
+		return responseContent, nil
+	}
+
 	statusCode := resp.StatusCode
 	var response any
 	responseByte, err := io.ReadAll(resp.Body)
@@ -110,7 +129,7 @@ func (q RequestContent[Req]) RequestExecute(
 	}
 	log.Debug(ctx, "response OK",
 		logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
-	return ResponseContent{
+	responseContent := ResponseContent{
 		Success:      true,
 		ByteResponse: responseByte,
 		Res:          response,
@@ -118,7 +137,9 @@ func (q RequestContent[Req]) RequestExecute(
 		EndTime:      endTime,
 		ResponseTime: endTime.Sub(startTime).Milliseconds(),
 		StatusCode:   statusCode,
-	}, nil
+	}
+	applyGraphQL(req, &responseContent)
+	return responseContent, nil
 }
 
 var _ RequestExecutor = RequestContent[ExecReq]{} // ensure that RequestContent implements RequestExecutor
@@ -12,6 +12,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/tracing"
 	"github.com/ablankz/bloader/internal/utils"
 )
 
@@ -29,6 +30,21 @@ type MassRequestContent[Req ExecReq] struct {
 	ResChan      chan<- ResponseContent
 	CountLimit   RequestCountLimit
 	ResponseType ResponseType
+	ArrivalRate  ArrivalRate
+}
+
+func newMassClient() *http.Client {
+	return &http.Client{
+		Timeout: 10 * time.Minute,
+		Transport: &utils.DelayedTransport{
+			Transport: &http.Transport{
+				MaxIdleConns:        200,
+				MaxIdleConnsPerHost: 180,
+				IdleConnTimeout:     5 * time.Minute,
+			},
+			// Delay:     2 * time.Second,
+		},
+	}
 }
 
 // MassRequestExecute executes the request
@@ -36,236 +52,249 @@ func (q MassRequestContent[Req]) MassRequestExecute(
 	ctx context.Context,
 	log logger.Logger,
 ) error {
-	go func() {
-		// defer close(q.ResChan) // TODO: close channel
-		waitForResponse := q.ResponseWait
-		var count int
-		var countLimitOver bool
-		chanForWait := make(chan struct{})
-		defer close(chanForWait)
+	client := newMassClient()
+	Schedule{
+		Interval:     q.Interval,
+		ResponseWait: q.ResponseWait,
+		CountLimit:   q.CountLimit,
+		ArrivalRate:  q.ArrivalRate,
+	}.Run(ctx, log, func(count, stage int, countOver bool) {
+		q.send(ctx, log, client, count, stage, countOver)
+	})
 
-		client := &http.Client{
-			Timeout: 10 * time.Minute,
-			Transport: &utils.DelayedTransport{
-				Transport: &http.Transport{
-					MaxIdleConns:        200,
-					MaxIdleConnsPerHost: 180,
-					IdleConnTimeout:     5 * time.Minute,
-				},
-				// Delay:     2 * time.Second,
-			},
-		}
-		ticker := time.NewTicker(q.Interval)
-		defer ticker.Stop()
-
-		for {
-			select {
-			case <-ctx.Done():
-				log.Info(ctx, "request processing is interrupted due to context termination",
-					logger.Value("on", "RequestContent.QueryExecute"))
-				return
-			case <-ticker.C:
-				if count > 0 && waitForResponse {
-					select {
-					case <-ctx.Done():
-						log.Info(ctx, "request processing is interrupted due to context termination",
-							logger.Value("on", "RequestContent.QueryExecute"))
-						return
-					case <-chanForWait:
-					}
-				}
-
-				count++
-				if q.CountLimit.Enabled && count >= q.CountLimit.Count {
-					log.Info(ctx, "request processing is interrupted due to count limit",
-						logger.Value("on", "RequestContent.QueryExecute"))
-					countLimitOver = true
-				}
-
-				go func(countInternal int, countOver bool) {
-					defer func() {
-						if waitForResponse {
-							chanForWait <- struct{}{}
-						}
-					}()
-
-					req, err := q.Req.CreateRequest(ctx, log, countInternal)
-					if err != nil {
-						log.Error(ctx, "failed to create request",
-							logger.Value("error", err), logger.Value("on", "RequestContent.QueryExecute"))
-						select {
-						case <-ctx.Done():
-							log.Info(ctx, "request processing is interrupted due to context termination",
-								logger.Value("on", "RequestContent.QueryExecute"))
-							return
-						case q.ResChan <- ResponseContent{
-							Success:        false,
-							HasSystemErr:   true,
-							WithCountLimit: countLimitOver,
-							Count:          countInternal,
-						}: // do nothing
-						}
+	return nil
+}
 
-						return
-					}
+// send sends a single request and delivers the response to ResChan
+func (q MassRequestContent[Req]) send(
+	ctx context.Context,
+	log logger.Logger,
+	client *http.Client,
+	countInternal int,
+	stageInternal int,
+	countOver bool,
+) {
+	req, err := q.Req.CreateRequest(ctx, log, countInternal, stageInternal)
+	if err != nil {
+		log.Error(ctx, "failed to create request",
+			logger.Value("error", err), logger.Value("on", "RequestContent.QueryExecute"))
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "request processing is interrupted due to context termination",
+				logger.Value("on", "RequestContent.QueryExecute"))
+			return
+		case q.ResChan <- ResponseContent{
+			Success:        false,
+			HasSystemErr:   true,
+			WithCountLimit: countOver,
+			Count:          countInternal,
+			Stage:          stageInternal,
+		}: // do nothing
+		}
 
-					log.Debug(ctx, "sending request",
-						logger.Value("on", "RequestContent.QueryExecute"),
-						logger.Value("url", req.URL),
-						logger.Value("count", countInternal),
-					)
-					startTime := time.Now()
-					resp, err := client.Do(req)
-					endTime := time.Now()
-					log.Debug(ctx, "received response",
-						logger.Value("on", "RequestContent.QueryExecute"),
-						logger.Value("url", req.URL),
-						logger.Value("count", countInternal),
-					)
-					if err != nil {
-						log.Error(ctx, "response error",
-							logger.Value("error", err), logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
-						select {
-						case <-ctx.Done():
-							log.Info(ctx, "request processing is interrupted due to context termination",
-								logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
-							return
-						case q.ResChan <- ResponseContent{
-							Success:        false,
-							StartTime:      startTime,
-							EndTime:        endTime,
-							Count:          countInternal,
-							ResponseTime:   endTime.Sub(startTime).Milliseconds(),
-							HasSystemErr:   true,
-							WithCountLimit: countOver,
-						}: // do nothing
-							log.Error(ctx, "response error",
-								logger.Value("startTime", startTime),
-								logger.Value("endTime", endTime),
-								logger.Value("count", countInternal),
-								logger.Value("responseTime", endTime.Sub(startTime).Milliseconds()),
-								logger.Value("err", err),
-							)
-						}
+		return
+	}
 
-						return
-					}
-					defer resp.Body.Close()
+	operation, _ := graphQLOperation(req)
+	var firstByteTime *time.Time
+	if q.ResponseType.IsStream() {
+		req, firstByteTime = withFirstByteTrace(req)
+	}
 
-					statusCode := resp.StatusCode
-					var response any
-					responseByte, err := io.ReadAll(resp.Body)
-					if err != nil {
-						log.Error(ctx, "failed to read response",
-							logger.Value("error", err), logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
-						select {
-						case <-ctx.Done():
-							log.Info(ctx, "request processing is interrupted due to context termination",
-								logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
-							return
-						case q.ResChan <- ResponseContent{
-							Success:        false,
-							Res:            response,
-							StartTime:      startTime,
-							EndTime:        endTime,
-							Count:          countInternal,
-							ResponseTime:   endTime.Sub(startTime).Milliseconds(),
-							StatusCode:     statusCode,
-							ParseResHasErr: true,
-							WithCountLimit: countOver,
-						}: // do nothing
-							log.Error(ctx, "failed to read response",
-								logger.Value("responseByte", string(responseByte)),
-								logger.Value("response", response),
-								logger.Value("startTime", startTime),
-								logger.Value("endTime", endTime),
-								logger.Value("count", countInternal),
-								logger.Value("statusCode", statusCode),
-								logger.Value("err", err),
-							)
-						}
-						return
-					}
-					switch ResponseType(q.ResponseType) {
-					case ResponseTypeJSON:
-						err = json.Unmarshal(responseByte, &response)
-					case ResponseTypeXML:
-						err = xml.Unmarshal(responseByte, &response)
-					case ResponseTypeYAML:
-						err = yaml.Unmarshal(responseByte, &response)
-					case ResponseTypeText:
-						response = string(responseByte)
-					case ResponseTypeHTML:
-						response = string(responseByte)
-					default:
-						err = fmt.Errorf("invalid response type: %s", q.ResponseType)
-					}
-					if err != nil {
-						log.Error(ctx, "failed to parse response",
-							logger.Value("error", err), logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
-						select {
-						case <-ctx.Done():
-							log.Info(ctx, "request processing is interrupted due to context termination",
-								logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
-							return
-						case q.ResChan <- ResponseContent{
-							Success:        false,
-							Res:            response,
-							ByteResponse:   responseByte,
-							StartTime:      startTime,
-							EndTime:        endTime,
-							Count:          countInternal,
-							ResponseTime:   endTime.Sub(startTime).Milliseconds(),
-							StatusCode:     statusCode,
-							ParseResHasErr: true,
-							WithCountLimit: countOver,
-						}: // do nothing
-							log.Error(ctx, "failed to parse response",
-								logger.Value("responseByte", string(responseByte)),
-								logger.Value("response", response),
-								logger.Value("startTime", startTime),
-								logger.Value("endTime", endTime),
-								logger.Value("count", countInternal),
-								logger.Value("statusCode", statusCode),
-								logger.Value("err", err),
-							)
-						}
-						return
-					}
+	log.Debug(ctx, "sending request",
+		logger.Value("on", "RequestContent.QueryExecute"),
+		logger.Value("url", req.URL),
+		logger.Value("count", countInternal),
+	)
+	startTime := time.Now()
+	resp, err := tracing.Do(client, req)
+	endTime := time.Now()
+	log.Debug(ctx, "received response",
+		logger.Value("on", "RequestContent.QueryExecute"),
+		logger.Value("url", req.URL),
+		logger.Value("count", countInternal),
+	)
+	if err != nil {
+		log.Error(ctx, "response error",
+			logger.Value("error", err), logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "request processing is interrupted due to context termination",
+				logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
+			return
+		case q.ResChan <- ResponseContent{
+			Success:        false,
+			StartTime:      startTime,
+			EndTime:        endTime,
+			Count:          countInternal,
+			Stage:          stageInternal,
+			ResponseTime:   endTime.Sub(startTime).Milliseconds(),
+			HasSystemErr:   true,
+			WithCountLimit: countOver,
+			Operation:      operation,
+		}: // do nothing
+			log.Error(ctx, "response error",
+				logger.Value("startTime", startTime),
+				logger.Value("endTime", endTime),
+				logger.Value("count", countInternal),
+				logger.Value("responseTime", endTime.Sub(startTime).Milliseconds()),
+				logger.Value("err", err),
+			)
+		}
 
-					log.Debug(ctx, "response OK",
-						logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
-					responseContent := ResponseContent{
-						Success:        true,
-						ByteResponse:   responseByte,
-						Res:            response,
-						StartTime:      startTime,
-						EndTime:        endTime,
-						Count:          countInternal,
-						ResponseTime:   endTime.Sub(startTime).Milliseconds(),
-						StatusCode:     statusCode,
-						WithCountLimit: countOver,
-					}
-					select {
-					case q.ResChan <- responseContent:
-					case <-ctx.Done():
-						log.Info(ctx, "request processing is interrupted due to context termination",
-							logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
-						return
-					}
-				}(count, countLimitOver)
+		return
+	}
+	defer resp.Body.Close()
 
-				if countLimitOver {
-					<-ctx.Done()
-					log.Info(ctx, "request processing is interrupted due to count limit",
-						logger.Value("on", "RequestContent.QueryExecute"))
-					return
-				}
+	if q.ResponseType.IsStream() {
+		var graphQLErr bool
+		onEvent := func(e StreamEventContent) bool {
+			eventContent := ResponseContent{
+				Success:      true,
+				Res:          e.Event.Data,
+				ByteResponse: e.Event.Raw,
+				StartTime:    startTime,
+				EndTime:      e.Event.ReceivedTime,
+				Count:        countInternal,
+				Stage:        stageInternal,
+				ResponseTime: e.Event.ReceivedTime.Sub(startTime).Milliseconds(),
+				StatusCode:   resp.StatusCode,
+				Event:        &e,
+			}
+			applyGraphQL(req, &eventContent)
+			graphQLErr = graphQLErr || eventContent.HasGraphQLErr
+			select {
+			case q.ResChan <- eventContent:
+				return true
+			case <-ctx.Done():
+				return false
 			}
 		}
-	}()
+		responseContent := readStream(ctx, log, resp, q.ResponseType, startTime, *firstByteTime, onEvent)
+		applyGraphQL(req, &responseContent)
+		if graphQLErr {
+			responseContent.Success = false
+			responseContent.HasGraphQLErr = true
+		}
+		responseContent.Count = countInternal
+		responseContent.Stage = stageInternal
+		responseContent.WithCountLimit = countOver
+		select {
+		case q.ResChan <- responseContent:
+		case <-ctx.Done():
+			log.Info(ctx, "request processing is interrupted due to context termination",
+				logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
+		}
+		return
+	}
 
-	return nil
+	statusCode := resp.StatusCode
+	var response any
+	responseByte, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Error(ctx, "failed to read response",
+			logger.Value("error", err), logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "request processing is interrupted due to context termination",
+				logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
+			return
+		case q.ResChan <- ResponseContent{
+			Success:        false,
+			Res:            response,
+			StartTime:      startTime,
+			EndTime:        endTime,
+			Count:          countInternal,
+			Stage:          stageInternal,
+			ResponseTime:   endTime.Sub(startTime).Milliseconds(),
+			StatusCode:     statusCode,
+			ParseResHasErr: true,
+			WithCountLimit: countOver,
+			Operation:      operation,
+		}: // do nothing
+			log.Error(ctx, "failed to read response",
+				logger.Value("responseByte", string(responseByte)),
+				logger.Value("response", response),
+				logger.Value("startTime", startTime),
+				logger.Value("endTime", endTime),
+				logger.Value("count", countInternal),
+				logger.Value("statusCode", statusCode),
+				logger.Value("err", err),
+			)
+		}
+		return
+	}
+	switch ResponseType(q.ResponseType) {
+	case ResponseTypeJSON:
+		err = json.Unmarshal(responseByte, &response)
+	case ResponseTypeXML:
+		err = xml.Unmarshal(responseByte, &response)
+	case ResponseTypeYAML:
+		err = yaml.Unmarshal(responseByte, &response)
+	case ResponseTypeText:
+		response = string(responseByte)
+	case ResponseTypeHTML:
+		response = string(responseByte)
+	default:
+		err = fmt.Errorf("invalid response type: %s", q.ResponseType)
+	}
+	if err != nil {
+		log.Error(ctx, "failed to parse response",
+			logger.Value("error", err), logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "request processing is interrupted due to context termination",
+				logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
+			return
+		case q.ResChan <- ResponseContent{
+			Success:        false,
+			Res:            response,
+			ByteResponse:   responseByte,
+			StartTime:      startTime,
+			EndTime:        endTime,
+			Count:          countInternal,
+			Stage:          stageInternal,
+			ResponseTime:   endTime.Sub(startTime).Milliseconds(),
+			StatusCode:     statusCode,
+			ParseResHasErr: true,
+			WithCountLimit: countOver,
+			Operation:      operation,
+		}: // do nothing
+			log.Error(ctx, "failed to parse response",
+				logger.Value("responseByte", string(responseByte)),
+				logger.Value("response", response),
+				logger.Value("startTime", startTime),
+				logger.Value("endTime", endTime),
+				logger.Value("count", countInternal),
+				logger.Value("statusCode", statusCode),
+				logger.Value("err", err),
+			)
+		}
+		return
+	}
+
+	log.Debug(ctx, "response OK",
+		logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
+	responseContent := ResponseContent{
+		Success:        true,
+		ByteResponse:   responseByte,
+		Res:            response,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Count:          countInternal,
+		Stage:          stageInternal,
+		ResponseTime:   endTime.Sub(startTime).Milliseconds(),
+		StatusCode:     statusCode,
+		WithCountLimit: countOver,
+	}
+	applyGraphQL(req, &responseContent)
+	select {
+	case q.ResChan <- responseContent:
+	case <-ctx.Done():
+		log.Info(ctx, "request processing is interrupted due to context termination",
+			logger.Value("on", "RequestContent.QueryExecute"), logger.Value("url", req.URL))
+		return
+	}
 }
 
 var _ MassRequestExecutor = MassRequestContent[ExecReq]{}
@@ -10,5 +10,5 @@ import (
 // ExecReq represents the request executor
 type ExecReq interface {
 	// CreateRequest creates the http.Request object for the query
-	CreateRequest(ctx context.Context, log logger.Logger, count int) (*http.Request, error)
+	CreateRequest(ctx context.Context, log logger.Logger, count, stage int) (*http.Request, error)
 }
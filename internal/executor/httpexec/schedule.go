@@ -0,0 +1,204 @@
+package httpexec
+
+import (
+	"context"
+	"time"
+
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+// SendFunc sends a single request of the given count and stage.
+// countOver is true for the last request allowed by the count limit.
+type SendFunc func(count, stage int, countOver bool)
+
+// Schedule represents the scheduling of the mass requests.
+// It does not depend on the protocol, so the executors of other protocols share it.
+type Schedule struct {
+	Interval     time.Duration
+	ResponseWait bool
+	CountLimit   RequestCountLimit
+	ArrivalRate  ArrivalRate
+}
+
+// SendHook is called when a request is sent, and the returned function is called when the send returns
+type SendHook func() func()
+
+type sendHookKey struct{}
+
+// WithSendHook returns the context which calls the hook on every request scheduled under it
+func WithSendHook(ctx context.Context, hook SendHook) context.Context {
+	return context.WithValue(ctx, sendHookKey{}, hook)
+}
+
+// Run starts the scheduling in the background
+func (s Schedule) Run(ctx context.Context, log logger.Logger, send SendFunc) {
+	if hook, ok := ctx.Value(sendHookKey{}).(SendHook); ok && hook != nil {
+		inner := send
+		send = func(count, stage int, countOver bool) {
+			defer hook()()
+			inner(count, stage, countOver)
+		}
+	}
+	if s.ArrivalRate.Enabled {
+		go s.runArrivalRate(ctx, log, send)
+		return
+	}
+	go s.runInterval(ctx, log, send)
+}
+
+// runInterval sends the requests at the configured interval (closed model when ResponseWait is set)
+func (s Schedule) runInterval(ctx context.Context, log logger.Logger, send SendFunc) {
+	// defer close(q.ResChan) // TODO: close channel
+	waitForResponse := s.ResponseWait
+	var count int
+	var countLimitOver bool
+	chanForWait := make(chan struct{})
+	defer close(chanForWait)
+
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "request processing is interrupted due to context termination",
+				logger.Value("on", "RequestContent.QueryExecute"))
+			return
+		case <-ticker.C:
+			if count > 0 && waitForResponse {
+				select {
+				case <-ctx.Done():
+					log.Info(ctx, "request processing is interrupted due to context termination",
+						logger.Value("on", "RequestContent.QueryExecute"))
+					return
+				case <-chanForWait:
+				}
+			}
+
+			count++
+			if s.CountLimit.Enabled && count >= s.CountLimit.Count {
+				log.Info(ctx, "request processing is interrupted due to count limit",
+					logger.Value("on", "RequestContent.QueryExecute"))
+				countLimitOver = true
+			}
+
+			go func(countInternal int, countOver bool) {
+				defer func() {
+					if waitForResponse {
+						chanForWait <- struct{}{}
+					}
+				}()
+
+				send(countInternal, 0, countOver)
+			}(count, countLimitOver)
+
+			if countLimitOver {
+				<-ctx.Done()
+				log.Info(ctx, "request processing is interrupted due to count limit",
+					logger.Value("on", "RequestContent.QueryExecute"))
+				return
+			}
+		}
+	}
+}
+
+// runArrivalRate schedules the requests at the configured arrival rate (open model).
+// The schedule is computed from the start time, so it does not drift with the response latency.
+// When stages are configured, the scheduling stops at the end of the last stage.
+func (s Schedule) runArrivalRate(
+	ctx context.Context,
+	log logger.Logger,
+	send SendFunc,
+) {
+	stats := s.ArrivalRate.Stats
+	if stats == nil {
+		stats = &ArrivalRateStats{}
+	}
+	var inFlight chan struct{}
+	if s.ArrivalRate.MaxInFlight > 0 {
+		inFlight = make(chan struct{}, s.ArrivalRate.MaxInFlight)
+	}
+	var count int
+	var countLimitOver bool
+	var scheduled int64
+	startTime := time.Now()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	stage := 0
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "request processing is interrupted due to context termination",
+				logger.Value("on", "Schedule.runArrivalRate"))
+			return
+		case <-timer.C:
+		}
+		currentStage := stage
+		scheduled++
+		stats.Scheduled.Add(1)
+		offset, nextStage, ok := s.ArrivalRate.arrivalAt(float64(scheduled))
+		if ok {
+			stage = nextStage
+			timer.Reset(time.Until(startTime.Add(offset)))
+		}
+
+		var dropped bool
+		if inFlight != nil {
+			select {
+			case inFlight <- struct{}{}:
+			default:
+				if s.ArrivalRate.OnMaxInFlight == MaxInFlightPolicyDrop {
+					dropped = true
+					stats.Dropped.Add(1)
+					log.Debug(ctx, "request dropped due to max in-flight",
+						logger.Value("on", "Schedule.runArrivalRate"), logger.Value("scheduled", scheduled))
+					break
+				}
+				stats.Delayed.Add(1)
+				log.Debug(ctx, "request delayed due to max in-flight",
+					logger.Value("on", "Schedule.runArrivalRate"), logger.Value("scheduled", scheduled))
+				select {
+				case <-ctx.Done():
+					log.Info(ctx, "request processing is interrupted due to context termination",
+						logger.Value("on", "Schedule.runArrivalRate"))
+					return
+				case inFlight <- struct{}{}:
+				}
+			}
+		}
+
+		if !dropped {
+			count++
+			if s.CountLimit.Enabled && count >= s.CountLimit.Count {
+				log.Info(ctx, "request processing is interrupted due to count limit",
+					logger.Value("on", "Schedule.runArrivalRate"))
+				countLimitOver = true
+			}
+			stats.Sent.Add(1)
+
+			go func(countInternal, stageInternal int, countOver bool) {
+				defer func() {
+					if inFlight != nil {
+						<-inFlight
+					}
+				}()
+
+				send(countInternal, stageInternal, countOver)
+			}(count, currentStage, countLimitOver)
+		}
+
+		if !ok {
+			log.Info(ctx, "request scheduling is finished due to the end of stages",
+				logger.Value("on", "Schedule.runArrivalRate"))
+			return
+		}
+
+		if countLimitOver {
+			<-ctx.Done()
+			log.Info(ctx, "request processing is interrupted due to count limit",
+				logger.Value("on", "Schedule.runArrivalRate"))
+			return
+		}
+	}
+}
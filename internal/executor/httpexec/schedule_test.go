@@ -0,0 +1,86 @@
+package httpexec
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+// TestScheduleRunArrivalRateMaxInFlight tests the drop and the delay policies of the in-flight cap.
+func TestScheduleRunArrivalRateMaxInFlight(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy MaxInFlightPolicy
+		// sendTime is how long a send blocks, 0 means until the end of the test
+		sendTime time.Duration
+	}{
+		{name: "Drop", policy: MaxInFlightPolicyDrop},
+		{name: "Delay", policy: MaxInFlightPolicyDelay, sendTime: 15 * time.Millisecond},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			stats := &ArrivalRateStats{}
+			s := Schedule{
+				ArrivalRate: ArrivalRate{
+					Enabled:       true,
+					Rate:          100,
+					Stages:        []RateStage{{Duration: 100 * time.Millisecond, Target: 100}},
+					MaxInFlight:   1,
+					OnMaxInFlight: test.policy,
+					Stats:         stats,
+				},
+			}
+			send := func(_, _ int, _ bool) {
+				if test.sendTime == 0 {
+					<-ctx.Done()
+					return
+				}
+				time.Sleep(test.sendTime)
+			}
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				s.runArrivalRate(ctx, logger.NewSlogLogger(), send)
+			}()
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				tt.Fatalf("expected the scheduling to finish at the end of the stages")
+			}
+
+			scheduled := stats.Scheduled.Load()
+			sent := stats.Sent.Load()
+			dropped := stats.Dropped.Load()
+			delayed := stats.Delayed.Load()
+			if scheduled != sent+dropped {
+				tt.Errorf("expected scheduled %v, got sent %v + dropped %v", scheduled, sent, dropped)
+			}
+			switch test.policy {
+			case MaxInFlightPolicyDrop:
+				if sent != 1 {
+					tt.Errorf("expected %v, got %v", 1, sent)
+				}
+				if dropped != scheduled-1 {
+					tt.Errorf("expected %v, got %v", scheduled-1, dropped)
+				}
+				if delayed != 0 {
+					tt.Errorf("expected %v, got %v", 0, delayed)
+				}
+			case MaxInFlightPolicyDelay:
+				if dropped != 0 {
+					tt.Errorf("expected %v, got %v", 0, dropped)
+				}
+				if delayed == 0 {
+					tt.Errorf("expected delayed arrivals, got %v", delayed)
+				}
+			}
+		})
+	}
+}
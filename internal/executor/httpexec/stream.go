@@ -0,0 +1,257 @@
+package httpexec
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"strings"
+	"time"
+
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+// maxStreamLineSize is the maximum size of a line of the stream response
+const maxStreamLineSize = 16 * 1024 * 1024
+
+// StreamEvent represents an event of the stream response
+type StreamEvent struct {
+	// Name is the event field of the Server-Sent Events, empty for NDJSON
+	Name string
+	// ID is the id field of the Server-Sent Events, empty for NDJSON
+	ID string
+	// Data is the data decoded as JSON, or the string if it is not JSON (Server-Sent Events only)
+	Data         any
+	Raw          []byte
+	ReceivedTime time.Time
+}
+
+// StreamContent represents the timing and the events of the stream response
+type StreamContent struct {
+	FirstByteTime time.Time
+	Events        []StreamEvent
+}
+
+// StreamEventContent represents an event of the stream response delivered as it is read
+type StreamEventContent struct {
+	Index int
+	Event StreamEvent
+	// Gap is the time from the previous event, 0 for the first event
+	Gap time.Duration
+}
+
+// StreamEventHandler handles an event of the stream response as it is read, false to stop reading the stream
+type StreamEventHandler func(e StreamEventContent) bool
+
+// TimeToFirstByte returns the time from the start time to the first byte of the response
+func (s StreamContent) TimeToFirstByte(startTime time.Time) time.Duration {
+	return s.FirstByteTime.Sub(startTime)
+}
+
+// TimeToFirstEvent returns the time from the start time to the first event, false if there is no event
+func (s StreamContent) TimeToFirstEvent(startTime time.Time) (time.Duration, bool) {
+	if len(s.Events) == 0 {
+		return 0, false
+	}
+	return s.Events[0].ReceivedTime.Sub(startTime), true
+}
+
+// EventGaps returns the gaps between the consecutive events
+func (s StreamContent) EventGaps() []time.Duration {
+	if len(s.Events) < 2 {
+		return nil
+	}
+	gaps := make([]time.Duration, 0, len(s.Events)-1)
+	for i := 1; i < len(s.Events); i++ {
+		gaps = append(gaps, s.Events[i].ReceivedTime.Sub(s.Events[i-1].ReceivedTime))
+	}
+	return gaps
+}
+
+// withFirstByteTrace sets the trace recording the time of the first response byte on the request
+func withFirstByteTrace(req *http.Request) (*http.Request, *time.Time) {
+	var firstByteTime time.Time
+	trace := &httptrace.ClientTrace{
+		GotFirstResponseByte: func() {
+			firstByteTime = time.Now()
+		},
+	}
+	return req.WithContext(httptrace.WithClientTrace(req.Context(), trace)), &firstByteTime
+}
+
+// readStream reads the stream response until the end, decoding the events as they arrive.
+// Without the handler, Res is the slice of the event data.
+// With the handler, each event is handed to it as soon as it is decoded, and only the timing of the events is kept,
+// so that an endless stream can be stopped by the handler or the context.
+// EndTime is the end of the stream.
+func readStream(
+	ctx context.Context,
+	log logger.Logger,
+	resp *http.Response,
+	responseType ResponseType,
+	startTime time.Time,
+	firstByteTime time.Time,
+	onEvent StreamEventHandler,
+) ResponseContent {
+	if firstByteTime.IsZero() {
+		firstByteTime = time.Now()
+	}
+	// the request is not bound to the context, so the body is closed to stop the blocked read
+	stop := context.AfterFunc(ctx, func() {
+		resp.Body.Close()
+	})
+	defer stop()
+	stream := &StreamContent{FirstByteTime: firstByteTime}
+	emit := func(e StreamEvent) bool {
+		if onEvent == nil {
+			stream.Events = append(stream.Events, e)
+			return true
+		}
+		index := len(stream.Events)
+		var gap time.Duration
+		if index > 0 {
+			gap = e.ReceivedTime.Sub(stream.Events[index-1].ReceivedTime)
+		}
+		stream.Events = append(stream.Events, StreamEvent{
+			Name:         e.Name,
+			ID:           e.ID,
+			ReceivedTime: e.ReceivedTime,
+		})
+		return onEvent(StreamEventContent{Index: index, Event: e, Gap: gap})
+	}
+	var err error
+	switch responseType {
+	case ResponseTypeSSE:
+		err = readSSE(resp.Body, emit)
+	case ResponseTypeNDJSON:
+		err = readNDJSON(resp.Body, emit)
+	default:
+		err = fmt.Errorf("invalid stream response type: %s", responseType)
+	}
+	endTime := time.Now()
+
+	content := ResponseContent{
+		StartTime:    startTime,
+		EndTime:      endTime,
+		ResponseTime: endTime.Sub(startTime).Milliseconds(),
+		StatusCode:   resp.StatusCode,
+		Stream:       stream,
+	}
+	if onEvent == nil {
+		data := make([]any, 0, len(stream.Events))
+		for _, e := range stream.Events {
+			data = append(data, e.Data)
+		}
+		responseByte, marshalErr := json.Marshal(data)
+		if err == nil {
+			err = marshalErr
+		}
+		content.Res = data
+		content.ByteResponse = responseByte
+	}
+	content.Success = err == nil
+	if err != nil {
+		log.Error(ctx, "failed to read stream",
+			logger.Value("error", err), logger.Value("on", "readStream"), logger.Value("events", len(stream.Events)))
+		content.ParseResHasErr = true
+	}
+	return content
+}
+
+func newStreamScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLineSize)
+	return scanner
+}
+
+// readSSE reads the Server-Sent Events (text/event-stream), stopping when emit returns false.
+// The data lines of an event are joined with "\n", and the event is dispatched on a blank line.
+func readSSE(r io.Reader, emit func(e StreamEvent) bool) error {
+	scanner := newStreamScanner(r)
+	var name, id string
+	var data []string
+	var hasData bool
+	dispatch := func() bool {
+		next := true
+		if hasData {
+			raw := []byte(strings.Join(data, "\n"))
+			var decoded any
+			if err := json.Unmarshal(raw, &decoded); err != nil {
+				decoded = string(raw)
+			}
+			next = emit(StreamEvent{
+				Name:         name,
+				ID:           id,
+				Data:         decoded,
+				Raw:          raw,
+				ReceivedTime: time.Now(),
+			})
+		}
+		name, data, hasData = "", nil, false
+		return next
+	}
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			if !dispatch() {
+				return nil
+			}
+			continue
+		}
+		if strings.HasPrefix(line, ":") {
+			// comment, used as the keep-alive
+			continue
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			name = value
+		case "data":
+			data = append(data, value)
+			hasData = true
+		case "id":
+			id = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read event stream: %w", err)
+	}
+	// the event not terminated by a blank line is discarded, the same as the browsers
+	return nil
+}
+
+// readNDJSON reads the newline delimited JSON, stopping when emit returns false. Empty lines are skipped.
+func readNDJSON(r io.Reader, emit func(e StreamEvent) bool) error {
+	scanner := newStreamScanner(r)
+	var index int
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		receivedTime := time.Now()
+		raw := make([]byte, len(line))
+		copy(raw, line)
+		var decoded any
+		if err := json.Unmarshal(raw, &decoded); err != nil {
+			return fmt.Errorf("failed to parse line %d: %w", index+1, err)
+		}
+		index++
+		if !emit(StreamEvent{
+			Data:         decoded,
+			Raw:          raw,
+			ReceivedTime: receivedTime,
+		}) {
+			return nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read ndjson stream: %w", err)
+	}
+	return nil
+}
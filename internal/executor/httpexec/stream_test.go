@@ -0,0 +1,262 @@
+package httpexec
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+// TestReadSSE tests the decoding of the Server-Sent Events.
+func TestReadSSE(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []StreamEvent
+	}{
+		{
+			name:  "MultiLineData",
+			input: "data: {\"a\":\ndata: 1}\n\ndata: hello\ndata: world\n\n",
+			want: []StreamEvent{
+				{Data: map[string]any{"a": float64(1)}, Raw: []byte("{\"a\":\n1}")},
+				{Data: "hello\nworld", Raw: []byte("hello\nworld")},
+			},
+		},
+		{
+			name:  "Comment",
+			input: ": keep-alive\ndata: 1\n: keep-alive\n\n",
+			want:  []StreamEvent{{Data: float64(1), Raw: []byte("1")}},
+		},
+		{
+			// the id is kept for the following events, the event name is not
+			name:  "EventAndID",
+			input: "event: update\nid: 7\ndata: {\"x\":true}\n\ndata: 2\n\n",
+			want: []StreamEvent{
+				{Name: "update", ID: "7", Data: map[string]any{"x": true}, Raw: []byte("{\"x\":true}")},
+				{ID: "7", Data: float64(2), Raw: []byte("2")},
+			},
+		},
+		{
+			name:  "NoSpaceAfterColon",
+			input: "event:ping\ndata:1\n\n",
+			want:  []StreamEvent{{Name: "ping", Data: float64(1), Raw: []byte("1")}},
+		},
+		{
+			name:  "WithoutData",
+			input: "event: ping\n\ndata: 1\n\n",
+			want:  []StreamEvent{{Data: float64(1), Raw: []byte("1")}},
+		},
+		{
+			name:  "TrailingEventWithoutBlankLine",
+			input: "data: 1\n\ndata: 2",
+			want:  []StreamEvent{{Data: float64(1), Raw: []byte("1")}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			var got []StreamEvent
+			err := readSSE(strings.NewReader(test.input), func(e StreamEvent) bool {
+				if e.ReceivedTime.IsZero() {
+					tt.Errorf("expected the received time, got zero")
+				}
+				e.ReceivedTime = time.Time{}
+				got = append(got, e)
+				return true
+			})
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				tt.Errorf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+// TestReadNDJSON tests the decoding of the newline delimited JSON.
+func TestReadNDJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []any
+		wantErr bool
+	}{
+		{
+			name:  "Lines",
+			input: "{\"a\":1}\n[1,2]\n\"s\"\n",
+			want:  []any{map[string]any{"a": float64(1)}, []any{float64(1), float64(2)}, "s"},
+		},
+		{
+			name:  "EmptyLines",
+			input: "\n{\"a\":1}\n  \n\n2",
+			want:  []any{map[string]any{"a": float64(1)}, float64(2)},
+		},
+		{
+			name:    "InvalidLine",
+			input:   "{\"a\":1}\nnot json\n{\"b\":2}\n",
+			want:    []any{map[string]any{"a": float64(1)}},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			var got []any
+			err := readNDJSON(strings.NewReader(test.input), func(e StreamEvent) bool {
+				got = append(got, e.Data)
+				return true
+			})
+			if (err != nil) != test.wantErr {
+				tt.Errorf("expected error %v, got %v", test.wantErr, err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				tt.Errorf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+// TestReadStreamContextCancel tests that the cancellation of the context stops a blocked read of the stream.
+func TestReadStreamContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pr, pw := io.Pipe()
+	defer pw.Close()
+	resp := &http.Response{StatusCode: http.StatusOK, Body: pr}
+
+	received := make(chan struct{}, 1)
+	done := make(chan ResponseContent)
+	go func() {
+		done <- readStream(ctx, logger.NewSlogLogger(), resp, ResponseTypeSSE, time.Now(), time.Time{},
+			func(_ StreamEventContent) bool {
+				received <- struct{}{}
+				return true
+			})
+	}()
+
+	if _, err := io.WriteString(pw, "data: 1\n\n"); err != nil {
+		t.Fatalf("failed to write event: %v", err)
+	}
+	<-received
+	cancel()
+
+	select {
+	case content := <-done:
+		if len(content.Stream.Events) != 1 {
+			t.Errorf("expected %v, got %v", 1, len(content.Stream.Events))
+		}
+		if !content.ParseResHasErr {
+			t.Errorf("expected the interrupted read to be an error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the read to stop on the cancellation of the context")
+	}
+}
+
+type streamTestReq struct {
+	url string
+}
+
+func (r streamTestReq) CreateRequest(ctx context.Context, _ logger.Logger, _, _ int) (*http.Request, error) {
+	return http.NewRequestWithContext(ctx, http.MethodGet, r.url, nil)
+}
+
+// TestMassRequestContentSendStream tests that the events of the stream are delivered to ResChan in order as they arrive.
+func TestMassRequestContentSendStream(t *testing.T) {
+	const (
+		events = 3
+		gap    = 100 * time.Millisecond
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		for i := 0; i < events; i++ {
+			if i > 0 {
+				time.Sleep(gap)
+			}
+			fmt.Fprintf(w, "event: tick\nid: %d\ndata: {\"i\":%d}\n\n", i, i)
+			w.(http.Flusher).Flush()
+		}
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resChan := make(chan ResponseContent)
+	q := MassRequestContent[streamTestReq]{
+		Req:          streamTestReq{url: srv.URL},
+		ResChan:      resChan,
+		ResponseType: ResponseTypeSSE,
+	}
+	go func() {
+		defer close(resChan)
+		q.send(ctx, logger.NewSlogLogger(), srv.Client(), 1, 0, false)
+	}()
+
+	var contents []ResponseContent
+	var deliveredAt []time.Time
+	timeout := time.After(5 * time.Second)
+	for done := false; !done; {
+		select {
+		case c, ok := <-resChan:
+			if !ok {
+				done = true
+				break
+			}
+			contents = append(contents, c)
+			deliveredAt = append(deliveredAt, time.Now())
+		case <-timeout:
+			t.Fatalf("expected the stream to end")
+		}
+	}
+
+	if len(contents) != events+1 {
+		t.Fatalf("expected %v, got %v", events+1, len(contents))
+	}
+	for i, c := range contents[:events] {
+		if c.Event == nil {
+			t.Fatalf("expected the event %d, got the end of the stream", i)
+		}
+		if c.Event.Index != i {
+			t.Errorf("expected %v, got %v", i, c.Event.Index)
+		}
+		if c.Event.Event.ID != fmt.Sprint(i) || c.Event.Event.Name != "tick" {
+			t.Errorf("expected the event %d, got %v(%v)", i, c.Event.Event.Name, c.Event.Event.ID)
+		}
+		if want := map[string]any{"i": float64(i)}; !reflect.DeepEqual(c.Res, want) {
+			t.Errorf("expected %v, got %v", want, c.Res)
+		}
+		if i == 0 {
+			continue
+		}
+		// each event is delivered as it arrives, not when the stream ends
+		if c.Event.Gap < gap/2 {
+			t.Errorf("expected the gap of the event %d to be at least %v, got %v", i, gap/2, c.Event.Gap)
+		}
+		if d := deliveredAt[i].Sub(deliveredAt[i-1]); d < gap/2 {
+			t.Errorf("expected the event %d to be delivered at least %v after the previous one, got %v", i, gap/2, d)
+		}
+	}
+
+	end := contents[events]
+	if end.Event != nil || end.Stream == nil {
+		t.Fatalf("expected the end of the stream, got %+v", end)
+	}
+	if !end.Success {
+		t.Errorf("expected the stream to succeed")
+	}
+	if len(end.Stream.Events) != events {
+		t.Errorf("expected %v, got %v", events, len(end.Stream.Events))
+	}
+	if end.EndTime.Before(contents[events-1].EndTime) {
+		t.Errorf("expected the end of the stream after the last event, got %v before %v",
+			end.EndTime, contents[events-1].EndTime)
+	}
+}
@@ -2,9 +2,16 @@ package httpexec
 
 import (
 	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math"
 	"strconv"
+	"sync/atomic"
 	"time"
 
+	"gopkg.in/yaml.v3"
+
 	"github.com/ablankz/bloader/internal/logger"
 )
 
@@ -21,7 +28,17 @@ type ResponseContent struct {
 	ReqCreateHasErr bool
 	ParseResHasErr  bool
 	HasSystemErr    bool
-	WithCountLimit  bool
+	// HasGraphQLErr is set when the errors array of the GraphQL response is not empty
+	HasGraphQLErr  bool
+	WithCountLimit bool
+	Stage          int
+	// Operation is the GraphQL operation name of the request
+	Operation string
+	// Stream is set for the stream response types
+	Stream *StreamContent
+	// Event is set for each event of the stream response types delivered as it is read,
+	// before the content of the end of the stream
+	Event *StreamEventContent
 }
 
 // ToWriteHTTPData converts the ResponseContent to WriteHTTPData
@@ -46,14 +63,14 @@ type WriteHTTPData struct {
 	StatusCode       string
 }
 
-// ToSlice converts the WriteHTTPData to a slice
-func (d WriteHTTPData) ToSlice() []string {
-	return []string{
-		strconv.FormatBool(d.Success),
+// ToSlice converts the WriteHTTPData to a slice, keeping the types of the values
+func (d WriteHTTPData) ToSlice() []any {
+	return []any{
+		d.Success,
 		d.SendDatetime,
 		d.ReceivedDatetime,
-		strconv.Itoa(d.Count),
-		strconv.Itoa(d.ResponseTime),
+		d.Count,
+		d.ResponseTime,
 		d.StatusCode,
 	}
 }
@@ -72,14 +89,126 @@ const (
 	ResponseTypeText ResponseType = "text"
 	// ResponseTypeHTML represents the HTML response type
 	ResponseTypeHTML ResponseType = "html"
+	// ResponseTypeSSE represents the Server-Sent Events response type
+	ResponseTypeSSE ResponseType = "sse"
+	// ResponseTypeNDJSON represents the newline delimited JSON response type
+	ResponseTypeNDJSON ResponseType = "ndjson"
 )
 
+// IsStream reports whether the response is read as a stream of events
+func (t ResponseType) IsStream() bool {
+	return t == ResponseTypeSSE || t == ResponseTypeNDJSON
+}
+
+// DecodeResponse decodes the raw response by the response type.
+// It is shared by the executors of the protocols without the content negotiation.
+func DecodeResponse(responseType ResponseType, data []byte) (any, error) {
+	var response any
+	var err error
+	switch responseType {
+	case ResponseTypeJSON:
+		err = json.Unmarshal(data, &response)
+	case ResponseTypeXML:
+		err = xml.Unmarshal(data, &response)
+	case ResponseTypeYAML:
+		err = yaml.Unmarshal(data, &response)
+	case ResponseTypeText, ResponseTypeHTML:
+		response = string(data)
+	default:
+		err = fmt.Errorf("invalid response type: %s", responseType)
+	}
+	return response, err
+}
+
 // RequestExecutor represents the request executor
 type RequestExecutor interface {
 	// RequestExecute executes the request
 	RequestExecute(ctx context.Context, log logger.Logger) (ResponseContent, error)
 }
 
+// MaxInFlightPolicy represents the behavior when the in-flight cap is reached
+type MaxInFlightPolicy string
+
+const (
+	// MaxInFlightPolicyDrop represents the policy that drops the scheduled request
+	MaxInFlightPolicyDrop MaxInFlightPolicy = "drop"
+	// MaxInFlightPolicyDelay represents the policy that delays the scheduled request until a slot is free
+	MaxInFlightPolicyDelay MaxInFlightPolicy = "delay"
+
+	// DefaultMaxInFlightPolicy represents the default max in-flight policy
+	DefaultMaxInFlightPolicy = MaxInFlightPolicyDrop
+)
+
+// ArrivalRate represents the open-model arrival rate configuration.
+// Requests are scheduled at the given rate independently of the response latency.
+type ArrivalRate struct {
+	Enabled bool
+	// Rate is the number of requests per second, or the starting rate when Stages is set
+	Rate float64
+	// Stages is the staged rate profile, the rate is interpolated linearly between stages
+	Stages []RateStage
+	// MaxInFlight is the maximum number of concurrent requests, 0 means unlimited
+	MaxInFlight   int
+	OnMaxInFlight MaxInFlightPolicy
+	Stats         *ArrivalRateStats
+}
+
+// RateStage represents a stage of the arrival rate profile
+type RateStage struct {
+	Duration time.Duration
+	// Target is the number of requests per second reached at the end of the stage
+	Target float64
+}
+
+// TotalDuration returns the total duration of the stages
+func (a ArrivalRate) TotalDuration() time.Duration {
+	var total time.Duration
+	for _, s := range a.Stages {
+		total += s.Duration
+	}
+	return total
+}
+
+// arrivalAt returns the offset from the start time of the n-th (0-origin) arrival and its stage index.
+// ok is false when the arrival is beyond the last stage.
+func (a ArrivalRate) arrivalAt(n float64) (offset time.Duration, stage int, ok bool) {
+	if len(a.Stages) == 0 {
+		return time.Duration(n / a.Rate * float64(time.Second)), 0, true
+	}
+	var elapsed float64
+	from := a.Rate
+	remaining := n
+	for i, s := range a.Stages {
+		d := s.Duration.Seconds()
+		area := (from + s.Target) / 2 * d
+		if remaining <= area && (remaining <= 0 || area > 0) {
+			var tau float64
+			if remaining > 0 {
+				// solve from*tau + slope*tau^2/2 = remaining in a numerically stable form
+				slope := (s.Target - from) / d
+				tau = 2 * remaining / (from + math.Sqrt(math.Max(0, from*from+2*slope*remaining)))
+			}
+			return time.Duration((elapsed + tau) * float64(time.Second)), i, true
+		}
+		remaining -= area
+		elapsed += d
+		from = s.Target
+	}
+	return 0, len(a.Stages) - 1, false
+}
+
+// ArrivalRateStats represents the scheduling statistics of the arrival rate mode
+type ArrivalRateStats struct {
+	// Scheduled is the number of arrivals produced by the scheduler
+	Scheduled atomic.Int64
+	// Sent is the number of requests actually sent
+	Sent atomic.Int64
+	// Dropped is the number of arrivals dropped because the in-flight cap was hit
+	Dropped atomic.Int64
+	// Delayed is the number of arrivals delayed because the in-flight cap was hit
+	Delayed atomic.Int64
+}
+
 // MassRequestExecutor represents the request executor
 type MassRequestExecutor interface {
 	// MassRequestExecute executes the request
@@ -0,0 +1,92 @@
+package httpexec
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// TestArrivalRateArrivalAt tests the offset and the stage of the arrivals of the arrival rate.
+func TestArrivalRateArrivalAt(t *testing.T) {
+	seconds := func(s float64) time.Duration {
+		return time.Duration(s * float64(time.Second))
+	}
+	tests := []struct {
+		name       string
+		rate       ArrivalRate
+		n          float64
+		wantOffset time.Duration
+		wantStage  int
+		wantOK     bool
+	}{
+		{name: "ConstantFirst", rate: ArrivalRate{Rate: 10}, n: 0, wantOffset: 0, wantOK: true},
+		{name: "Constant", rate: ArrivalRate{Rate: 10}, n: 5, wantOffset: 500 * time.Millisecond, wantOK: true},
+		{
+			// 0 to 10 rps in 10s, the n-th arrival is at sqrt(2n) seconds
+			name: "RampUp",
+			rate: ArrivalRate{Stages: []RateStage{{Duration: 10 * time.Second, Target: 10}}},
+			n:    8, wantOffset: 4 * time.Second, wantOK: true,
+		},
+		{
+			name: "RampUpEnd",
+			rate: ArrivalRate{Stages: []RateStage{{Duration: 10 * time.Second, Target: 10}}},
+			n:    50, wantOffset: 10 * time.Second, wantOK: true,
+		},
+		{
+			name: "HoldThenRampDownInHold",
+			rate: ArrivalRate{Rate: 10, Stages: []RateStage{
+				{Duration: 2 * time.Second, Target: 10},
+				{Duration: 2 * time.Second, Target: 0},
+			}},
+			n: 10, wantOffset: time.Second, wantOK: true,
+		},
+		{
+			// 10 - 5t rps from 2s, the 5 arrivals after 20 take 10t - 2.5t^2 = 5
+			name: "HoldThenRampDownInRamp",
+			rate: ArrivalRate{Rate: 10, Stages: []RateStage{
+				{Duration: 2 * time.Second, Target: 10},
+				{Duration: 2 * time.Second, Target: 0},
+			}},
+			n: 25, wantOffset: seconds(2 + (10-math.Sqrt(50))/5), wantStage: 1, wantOK: true,
+		},
+		{
+			name: "HoldThenRampDownEnd",
+			rate: ArrivalRate{Rate: 10, Stages: []RateStage{
+				{Duration: 2 * time.Second, Target: 10},
+				{Duration: 2 * time.Second, Target: 0},
+			}},
+			n: 30, wantOffset: 4 * time.Second, wantStage: 1, wantOK: true,
+		},
+		{
+			name: "BeyondStages",
+			rate: ArrivalRate{Rate: 10, Stages: []RateStage{
+				{Duration: 2 * time.Second, Target: 10},
+				{Duration: 2 * time.Second, Target: 0},
+			}},
+			n: 31, wantStage: 1, wantOK: false,
+		},
+		{
+			// nothing arrives in the idle stage, the ramp after it takes 2.5t^2 = 5
+			name: "IdleThenRampUp",
+			rate: ArrivalRate{Stages: []RateStage{
+				{Duration: time.Second, Target: 0},
+				{Duration: 2 * time.Second, Target: 10},
+			}},
+			n: 5, wantOffset: seconds(1 + math.Sqrt2), wantStage: 1, wantOK: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			offset, stage, ok := tc.rate.arrivalAt(tc.n)
+			if ok != tc.wantOK {
+				tt.Fatalf("expected ok %t, got %t", tc.wantOK, ok)
+			}
+			if stage != tc.wantStage {
+				tt.Errorf("expected stage %d, got %d", tc.wantStage, stage)
+			}
+			if diff := offset - tc.wantOffset; diff < -time.Microsecond || diff > time.Microsecond {
+				tt.Errorf("expected offset %s, got %s", tc.wantOffset, offset)
+			}
+		})
+	}
+}
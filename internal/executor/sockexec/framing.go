@@ -0,0 +1,114 @@
+package sockexec
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// maxFrameSize is the maximum size of a reply frame
+const maxFrameSize = 16 * 1024 * 1024
+
+// FramingType represents the way the reply is cut out of the stream
+type FramingType string
+
+const (
+	// FramingTypeNone represents that no reply is read
+	FramingTypeNone FramingType = "none"
+	// FramingTypeDelimiter represents the reply terminated by the delimiter
+	FramingTypeDelimiter FramingType = "delimiter"
+	// FramingTypeFixed represents the reply of the fixed length
+	FramingTypeFixed FramingType = "fixed"
+	// FramingTypeLengthPrefix represents the reply prefixed with its length
+	FramingTypeLengthPrefix FramingType = "length_prefix"
+)
+
+// Framing represents the reply framing.
+// The delimiter and the length prefix are not included in the frame.
+type Framing struct {
+	Type      FramingType
+	Delimiter []byte
+	// Length is the length of the fixed frame
+	Length int
+	// PrefixSize is the size of the length prefix in bytes, one of 1, 2, 4 or 8
+	PrefixSize int
+	ByteOrder  binary.ByteOrder
+}
+
+// ReadFrame reads a single frame from the stream
+func (f Framing) ReadFrame(r *bufio.Reader) ([]byte, error) {
+	switch f.Type {
+	case FramingTypeNone:
+		return nil, nil
+	case FramingTypeDelimiter:
+		return f.readDelimited(r)
+	case FramingTypeFixed:
+		return readFull(r, f.Length)
+	case FramingTypeLengthPrefix:
+		prefix, err := readFull(r, f.PrefixSize)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read length prefix: %w", err)
+		}
+		var length uint64
+		switch f.PrefixSize {
+		case 1:
+			length = uint64(prefix[0])
+		case 2:
+			length = uint64(f.ByteOrder.Uint16(prefix))
+		case 4:
+			length = uint64(f.ByteOrder.Uint32(prefix))
+		case 8:
+			length = f.ByteOrder.Uint64(prefix)
+		default:
+			return nil, fmt.Errorf("invalid prefix size: %d", f.PrefixSize)
+		}
+		if length > maxFrameSize {
+			return nil, fmt.Errorf("frame length %d exceeds the limit", length)
+		}
+		return readFull(r, int(length))
+	}
+	return nil, fmt.Errorf("invalid framing type: %s", f.Type)
+}
+
+// SplitDatagram cuts the frame out of a datagram.
+// The datagram is the boundary of the message, so the delimiter is optional at its end.
+func (f Framing) SplitDatagram(datagram []byte) ([]byte, error) {
+	if f.Type == FramingTypeDelimiter {
+		frame, _, _ := bytes.Cut(datagram, f.Delimiter)
+		return frame, nil
+	}
+	return f.ReadFrame(bufio.NewReader(bytes.NewReader(datagram)))
+}
+
+// readDelimited reads the stream until the delimiter
+func (f Framing) readDelimited(r *bufio.Reader) ([]byte, error) {
+	last := f.Delimiter[len(f.Delimiter)-1]
+	var frame []byte
+	for {
+		chunk, err := r.ReadBytes(last)
+		frame = append(frame, chunk...)
+		if err != nil {
+			if err == io.EOF && len(frame) > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		if bytes.HasSuffix(frame, f.Delimiter) {
+			return frame[:len(frame)-len(f.Delimiter)], nil
+		}
+		if len(frame) > maxFrameSize {
+			return nil, fmt.Errorf("frame exceeds the limit without the delimiter")
+		}
+	}
+}
+
+// readFull reads exactly n bytes from the stream
+func readFull(r io.Reader, n int) ([]byte, error) {
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
@@ -0,0 +1,230 @@
+package sockexec
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+// lengthPrefixed encodes the frames with the length prefix of the size and the byte order
+func lengthPrefixed(size int, order binary.ByteOrder, frames ...string) []byte {
+	var buf bytes.Buffer
+	for _, frame := range frames {
+		prefix := make([]byte, 8)
+		switch size {
+		case 1:
+			prefix[0] = byte(len(frame))
+		case 2:
+			order.PutUint16(prefix, uint16(len(frame)))
+		case 4:
+			order.PutUint32(prefix, uint32(len(frame)))
+		case 8:
+			order.PutUint64(prefix, uint64(len(frame)))
+		}
+		buf.Write(prefix[:size])
+		buf.WriteString(frame)
+	}
+	return buf.Bytes()
+}
+
+// TestFramingReadFrame tests the frames read from the stream, whole and one byte at a time.
+func TestFramingReadFrame(t *testing.T) {
+	oversized := make([]byte, 4)
+	binary.BigEndian.PutUint32(oversized, maxFrameSize+1)
+
+	tests := []struct {
+		name    string
+		framing Framing
+		input   []byte
+		want    []string
+		// wantErr is the error after the frames, nil for io.EOF at the end of the stream
+		wantErr error
+		anyErr  bool
+	}{
+		{
+			name:    "None",
+			framing: Framing{Type: FramingTypeNone},
+			input:   []byte("ignored"),
+			want:    []string{""},
+		},
+		{
+			name:    "Delimiter",
+			framing: Framing{Type: FramingTypeDelimiter, Delimiter: []byte("\n")},
+			input:   []byte("first\nsecond\n\n"),
+			want:    []string{"first", "second", ""},
+		},
+		{
+			name:    "MultiByteDelimiter",
+			framing: Framing{Type: FramingTypeDelimiter, Delimiter: []byte("\r\n")},
+			input:   []byte("a\rb\nc\r\nd\r\n"),
+			want:    []string{"a\rb\nc", "d"},
+		},
+		{
+			name:    "MissingDelimiter",
+			framing: Framing{Type: FramingTypeDelimiter, Delimiter: []byte("\n")},
+			input:   []byte("first\nsecond"),
+			want:    []string{"first"},
+			wantErr: io.ErrUnexpectedEOF,
+		},
+		{
+			name:    "Fixed",
+			framing: Framing{Type: FramingTypeFixed, Length: 3},
+			input:   []byte("abcdef"),
+			want:    []string{"abc", "def"},
+		},
+		{
+			name:    "FixedPartial",
+			framing: Framing{Type: FramingTypeFixed, Length: 4},
+			input:   []byte("abcdef"),
+			want:    []string{"abcd"},
+			wantErr: io.ErrUnexpectedEOF,
+		},
+		{
+			name:    "LengthPrefix1",
+			framing: Framing{Type: FramingTypeLengthPrefix, PrefixSize: 1, ByteOrder: binary.BigEndian},
+			input:   lengthPrefixed(1, binary.BigEndian, "hello", "", "world"),
+			want:    []string{"hello", "", "world"},
+		},
+		{
+			name:    "LengthPrefix2Big",
+			framing: Framing{Type: FramingTypeLengthPrefix, PrefixSize: 2, ByteOrder: binary.BigEndian},
+			input:   lengthPrefixed(2, binary.BigEndian, "hello", "world"),
+			want:    []string{"hello", "world"},
+		},
+		{
+			name:    "LengthPrefix4Little",
+			framing: Framing{Type: FramingTypeLengthPrefix, PrefixSize: 4, ByteOrder: binary.LittleEndian},
+			input:   lengthPrefixed(4, binary.LittleEndian, "hello", "world"),
+			want:    []string{"hello", "world"},
+		},
+		{
+			name:    "LengthPrefix8",
+			framing: Framing{Type: FramingTypeLengthPrefix, PrefixSize: 8, ByteOrder: binary.BigEndian},
+			input:   lengthPrefixed(8, binary.BigEndian, "hello"),
+			want:    []string{"hello"},
+		},
+		{
+			name:    "LengthPrefixPartialFrame",
+			framing: Framing{Type: FramingTypeLengthPrefix, PrefixSize: 2, ByteOrder: binary.BigEndian},
+			input:   lengthPrefixed(2, binary.BigEndian, "hello")[:5],
+			wantErr: io.ErrUnexpectedEOF,
+		},
+		{
+			name:    "LengthPrefixPartialPrefix",
+			framing: Framing{Type: FramingTypeLengthPrefix, PrefixSize: 4, ByteOrder: binary.BigEndian},
+			input:   []byte{0, 0},
+			wantErr: io.ErrUnexpectedEOF,
+		},
+		{
+			name:    "LengthPrefixOversized",
+			framing: Framing{Type: FramingTypeLengthPrefix, PrefixSize: 4, ByteOrder: binary.BigEndian},
+			input:   append(oversized, "data"...),
+			anyErr:  true,
+		},
+		{
+			name:    "InvalidPrefixSize",
+			framing: Framing{Type: FramingTypeLengthPrefix, PrefixSize: 3, ByteOrder: binary.BigEndian},
+			input:   []byte("abcdef"),
+			anyErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		for _, reader := range []struct {
+			name string
+			wrap func(r io.Reader) io.Reader
+		}{
+			{name: "Whole", wrap: func(r io.Reader) io.Reader { return r }},
+			{name: "OneByte", wrap: iotest.OneByteReader},
+		} {
+			t.Run(test.name+"/"+reader.name, func(tt *testing.T) {
+				r := bufio.NewReader(reader.wrap(bytes.NewReader(test.input)))
+				for i, want := range test.want {
+					frame, err := test.framing.ReadFrame(r)
+					if err != nil {
+						tt.Fatalf("unexpected error of the frame %d: %v", i, err)
+					}
+					if string(frame) != want {
+						tt.Errorf("expected %q, got %q", want, frame)
+					}
+				}
+				if test.framing.Type == FramingTypeNone {
+					return
+				}
+				_, err := test.framing.ReadFrame(r)
+				switch {
+				case test.anyErr:
+					if err == nil {
+						tt.Errorf("expected an error, got nil")
+					}
+				case test.wantErr != nil:
+					if !errors.Is(err, test.wantErr) {
+						tt.Errorf("expected %v, got %v", test.wantErr, err)
+					}
+				default:
+					if !errors.Is(err, io.EOF) {
+						tt.Errorf("expected %v, got %v", io.EOF, err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestFramingSplitDatagram tests the frame cut out of a datagram.
+func TestFramingSplitDatagram(t *testing.T) {
+	tests := []struct {
+		name     string
+		framing  Framing
+		datagram []byte
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "Delimiter",
+			framing:  Framing{Type: FramingTypeDelimiter, Delimiter: []byte("\n")},
+			datagram: []byte("reply\nignored"),
+			want:     "reply",
+		},
+		{
+			name:     "WithoutDelimiter",
+			framing:  Framing{Type: FramingTypeDelimiter, Delimiter: []byte("\n")},
+			datagram: []byte("reply"),
+			want:     "reply",
+		},
+		{
+			name:     "LengthPrefix",
+			framing:  Framing{Type: FramingTypeLengthPrefix, PrefixSize: 2, ByteOrder: binary.BigEndian},
+			datagram: lengthPrefixed(2, binary.BigEndian, "reply"),
+			want:     "reply",
+		},
+		{
+			name:     "LengthPrefixTruncated",
+			framing:  Framing{Type: FramingTypeLengthPrefix, PrefixSize: 2, ByteOrder: binary.BigEndian},
+			datagram: lengthPrefixed(2, binary.BigEndian, "reply")[:4],
+			wantErr:  true,
+		},
+		{
+			name:     "Fixed",
+			framing:  Framing{Type: FramingTypeFixed, Length: 2},
+			datagram: []byte("reply"),
+			want:     "re",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			frame, err := test.framing.SplitDatagram(test.datagram)
+			if (err != nil) != test.wantErr {
+				tt.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+			if string(frame) != test.want {
+				tt.Errorf("expected %q, got %q", test.want, frame)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+// Package sockexec provides the executor for the raw TCP/UDP sockets.
+package sockexec
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/ablankz/bloader/internal/executor/httpexec"
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+// Network represents the network of the socket
+type Network string
+
+const (
+	// NetworkTCP represents the TCP network
+	NetworkTCP Network = "tcp"
+	// NetworkUDP represents the UDP network
+	NetworkUDP Network = "udp"
+)
+
+// maxDatagramSize is the maximum size of a UDP datagram
+const maxDatagramSize = 64 * 1024
+
+// Address returns the "host:port" address of the target, the "tcp://" or "udp://" prefix is removed
+func Address(target string) string {
+	for _, scheme := range []string{"tcp://", "udp://"} {
+		if strings.HasPrefix(target, scheme) {
+			return strings.TrimPrefix(target, scheme)
+		}
+	}
+	return target
+}
+
+// Payload represents the payload sent on the socket
+type Payload struct {
+	Data []byte
+}
+
+// ExecReq represents the payload creator
+type ExecReq interface {
+	// CreatePayload creates the payload of the count and stage
+	CreatePayload(ctx context.Context, log logger.Logger, count, stage int) (*Payload, error)
+}
+
+// RequestContent represents the request content.
+// A connection is opened for each request, the payload is sent and a single reply frame is read.
+type RequestContent[Req ExecReq] struct {
+	Req          Req
+	Network      Network
+	Address      string
+	Framing      Framing
+	Timeout      time.Duration
+	ResponseType httpexec.ResponseType
+	// Count is passed to the payload creation, used as the loop count
+	Count int
+}
+
+// RequestExecute executes the request
+func (q RequestContent[Req]) RequestExecute(
+	ctx context.Context,
+	log logger.Logger,
+) (httpexec.ResponseContent, error) {
+	payload, err := q.Req.CreatePayload(ctx, log, q.Count, 0)
+	if err != nil {
+		log.Error(ctx, "failed to create payload",
+			logger.Value("error", err), logger.Value("on", "RequestContent.RequestExecute"))
+		return httpexec.ResponseContent{}, fmt.Errorf("failed to create payload: %w", err)
+	}
+	return exchange(ctx, log, q.Network, q.Address, q.Framing, q.Timeout, q.ResponseType, payload), nil
+}
+
+// MassRequestContent represents the request content
+type MassRequestContent[Req ExecReq] struct {
+	Req          Req
+	Network      Network
+	Address      string
+	Framing      Framing
+	Timeout      time.Duration
+	ResponseType httpexec.ResponseType
+	Interval     time.Duration
+	ResponseWait bool
+	ResChan      chan<- httpexec.ResponseContent
+	CountLimit   httpexec.RequestCountLimit
+	ArrivalRate  httpexec.ArrivalRate
+}
+
+// MassRequestExecute executes the request
+func (q MassRequestContent[Req]) MassRequestExecute(
+	ctx context.Context,
+	log logger.Logger,
+) error {
+	httpexec.Schedule{
+		Interval:     q.Interval,
+		ResponseWait: q.ResponseWait,
+		CountLimit:   q.CountLimit,
+		ArrivalRate:  q.ArrivalRate,
+	}.Run(ctx, log, func(count, stage int, countOver bool) {
+		q.send(ctx, log, count, stage, countOver)
+	})
+
+	return nil
+}
+
+// send sends a single request and delivers the response to ResChan
+func (q MassRequestContent[Req]) send(
+	ctx context.Context,
+	log logger.Logger,
+	countInternal int,
+	stageInternal int,
+	countOver bool,
+) {
+	var response httpexec.ResponseContent
+	payload, err := q.Req.CreatePayload(ctx, log, countInternal, stageInternal)
+	if err != nil {
+		log.Error(ctx, "failed to create payload",
+			logger.Value("error", err), logger.Value("on", "MassRequestContent.send"))
+		response = httpexec.ResponseContent{
+			Success:         false,
+			ReqCreateHasErr: true,
+		}
+	} else {
+		response = exchange(ctx, log, q.Network, q.Address, q.Framing, q.Timeout, q.ResponseType, payload)
+	}
+	response.Count = countInternal
+	response.Stage = stageInternal
+	response.WithCountLimit = countOver
+
+	select {
+	case q.ResChan <- response:
+	case <-ctx.Done():
+		log.Info(ctx, "request processing is interrupted due to context termination",
+			logger.Value("on", "MassRequestContent.send"))
+		return
+	}
+}
+
+// exchange sends the payload on a new connection and reads the reply.
+// The response time includes the connection setup.
+func exchange(
+	ctx context.Context,
+	log logger.Logger,
+	network Network,
+	address string,
+	framing Framing,
+	timeout time.Duration,
+	responseType httpexec.ResponseType,
+	payload *Payload,
+) httpexec.ResponseContent {
+	startTime := time.Now()
+	failed := func(err error) httpexec.ResponseContent {
+		log.Error(ctx, "failed to exchange payload",
+			logger.Value("error", err), logger.Value("on", "exchange"), logger.Value("address", address))
+		endTime := time.Now()
+		return httpexec.ResponseContent{
+			Success:      false,
+			StartTime:    startTime,
+			EndTime:      endTime,
+			ResponseTime: endTime.Sub(startTime).Milliseconds(),
+			HasSystemErr: true,
+		}
+	}
+
+	dialer := net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, string(network), address)
+	if err != nil {
+		return failed(fmt.Errorf("failed to dial: %w", err))
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(startTime.Add(timeout)); err != nil {
+		return failed(fmt.Errorf("failed to set deadline: %w", err))
+	}
+	if _, err := conn.Write(payload.Data); err != nil {
+		return failed(fmt.Errorf("failed to write payload: %w", err))
+	}
+	if framing.Type == FramingTypeNone {
+		endTime := time.Now()
+		return httpexec.ResponseContent{
+			Success:      true,
+			StartTime:    startTime,
+			EndTime:      endTime,
+			ResponseTime: endTime.Sub(startTime).Milliseconds(),
+		}
+	}
+
+	var frame []byte
+	if network == NetworkUDP {
+		buf := make([]byte, maxDatagramSize)
+		n, err := conn.Read(buf)
+		if err != nil {
+			return failed(fmt.Errorf("failed to read datagram: %w", err))
+		}
+		frame, err = framing.SplitDatagram(buf[:n])
+		if err != nil {
+			return failed(fmt.Errorf("failed to read frame: %w", err))
+		}
+	} else {
+		frame, err = framing.ReadFrame(bufio.NewReader(conn))
+		if err != nil {
+			return failed(fmt.Errorf("failed to read frame: %w", err))
+		}
+	}
+	endTime := time.Now()
+
+	response, err := httpexec.DecodeResponse(responseType, frame)
+	if err != nil {
+		log.Error(ctx, "failed to parse response",
+			logger.Value("error", err), logger.Value("on", "exchange"), logger.Value("address", address))
+	}
+	return httpexec.ResponseContent{
+		Success:        err == nil,
+		Res:            response,
+		ByteResponse:   frame,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		ResponseTime:   endTime.Sub(startTime).Milliseconds(),
+		ParseResHasErr: err != nil,
+	}
+}
+
+var (
+	_ httpexec.RequestExecutor     = RequestContent[ExecReq]{}
+	_ httpexec.MassRequestExecutor = MassRequestContent[ExecReq]{}
+)
@@ -0,0 +1,113 @@
+package sockexec
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ablankz/bloader/internal/executor/httpexec"
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+type testPayloadReq struct {
+	data string
+}
+
+func (r testPayloadReq) CreatePayload(_ context.Context, _ logger.Logger, _, _ int) (*Payload, error) {
+	return &Payload{Data: []byte(r.data)}, nil
+}
+
+// TestRequestContentTCP tests a round trip on a loopback TCP listener with a length prefixed reply.
+func TestRequestContentTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer ln.Close()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		line, err := bufio.NewReader(conn).ReadString('\n')
+		if err != nil {
+			return
+		}
+		received <- line
+		reply := `{"echo":"ping"}`
+		prefix := make([]byte, 2)
+		binary.BigEndian.PutUint16(prefix, uint16(len(reply)))
+		conn.Write(append(prefix, reply...))
+	}()
+
+	resp, err := RequestContent[testPayloadReq]{
+		Req:          testPayloadReq{data: "ping\n"},
+		Network:      NetworkTCP,
+		Address:      ln.Addr().String(),
+		Framing:      Framing{Type: FramingTypeLengthPrefix, PrefixSize: 2, ByteOrder: binary.BigEndian},
+		Timeout:      5 * time.Second,
+		ResponseType: httpexec.ResponseTypeJSON,
+	}.RequestExecute(context.Background(), logger.NewSlogLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := <-received; got != "ping\n" {
+		t.Errorf("expected %q, got %q", "ping\n", got)
+	}
+	if !resp.Success {
+		t.Errorf("expected the exchange to succeed")
+	}
+	if want := map[string]any{"echo": "ping"}; !reflect.DeepEqual(resp.Res, want) {
+		t.Errorf("expected %v, got %v", want, resp.Res)
+	}
+}
+
+// TestRequestContentUDP tests a round trip on a loopback UDP socket with a delimited reply.
+func TestRequestContentUDP(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer pc.Close()
+
+	received := make(chan string, 1)
+	go func() {
+		buf := make([]byte, maxDatagramSize)
+		n, addr, err := pc.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		received <- string(buf[:n])
+		pc.WriteTo([]byte("pong\nignored"), addr)
+	}()
+
+	resp, err := RequestContent[testPayloadReq]{
+		Req:          testPayloadReq{data: "ping"},
+		Network:      NetworkUDP,
+		Address:      pc.LocalAddr().String(),
+		Framing:      Framing{Type: FramingTypeDelimiter, Delimiter: []byte("\n")},
+		Timeout:      5 * time.Second,
+		ResponseType: httpexec.ResponseTypeText,
+	}.RequestExecute(context.Background(), logger.NewSlogLogger())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := <-received; got != "ping" {
+		t.Errorf("expected %q, got %q", "ping", got)
+	}
+	if !resp.Success {
+		t.Errorf("expected the exchange to succeed")
+	}
+	if resp.Res != "pong" {
+		t.Errorf("expected %v, got %v", "pong", resp.Res)
+	}
+}
@@ -0,0 +1,309 @@
+// Package wsexec provides the executor for the WebSocket connections.
+package wsexec
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/ablankz/bloader/internal/executor/httpexec"
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+const (
+	// handshakeTimeout is the timeout of the opening handshake
+	handshakeTimeout = 30 * time.Second
+	// closeTimeout is the time to wait for the close frame of the peer
+	closeTimeout = 3 * time.Second
+)
+
+// Message represents the message sent on the connection
+type Message struct {
+	// Type is the frame type, websocket.TextMessage or websocket.BinaryMessage
+	Type int
+	Data []byte
+}
+
+// ExecReq represents the message creator
+type ExecReq interface {
+	// CreateMessage creates the message of the count sent on the connection
+	CreateMessage(ctx context.Context, log logger.Logger, count, connID int) (*Message, error)
+}
+
+// ReplyMatcher reports whether the incoming frame is the reply of the sent message
+type ReplyMatcher func(res any) (bool, error)
+
+// ConnectionContent represents the lifetime of a connection
+type ConnectionContent struct {
+	ID              int
+	ConnectTime     time.Time
+	CloseTime       time.Time
+	HandshakeStatus int
+	CloseCode       int
+	CloseReason     string
+	Sent            int64
+	Received        int64
+}
+
+// MassRequestContent represents the request content.
+// Connections long-lived connections are opened, and each of them sends a message on Interval.
+// An incoming frame matched by ReplyMatcher is the reply to the oldest pending message of the connection,
+// and it is delivered to ResChan with the round-trip time. Every frame is a reply when ReplyMatcher is nil.
+type MassRequestContent[Req ExecReq] struct {
+	Req          Req
+	URL          string
+	Header       http.Header
+	Connections  int
+	Interval     time.Duration
+	ResChan      chan<- httpexec.ResponseContent
+	CountLimit   httpexec.RequestCountLimit
+	ResponseType httpexec.ResponseType
+	ReplyMatcher ReplyMatcher
+	// OnClose is called when a connection is closed
+	OnClose func(ConnectionContent)
+	// Running tracks the running connections if set, so that the caller can wait for them to be closed
+	Running *sync.WaitGroup
+}
+
+// MassRequestExecute executes the request
+func (q MassRequestContent[Req]) MassRequestExecute(
+	ctx context.Context,
+	log logger.Logger,
+) error {
+	var count atomic.Int64
+	if q.Running != nil {
+		q.Running.Add(q.Connections)
+	}
+	for i := 0; i < q.Connections; i++ {
+		go func(connID int) {
+			if q.Running != nil {
+				defer q.Running.Done()
+			}
+			q.runConnection(ctx, log, connID, &count)
+		}(i)
+	}
+	return nil
+}
+
+type pendingMessage struct {
+	count     int
+	sendTime  time.Time
+	countOver bool
+}
+
+// runConnection opens the connection, and sends and receives the messages until the context is done
+func (q MassRequestContent[Req]) runConnection(
+	ctx context.Context,
+	log logger.Logger,
+	connID int,
+	count *atomic.Int64,
+) {
+	content := ConnectionContent{ID: connID}
+	defer func() {
+		content.CloseTime = time.Now()
+		if q.OnClose != nil {
+			q.OnClose(content)
+		}
+	}()
+
+	dialer := websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: handshakeTimeout,
+	}
+	content.ConnectTime = time.Now()
+	conn, resp, err := dialer.DialContext(ctx, q.URL, q.Header)
+	if resp != nil {
+		content.HandshakeStatus = resp.StatusCode
+		if resp.Body != nil {
+			resp.Body.Close()
+		}
+	}
+	if err != nil {
+		log.Error(ctx, "failed to connect",
+			logger.Value("error", err), logger.Value("on", "MassRequestContent.runConnection"), logger.Value("connection", connID))
+		content.CloseCode = websocket.CloseAbnormalClosure
+		content.CloseReason = err.Error()
+		q.deliver(ctx, log, httpexec.ResponseContent{
+			Success:      false,
+			StartTime:    content.ConnectTime,
+			EndTime:      time.Now(),
+			StatusCode:   content.HandshakeStatus,
+			HasSystemErr: true,
+		})
+		return
+	}
+	defer conn.Close()
+
+	connCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mu sync.Mutex
+	var pending []pendingMessage
+	var writeMu sync.Mutex
+	var sent, received atomic.Int64
+
+	httpexec.Schedule{
+		Interval: q.Interval,
+	}.Run(connCtx, log, func(_, _ int, _ bool) {
+		n := int(count.Add(1))
+		if q.CountLimit.Enabled && n > q.CountLimit.Count {
+			return
+		}
+		countOver := q.CountLimit.Enabled && n == q.CountLimit.Count
+		msg, err := q.Req.CreateMessage(connCtx, log, n, connID)
+		if err != nil {
+			log.Error(ctx, "failed to create message",
+				logger.Value("error", err), logger.Value("on", "MassRequestContent.runConnection"), logger.Value("connection", connID))
+			q.deliver(ctx, log, httpexec.ResponseContent{
+				Success:        false,
+				Count:          n,
+				HasSystemErr:   true,
+				WithCountLimit: countOver,
+			})
+			return
+		}
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		sendTime := time.Now()
+		mu.Lock()
+		pending = append(pending, pendingMessage{count: n, sendTime: sendTime, countOver: countOver})
+		mu.Unlock()
+		if err := conn.WriteMessage(msg.Type, msg.Data); err != nil {
+			log.Error(ctx, "failed to send message",
+				logger.Value("error", err), logger.Value("on", "MassRequestContent.runConnection"), logger.Value("connection", connID))
+			mu.Lock()
+			pending = pending[:len(pending)-1]
+			mu.Unlock()
+			q.deliver(ctx, log, httpexec.ResponseContent{
+				Success:        false,
+				StartTime:      sendTime,
+				EndTime:        time.Now(),
+				Count:          n,
+				HasSystemErr:   true,
+				WithCountLimit: countOver,
+			})
+			return
+		}
+		sent.Add(1)
+	})
+
+	readDone := make(chan error, 1)
+	go func() {
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				readDone <- err
+				return
+			}
+			endTime := time.Now()
+			received.Add(1)
+			response, parseErr := httpexec.DecodeResponse(q.ResponseType, data)
+			if parseErr == nil && q.ReplyMatcher != nil {
+				match, err := q.ReplyMatcher(response)
+				if err != nil {
+					log.Warn(ctx, "failed to match reply",
+						logger.Value("error", err), logger.Value("on", "MassRequestContent.runConnection"))
+				}
+				if !match {
+					continue
+				}
+			}
+			mu.Lock()
+			if len(pending) == 0 {
+				mu.Unlock()
+				continue
+			}
+			p := pending[0]
+			pending = pending[1:]
+			mu.Unlock()
+
+			res := httpexec.ResponseContent{
+				Success:        parseErr == nil,
+				Res:            response,
+				ByteResponse:   data,
+				StartTime:      p.sendTime,
+				EndTime:        endTime,
+				Count:          p.count,
+				ResponseTime:   endTime.Sub(p.sendTime).Milliseconds(),
+				StatusCode:     content.HandshakeStatus,
+				ParseResHasErr: parseErr != nil,
+				WithCountLimit: p.countOver,
+			}
+			if parseErr != nil {
+				log.Error(ctx, "failed to parse message",
+					logger.Value("error", parseErr), logger.Value("on", "MassRequestContent.runConnection"))
+			}
+			q.deliver(ctx, log, res)
+		}
+	}()
+
+	var readErr error
+	select {
+	case readErr = <-readDone:
+	case <-ctx.Done():
+		cancel()
+		writeMu.Lock()
+		err := conn.WriteControl(
+			websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+			time.Now().Add(closeTimeout),
+		)
+		writeMu.Unlock()
+		if err != nil {
+			log.Debug(ctx, "failed to send close frame",
+				logger.Value("error", err), logger.Value("on", "MassRequestContent.runConnection"))
+		}
+		select {
+		case readErr = <-readDone:
+		case <-time.After(closeTimeout):
+		}
+	}
+	content.Sent = sent.Load()
+	content.Received = received.Load()
+
+	var closeErr *websocket.CloseError
+	switch {
+	case errors.As(readErr, &closeErr):
+		content.CloseCode = closeErr.Code
+		content.CloseReason = closeErr.Text
+	case ctx.Err() != nil:
+		content.CloseCode = websocket.CloseNormalClosure
+	default:
+		content.CloseCode = websocket.CloseAbnormalClosure
+		if readErr != nil {
+			content.CloseReason = readErr.Error()
+		}
+	}
+	if ctx.Err() == nil && content.CloseCode != websocket.CloseNormalClosure {
+		log.Error(ctx, "connection closed unexpectedly",
+			logger.Value("on", "MassRequestContent.runConnection"),
+			logger.Value("connection", connID),
+			logger.Value("code", content.CloseCode),
+			logger.Value("reason", content.CloseReason),
+		)
+		q.deliver(ctx, log, httpexec.ResponseContent{
+			Success:      false,
+			StartTime:    content.ConnectTime,
+			EndTime:      time.Now(),
+			StatusCode:   content.HandshakeStatus,
+			HasSystemErr: true,
+		})
+	}
+}
+
+// deliver delivers the response to ResChan unless the context is done
+func (q MassRequestContent[Req]) deliver(ctx context.Context, log logger.Logger, res httpexec.ResponseContent) {
+	select {
+	case q.ResChan <- res:
+	case <-ctx.Done():
+		log.Info(ctx, "request processing is interrupted due to context termination",
+			logger.Value("on", "MassRequestContent.deliver"))
+	}
+}
+
+var _ httpexec.MassRequestExecutor = MassRequestContent[ExecReq]{}
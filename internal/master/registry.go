@@ -0,0 +1,139 @@
+package master
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// RegisteredSlave represents the slave registered on the master
+type RegisteredSlave struct {
+	ID        string
+	URI       string
+	Labels    map[string]string
+	Capacity  int
+	ExpiresAt time.Time
+}
+
+// Matches returns whether the slave has all the labels
+func (s RegisteredSlave) Matches(labels map[string]string) bool {
+	for k, v := range labels {
+		if s.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Registry holds the slaves registered on the master.
+// A registration is kept until its lease expires, so the slave must refresh it.
+type Registry struct {
+	mu      *sync.Mutex
+	slaves  map[string]RegisteredSlave // Key: slaveID
+	changed chan struct{}
+}
+
+// NewRegistry creates a new Registry
+func NewRegistry() *Registry {
+	return &Registry{
+		mu:      &sync.Mutex{},
+		slaves:  make(map[string]RegisteredSlave),
+		changed: make(chan struct{}),
+	}
+}
+
+// Register registers or refreshes the slave, and returns whether the slave is newly registered
+func (r *Registry) Register(slave RegisteredSlave) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	prev, ok := r.slaves[slave.ID]
+	r.slaves[slave.ID] = slave
+	close(r.changed)
+	r.changed = make(chan struct{})
+	return !ok || time.Now().After(prev.ExpiresAt)
+}
+
+// Deregister removes the slave
+func (r *Registry) Deregister(slaveID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.slaves, slaveID)
+}
+
+// List returns the slaves of the unexpired registration, ordered by the ID
+func (r *Registry) List() []RegisteredSlave {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.list(nil, nil)
+}
+
+// list returns the unexpired slaves having the labels, excluding the slave IDs.
+// The caller must hold the lock.
+func (r *Registry) list(labels map[string]string, exclude map[string]struct{}) []RegisteredSlave {
+	now := time.Now()
+	var slaves []RegisteredSlave
+	for id, slave := range r.slaves {
+		if now.After(slave.ExpiresAt) {
+			delete(r.slaves, id)
+			continue
+		}
+		if _, ok := exclude[id]; ok {
+			continue
+		}
+		if slave.Matches(labels) {
+			slaves = append(slaves, slave)
+		}
+	}
+	sort.Slice(slaves, func(i, j int) bool {
+		return slaves[i].ID < slaves[j].ID
+	})
+	return slaves
+}
+
+// Select returns count slaves having the labels, the slaves of the larger capacity are selected first.
+// All the matching slaves are returned when count is 0.
+// It waits for the slaves to register until the timeout, the slave IDs in exclude are never selected.
+func (r *Registry) Select(
+	ctx context.Context,
+	labels map[string]string,
+	count int,
+	timeout time.Duration,
+	exclude map[string]struct{},
+) ([]RegisteredSlave, error) {
+	want := count
+	if want == 0 {
+		want = 1
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		r.mu.Lock()
+		slaves := r.list(labels, exclude)
+		changed := r.changed
+		r.mu.Unlock()
+
+		if len(slaves) >= want {
+			sort.SliceStable(slaves, func(i, j int) bool {
+				return slaves[i].Capacity > slaves[j].Capacity
+			})
+			if count > 0 {
+				slaves = slaves[:count]
+			}
+			return slaves, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			return nil, fmt.Errorf("%d of %d slaves matching the selector are registered", len(slaves), want)
+		case <-changed:
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package master
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestRegisteredSlaveMatches tests the label matching of the selector.
+func TestRegisteredSlaveMatches(t *testing.T) {
+	slave := RegisteredSlave{ID: "s1", Labels: map[string]string{"region": "eu", "tier": "large"}}
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   bool
+	}{
+		{name: "NoLabels", labels: nil, want: true},
+		{name: "Subset", labels: map[string]string{"region": "eu"}, want: true},
+		{name: "All", labels: map[string]string{"region": "eu", "tier": "large"}, want: true},
+		{name: "DifferentValue", labels: map[string]string{"region": "us"}, want: false},
+		{name: "MissingLabel", labels: map[string]string{"region": "eu", "zone": "a"}, want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			if got := slave.Matches(test.labels); got != test.want {
+				tt.Errorf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+// TestRegistryRegister tests the registration, the refresh with the same ID, the expiry and the deregistration.
+func TestRegistryRegister(t *testing.T) {
+	r := NewRegistry()
+	lease := time.Now().Add(time.Minute)
+
+	if !r.Register(RegisteredSlave{ID: "s1", URI: "dns:a:9900", Capacity: 1, ExpiresAt: lease}) {
+		t.Errorf("expected the first registration to be new")
+	}
+	if r.Register(RegisteredSlave{ID: "s1", URI: "dns:b:9900", Capacity: 2, ExpiresAt: lease}) {
+		t.Errorf("expected the refresh not to be new")
+	}
+	want := []RegisteredSlave{{ID: "s1", URI: "dns:b:9900", Capacity: 2, ExpiresAt: lease}}
+	if got := r.List(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	r.Register(RegisteredSlave{ID: "s2", URI: "dns:c:9900", Capacity: 1, ExpiresAt: time.Now().Add(-time.Second)})
+	if got := r.List(); len(got) != 1 || got[0].ID != "s1" {
+		t.Errorf("expected the expired slave to be excluded, got %v", got)
+	}
+	if !r.Register(RegisteredSlave{ID: "s2", URI: "dns:c:9900", Capacity: 1, ExpiresAt: lease}) {
+		t.Errorf("expected the registration after the expiry to be new")
+	}
+	if got := r.List(); len(got) != 2 || got[0].ID != "s1" || got[1].ID != "s2" {
+		t.Errorf("expected the slaves ordered by the ID, got %v", got)
+	}
+
+	r.Deregister("s1")
+	if got := r.List(); len(got) != 1 || got[0].ID != "s2" {
+		t.Errorf("expected the deregistered slave to be removed, got %v", got)
+	}
+}
+
+// TestRegistrySelect tests the selection of the registered slaves by the selector.
+func TestRegistrySelect(t *testing.T) {
+	lease := time.Now().Add(time.Minute)
+	slaves := []RegisteredSlave{
+		{ID: "a", Labels: map[string]string{"region": "eu"}, Capacity: 1, ExpiresAt: lease},
+		{ID: "b", Labels: map[string]string{"region": "eu"}, Capacity: 4, ExpiresAt: lease},
+		{ID: "c", Labels: map[string]string{"region": "eu"}, Capacity: 2, ExpiresAt: lease},
+		{ID: "d", Labels: map[string]string{"region": "us"}, Capacity: 8, ExpiresAt: lease},
+	}
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		count   int
+		exclude map[string]struct{}
+		want    []string
+		wantErr bool
+	}{
+		{name: "LargerCapacityFirst", labels: map[string]string{"region": "eu"}, count: 2, want: []string{"b", "c"}},
+		{name: "AllMatching", labels: map[string]string{"region": "eu"}, want: []string{"b", "c", "a"}},
+		{name: "NoLabels", count: 1, want: []string{"d"}},
+		{
+			name:    "Exclude",
+			labels:  map[string]string{"region": "eu"},
+			count:   2,
+			exclude: map[string]struct{}{"b": {}},
+			want:    []string{"c", "a"},
+		},
+		{name: "NotEnough", labels: map[string]string{"region": "eu"}, count: 4, wantErr: true},
+		{name: "NoMatch", labels: map[string]string{"region": "ap"}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			r := NewRegistry()
+			for _, s := range slaves {
+				r.Register(s)
+			}
+			got, err := r.Select(context.Background(), test.labels, test.count, 10*time.Millisecond, test.exclude)
+			if (err != nil) != test.wantErr {
+				tt.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+			var ids []string
+			for _, s := range got {
+				ids = append(ids, s.ID)
+			}
+			if !reflect.DeepEqual(ids, test.want) {
+				tt.Errorf("expected %v, got %v", test.want, ids)
+			}
+		})
+	}
+}
+
+// TestRegistrySelectWait tests that the selection waits for the slaves registered later.
+func TestRegistrySelectWait(t *testing.T) {
+	r := NewRegistry()
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		r.Register(RegisteredSlave{
+			ID:        "late",
+			Labels:    map[string]string{"region": "eu"},
+			Capacity:  1,
+			ExpiresAt: time.Now().Add(time.Minute),
+		})
+	}()
+	got, err := r.Select(context.Background(), map[string]string{"region": "eu"}, 1, 5*time.Second, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != "late" {
+		t.Errorf("expected %v, got %v", "late", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := r.Select(ctx, map[string]string{"region": "us"}, 1, 5*time.Second, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+}
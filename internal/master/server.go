@@ -0,0 +1,131 @@
+package master
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
+	"github.com/ablankz/bloader/internal/config"
+	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/utils"
+)
+
+// Server represents the registration server of the master node
+type Server struct {
+	log      logger.Logger
+	registry *Registry
+}
+
+// NewServer creates a new registration server
+func NewServer(log logger.Logger, registry *Registry) *Server {
+	return &Server{
+		log:      log,
+		registry: registry,
+	}
+}
+
+// Register handles the registration request from the slave node
+func (s *Server) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	if req.SlaveId == "" || req.Uri == "" {
+		return nil, status.Error(codes.InvalidArgument, "slave_id and uri are required")
+	}
+	if req.Capacity <= 0 || req.LeaseSeconds <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "capacity and lease_seconds must be greater than 0")
+	}
+
+	if s.registry.Register(RegisteredSlave{
+		ID:        req.SlaveId,
+		URI:       req.Uri,
+		Labels:    req.Labels,
+		Capacity:  int(req.Capacity),
+		ExpiresAt: time.Now().Add(time.Duration(req.LeaseSeconds) * time.Second),
+	}) {
+		s.log.Info(ctx, "slave registered",
+			logger.Value("slaveID", req.SlaveId), logger.Value("uri", req.Uri),
+			logger.Value("labels", req.Labels), logger.Value("capacity", req.Capacity),
+			logger.Value("on", "Server.Register"))
+	}
+
+	return &pb.RegisterResponse{}, nil
+}
+
+// Deregister handles the deregistration request from the slave node
+func (s *Server) Deregister(ctx context.Context, req *pb.DeregisterRequest) (*pb.DeregisterResponse, error) {
+	s.registry.Deregister(req.SlaveId)
+	s.log.Info(ctx, "slave deregistered",
+		logger.Value("slaveID", req.SlaveId), logger.Value("on", "Server.Deregister"))
+
+	return &pb.DeregisterResponse{}, nil
+}
+
+// UnaryServerTokenInterceptor is a server-side interceptor that rejects the request without the bearer token.
+func UnaryServerTokenInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		var got string
+		if values := md.Get("authorization"); len(values) > 0 {
+			got = strings.TrimPrefix(values[0], "Bearer ")
+		}
+		if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "invalid token")
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Serve starts the registration server on the port, it is stopped when the context is done.
+// The server is served over TLS, and rejects the request without the token.
+func Serve(
+	ctx context.Context,
+	log logger.Logger,
+	port int,
+	conf config.ValidServerRegistrationConfig,
+	registry *Registry,
+) error {
+	tlsConfig, err := utils.ServerTLSConfig(conf.Certificate.Cert, conf.Certificate.Key, conf.Certificate.ClientCACert)
+	if err != nil {
+		return fmt.Errorf("failed to load certificate: %w", err)
+	}
+	grpcServer := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.UnaryInterceptor(UnaryServerTokenInterceptor(conf.Token)),
+	)
+	pb.RegisterBloaderMasterServiceServer(grpcServer, NewServer(log, registry))
+
+	lister, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+
+	log.Info(ctx, "Starting the registration server",
+		logger.Value("port", port))
+
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+	}()
+	go func() {
+		if err := grpcServer.Serve(lister); err != nil {
+			log.Error(ctx, "failed to serve the registration server",
+				logger.Value("error", err), logger.Value("on", "Serve"))
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,138 @@
+// Package metrics provides the Prometheus metrics of the requests executed by the node during a run.
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/ablankz/bloader/internal/executor/httpexec"
+)
+
+// namespace represents the prefix of the metric names
+const namespace = "bloader"
+
+// LatencyBuckets represents the upper bounds of the request duration histogram in seconds
+var LatencyBuckets = []float64{0.001, 0.002, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Metrics represents the Prometheus metrics of the node.
+// All the methods do nothing on the nil metrics, so that the runners record them unconditionally.
+type Metrics struct {
+	registry      *prometheus.Registry
+	requests      *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	inFlight      *prometheus.GaugeVec
+	breaks        *prometheus.CounterVec
+	activeThreads prometheus.Gauge
+}
+
+// New creates a new Metrics, the labels are added to all the metrics
+func New(labels map[string]string) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Number of the responses received, by target, endpoint, status code and success.",
+		}, []string{"target", "endpoint", "status_code", "success"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of the requests, by target, endpoint and status code.",
+			Buckets:   LatencyBuckets,
+		}, []string{"target", "endpoint", "status_code"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "requests_in_flight",
+			Help:      "Number of the requests sent and waiting for the response, by target and endpoint.",
+		}, []string{"target", "endpoint"}),
+		breaks: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "break_events_total",
+			Help:      "Number of the threads ended by a break condition, by runner, type and whether it is a success break.",
+		}, []string{"runner", "type", "success"}),
+		activeThreads: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "active_threads",
+			Help:      "Number of the threads and the virtual users running.",
+		}),
+	}
+	reg := prometheus.WrapRegistererWith(labels, m.registry)
+	reg.MustRegister(
+		m.requests,
+		m.duration,
+		m.inFlight,
+		m.breaks,
+		m.activeThreads,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return m
+}
+
+// Handler returns the http handler exposing the metrics
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// Gatherer returns the gatherer of the metrics
+func (m *Metrics) Gatherer() prometheus.Gatherer {
+	return m.registry
+}
+
+// RequestStarted counts the request as in flight, and returns the function called when it completes
+func (m *Metrics) RequestStarted(target, endpoint string) func() {
+	if m == nil {
+		return func() {}
+	}
+	g := m.inFlight.WithLabelValues(target, endpoint)
+	g.Inc()
+	return g.Dec
+}
+
+// Record records the response of the request
+func (m *Metrics) Record(target, endpoint string, res httpexec.ResponseContent) {
+	if m == nil {
+		return
+	}
+	code := strconv.Itoa(res.StatusCode)
+	m.requests.WithLabelValues(target, endpoint, code, strconv.FormatBool(res.Success)).Inc()
+	if res.StartTime.IsZero() {
+		return
+	}
+	m.duration.WithLabelValues(target, endpoint, code).Observe(res.EndTime.Sub(res.StartTime).Seconds())
+}
+
+// BreakEvent records the thread of the runner ended by the break condition
+func (m *Metrics) BreakEvent(runner, termType string, success bool) {
+	if m == nil {
+		return
+	}
+	m.breaks.WithLabelValues(runner, termType, strconv.FormatBool(success)).Inc()
+}
+
+// ThreadStarted counts the thread as active, and returns the function called when the thread ends
+func (m *Metrics) ThreadStarted() func() {
+	if m == nil {
+		return func() {}
+	}
+	m.activeThreads.Inc()
+	return m.activeThreads.Dec
+}
+
+type metricsKey struct{}
+
+// With returns the context which records the executions run under it to the metrics
+func With(ctx context.Context, m *Metrics) context.Context {
+	return context.WithValue(ctx, metricsKey{}, m)
+}
+
+// From returns the metrics of the context, nil if it has none
+func From(ctx context.Context) *Metrics {
+	m, _ := ctx.Value(metricsKey{}).(*Metrics)
+	return m
+}
@@ -0,0 +1,226 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/snappy"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/ablankz/bloader/internal/config"
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+// remoteWriteTimeout represents the timeout of a push to the remote write endpoint
+const remoteWriteTimeout = 10 * time.Second
+
+// RemoteWriter pushes the metrics to the remote write endpoint of Prometheus on the interval
+type RemoteWriter struct {
+	metrics  *Metrics
+	conf     config.ValidMetricsRemoteWriteConfig
+	client   *http.Client
+	stopChan chan struct{}
+	stopOnce *sync.Once
+	doneChan chan struct{}
+}
+
+// NewRemoteWriter creates a new RemoteWriter
+func NewRemoteWriter(m *Metrics, conf config.ValidMetricsRemoteWriteConfig) *RemoteWriter {
+	return &RemoteWriter{
+		metrics: m,
+		conf:    conf,
+		client: &http.Client{
+			Timeout: remoteWriteTimeout,
+		},
+		stopChan: make(chan struct{}),
+		stopOnce: &sync.Once{},
+		doneChan: make(chan struct{}),
+	}
+}
+
+// Run pushes the metrics on the interval until the writer is stopped or the context is done,
+// and pushes the last values before it returns
+func (w *RemoteWriter) Run(ctx context.Context, log logger.Logger) {
+	defer close(w.doneChan)
+	log.Info(ctx, "Starting the metrics remote write",
+		logger.Value("url", w.conf.URL), logger.Value("interval", w.conf.Interval))
+	ticker := time.NewTicker(w.conf.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.pushAndLog(context.WithoutCancel(ctx), log)
+			return
+		case <-w.stopChan:
+			w.pushAndLog(ctx, log)
+			return
+		case <-ticker.C:
+		}
+		w.pushAndLog(ctx, log)
+	}
+}
+
+// Stop stops the writer, and waits for the last push
+func (w *RemoteWriter) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stopChan)
+	})
+	<-w.doneChan
+}
+
+// pushAndLog pushes the metrics, and logs the failure
+func (w *RemoteWriter) pushAndLog(ctx context.Context, log logger.Logger) {
+	if err := w.push(ctx); err != nil {
+		log.Warn(ctx, "failed to push the metrics",
+			logger.Value("error", err), logger.Value("url", w.conf.URL), logger.Value("on", "RemoteWriter.push"))
+	}
+}
+
+// push sends the current values of the metrics as a snappy compressed WriteRequest
+func (w *RemoteWriter) push(ctx context.Context) error {
+	families, err := w.metrics.Gatherer().Gather()
+	if err != nil {
+		return fmt.Errorf("failed to gather metrics: %w", err)
+	}
+	body := snappy.Encode(nil, encodeWriteRequest(families, time.Now().UnixMilli()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.conf.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("User-Agent", "bloader")
+	req.Header.Set("X-Prometheus-Remote-Write-Version", "0.1.0")
+	for k, v := range w.conf.Headers {
+		req.Header.Set(k, v)
+	}
+	res, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("remote write responded %s: %s", res.Status, bytes.TrimSpace(msg))
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	return nil
+}
+
+// remoteLabel represents a label of the time series
+type remoteLabel struct {
+	name  string
+	value string
+}
+
+// encodeWriteRequest encodes the metric families as the WriteRequest protobuf of the remote write protocol.
+// The histograms and the summaries are flattened into the series of the text exposition format.
+func encodeWriteRequest(families []*dto.MetricFamily, timestampMs int64) []byte {
+	var buf []byte
+	for _, mf := range families {
+		for _, metric := range mf.GetMetric() {
+			add := func(suffix string, value float64, extra ...remoteLabel) {
+				buf = protowire.AppendTag(buf, 1, protowire.BytesType)
+				buf = protowire.AppendBytes(buf,
+					encodeTimeSeries(mf.GetName()+suffix, metric.GetLabel(), extra, value, timestampMs))
+			}
+			switch mf.GetType() {
+			case dto.MetricType_COUNTER:
+				add("", metric.GetCounter().GetValue())
+			case dto.MetricType_GAUGE:
+				add("", metric.GetGauge().GetValue())
+			case dto.MetricType_UNTYPED:
+				add("", metric.GetUntyped().GetValue())
+			case dto.MetricType_HISTOGRAM, dto.MetricType_GAUGE_HISTOGRAM:
+				h := metric.GetHistogram()
+				inf := false
+				for _, b := range h.GetBucket() {
+					inf = inf || math.IsInf(b.GetUpperBound(), 1)
+					add("_bucket", float64(b.GetCumulativeCount()),
+						remoteLabel{name: "le", value: formatFloat(b.GetUpperBound())})
+				}
+				if !inf {
+					add("_bucket", float64(h.GetSampleCount()), remoteLabel{name: "le", value: "+Inf"})
+				}
+				add("_sum", h.GetSampleSum())
+				add("_count", float64(h.GetSampleCount()))
+			case dto.MetricType_SUMMARY:
+				s := metric.GetSummary()
+				for _, q := range s.GetQuantile() {
+					add("", q.GetValue(), remoteLabel{name: "quantile", value: formatFloat(q.GetQuantile())})
+				}
+				add("_sum", s.GetSampleSum())
+				add("_count", float64(s.GetSampleCount()))
+			}
+		}
+	}
+	return buf
+}
+
+// encodeTimeSeries encodes a TimeSeries of a single sample, the labels are sorted by the name as required.
+// The fields of the default values are omitted as proto3, so that the bytes are the same as prompb.
+func encodeTimeSeries(
+	name string,
+	pairs []*dto.LabelPair,
+	extra []remoteLabel,
+	value float64,
+	timestampMs int64,
+) []byte {
+	labels := make([]remoteLabel, 0, len(pairs)+len(extra)+1)
+	labels = append(labels, remoteLabel{name: "__name__", value: name})
+	for _, p := range pairs {
+		labels = append(labels, remoteLabel{name: p.GetName(), value: p.GetValue()})
+	}
+	labels = append(labels, extra...)
+	sort.Slice(labels, func(i, j int) bool {
+		return labels[i].name < labels[j].name
+	})
+
+	var buf []byte
+	for _, l := range labels {
+		var lb []byte
+		if l.name != "" {
+			lb = protowire.AppendTag(lb, 1, protowire.BytesType)
+			lb = protowire.AppendString(lb, l.name)
+		}
+		if l.value != "" {
+			lb = protowire.AppendTag(lb, 2, protowire.BytesType)
+			lb = protowire.AppendString(lb, l.value)
+		}
+		buf = protowire.AppendTag(buf, 1, protowire.BytesType)
+		buf = protowire.AppendBytes(buf, lb)
+	}
+	var sample []byte
+	if bits := math.Float64bits(value); bits != 0 {
+		sample = protowire.AppendTag(sample, 1, protowire.Fixed64Type)
+		sample = protowire.AppendFixed64(sample, bits)
+	}
+	if timestampMs != 0 {
+		sample = protowire.AppendTag(sample, 2, protowire.VarintType)
+		sample = protowire.AppendVarint(sample, uint64(timestampMs))
+	}
+	buf = protowire.AppendTag(buf, 2, protowire.BytesType)
+	buf = protowire.AppendBytes(buf, sample)
+	return buf
+}
+
+// formatFloat formats the bound of the bucket or the quantile as the text exposition format does
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,259 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/golang/snappy"
+	dto "github.com/prometheus/client_model/go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/ablankz/bloader/internal/config"
+)
+
+// remoteWriteProto is the WriteRequest of prompb, the protobuf of the remote write protocol
+const remoteWriteProto = `
+syntax = "proto3";
+package prometheus;
+
+message WriteRequest {
+  repeated TimeSeries timeseries = 1;
+  reserved 2;
+  reserved 3;
+}
+
+message TimeSeries {
+  repeated Label labels = 1;
+  repeated Sample samples = 2;
+}
+
+message Label {
+  string name = 1;
+  string value = 2;
+}
+
+message Sample {
+  double value = 1;
+  int64 timestamp = 2;
+}
+`
+
+// testSeries represents a decoded time series, the labels are "name=value" in the written order
+type testSeries struct {
+	Labels    []string
+	Value     float64
+	Timestamp int64
+}
+
+// decodeWriteRequest decodes the WriteRequest with the descriptor compiled from remoteWriteProto
+func decodeWriteRequest(t *testing.T, b []byte) []testSeries {
+	t.Helper()
+	compiler := protocompile.Compiler{
+		Resolver: &protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(map[string]string{"remote.proto": remoteWriteProto}),
+		},
+	}
+	files, err := compiler.Compile(context.Background(), "remote.proto")
+	if err != nil {
+		t.Fatalf("failed to compile proto: %v", err)
+	}
+	desc := files[0].Messages().ByName("WriteRequest")
+	msg := dynamicpb.NewMessage(desc)
+	if err := proto.Unmarshal(b, msg); err != nil {
+		t.Fatalf("failed to unmarshal WriteRequest: %v", err)
+	}
+	// the encoding is canonical when the decoded message is marshaled back to the same bytes
+	re, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		t.Fatalf("failed to marshal WriteRequest: %v", err)
+	}
+	if !bytes.Equal(re, b) {
+		t.Errorf("WriteRequest is not encoded canonically")
+	}
+
+	var series []testSeries
+	tsList := msg.Get(desc.Fields().ByName("timeseries")).List()
+	for i := 0; i < tsList.Len(); i++ {
+		ts := tsList.Get(i).Message()
+		tsDesc := ts.Descriptor()
+		var s testSeries
+		labels := ts.Get(tsDesc.Fields().ByName("labels")).List()
+		for j := 0; j < labels.Len(); j++ {
+			l := labels.Get(j).Message()
+			s.Labels = append(s.Labels, fmt.Sprintf("%s=%s",
+				l.Get(l.Descriptor().Fields().ByName("name")).String(),
+				l.Get(l.Descriptor().Fields().ByName("value")).String()))
+		}
+		samples := ts.Get(tsDesc.Fields().ByName("samples")).List()
+		if samples.Len() != 1 {
+			t.Fatalf("expected a sample in the series %v, got %d", s.Labels, samples.Len())
+		}
+		sample := samples.Get(0).Message()
+		fields := sample.Descriptor().Fields()
+		s.Value = sample.Get(fields.ByName("value")).Float()
+		s.Timestamp = sample.Get(fields.ByName("timestamp")).Int()
+		series = append(series, s)
+	}
+	return series
+}
+
+// TestEncodeWriteRequest tests the metric families are encoded as the time series of the WriteRequest.
+func TestEncodeWriteRequest(t *testing.T) {
+	const ts = int64(1700000000000)
+	label := func(name, value string) *dto.LabelPair {
+		return &dto.LabelPair{Name: proto.String(name), Value: proto.String(value)}
+	}
+	tests := []struct {
+		name   string
+		family *dto.MetricFamily
+		want   []testSeries
+	}{
+		{
+			name: "CounterWithSortedLabels",
+			family: &dto.MetricFamily{
+				Name: proto.String("bloader_requests_total"),
+				Type: dto.MetricType_COUNTER.Enum(),
+				Metric: []*dto.Metric{{
+					Label:   []*dto.LabelPair{label("target", "api"), label("endpoint", "/users")},
+					Counter: &dto.Counter{Value: proto.Float64(3)},
+				}},
+			},
+			want: []testSeries{
+				{Labels: []string{"__name__=bloader_requests_total", "endpoint=/users", "target=api"}, Value: 3, Timestamp: ts},
+			},
+		},
+		{
+			name: "GaugesWithDefaultValues",
+			family: &dto.MetricFamily{
+				Name: proto.String("bloader_requests_in_flight"),
+				Type: dto.MetricType_GAUGE.Enum(),
+				Metric: []*dto.Metric{
+					{Label: []*dto.LabelPair{label("target", "a")}, Gauge: &dto.Gauge{Value: proto.Float64(2)}},
+					{Label: []*dto.LabelPair{label("target", "b")}, Gauge: &dto.Gauge{Value: proto.Float64(-0.5)}},
+					{Label: []*dto.LabelPair{label("target", "")}, Gauge: &dto.Gauge{Value: proto.Float64(0)}},
+				},
+			},
+			want: []testSeries{
+				{Labels: []string{"__name__=bloader_requests_in_flight", "target=a"}, Value: 2, Timestamp: ts},
+				{Labels: []string{"__name__=bloader_requests_in_flight", "target=b"}, Value: -0.5, Timestamp: ts},
+				{Labels: []string{"__name__=bloader_requests_in_flight", "target="}, Value: 0, Timestamp: ts},
+			},
+		},
+		{
+			name: "HistogramWithoutInfBucket",
+			family: &dto.MetricFamily{
+				Name: proto.String("bloader_request_duration_seconds"),
+				Type: dto.MetricType_HISTOGRAM.Enum(),
+				Metric: []*dto.Metric{{
+					Label: []*dto.LabelPair{label("target", "api")},
+					Histogram: &dto.Histogram{
+						SampleCount: proto.Uint64(4),
+						SampleSum:   proto.Float64(1.25),
+						Bucket: []*dto.Bucket{
+							{UpperBound: proto.Float64(0.1), CumulativeCount: proto.Uint64(1)},
+							{UpperBound: proto.Float64(0.5), CumulativeCount: proto.Uint64(3)},
+						},
+					},
+				}},
+			},
+			want: []testSeries{
+				{Labels: []string{"__name__=bloader_request_duration_seconds_bucket", "le=0.1", "target=api"}, Value: 1, Timestamp: ts},
+				{Labels: []string{"__name__=bloader_request_duration_seconds_bucket", "le=0.5", "target=api"}, Value: 3, Timestamp: ts},
+				{Labels: []string{"__name__=bloader_request_duration_seconds_bucket", "le=+Inf", "target=api"}, Value: 4, Timestamp: ts},
+				{Labels: []string{"__name__=bloader_request_duration_seconds_sum", "target=api"}, Value: 1.25, Timestamp: ts},
+				{Labels: []string{"__name__=bloader_request_duration_seconds_count", "target=api"}, Value: 4, Timestamp: ts},
+			},
+		},
+		{
+			name: "Summary",
+			family: &dto.MetricFamily{
+				Name: proto.String("go_gc_duration_seconds"),
+				Type: dto.MetricType_SUMMARY.Enum(),
+				Metric: []*dto.Metric{{
+					Summary: &dto.Summary{
+						SampleCount: proto.Uint64(10),
+						SampleSum:   proto.Float64(3),
+						Quantile: []*dto.Quantile{
+							{Quantile: proto.Float64(0.5), Value: proto.Float64(0.2)},
+							{Quantile: proto.Float64(1), Value: proto.Float64(0.9)},
+						},
+					},
+				}},
+			},
+			want: []testSeries{
+				{Labels: []string{"__name__=go_gc_duration_seconds", "quantile=0.5"}, Value: 0.2, Timestamp: ts},
+				{Labels: []string{"__name__=go_gc_duration_seconds", "quantile=1"}, Value: 0.9, Timestamp: ts},
+				{Labels: []string{"__name__=go_gc_duration_seconds_sum"}, Value: 3, Timestamp: ts},
+				{Labels: []string{"__name__=go_gc_duration_seconds_count"}, Value: 10, Timestamp: ts},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			got := decodeWriteRequest(tt, encodeWriteRequest([]*dto.MetricFamily{tc.family}, ts))
+			if !reflect.DeepEqual(got, tc.want) {
+				tt.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+// TestRemoteWriterPush tests the push sends the snappy compressed WriteRequest with the headers.
+func TestRemoteWriterPush(t *testing.T) {
+	var (
+		header http.Header
+		body   []byte
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	m := New(map[string]string{"node": "master"})
+	w := NewRemoteWriter(m, config.ValidMetricsRemoteWriteConfig{
+		Enabled:  true,
+		URL:      srv.URL,
+		Interval: time.Second,
+		Headers:  map[string]string{"X-Scope-OrgID": "tenant"},
+	})
+	if err := w.push(context.Background()); err != nil {
+		t.Fatalf("failed to push: %v", err)
+	}
+
+	wantHeaders := map[string]string{
+		"Content-Encoding":                  "snappy",
+		"Content-Type":                      "application/x-protobuf",
+		"X-Prometheus-Remote-Write-Version": "0.1.0",
+		"X-Scope-OrgID":                     "tenant",
+	}
+	for k, v := range wantHeaders {
+		if got := header.Get(k); got != v {
+			t.Errorf("expected header %s %q, got %q", k, v, got)
+		}
+	}
+	decoded, err := snappy.Decode(nil, body)
+	if err != nil {
+		t.Fatalf("failed to decode snappy: %v", err)
+	}
+	want := []string{"__name__=bloader_active_threads", "node=master"}
+	for _, s := range decodeWriteRequest(t, decoded) {
+		if reflect.DeepEqual(s.Labels, want) {
+			if s.Value != 0 {
+				t.Errorf("expected active threads 0, got %v", s.Value)
+			}
+			return
+		}
+	}
+	t.Errorf("expected the series %v", want)
+}
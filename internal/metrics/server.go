@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/ablankz/bloader/internal/config"
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+// shutdownTimeout represents the time to wait for the scrapes in progress on the shutdown
+const shutdownTimeout = 5 * time.Second
+
+// Serve starts the http server exposing the metrics on the port and the path, it is stopped when the context is done
+func Serve(ctx context.Context, log logger.Logger, m *Metrics, port int, path string) error {
+	mux := http.NewServeMux()
+	mux.Handle(path, m.Handler())
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	lister, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+
+	log.Info(ctx, "Starting the metrics server",
+		logger.Value("port", port), logger.Value("path", path))
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Warn(ctx, "failed to shut down the metrics server",
+				logger.Value("error", err), logger.Value("on", "Serve"))
+		}
+	}()
+	go func() {
+		if err := server.Serve(lister); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error(ctx, "failed to serve the metrics server",
+				logger.Value("error", err), logger.Value("on", "Serve"))
+		}
+	}()
+
+	return nil
+}
+
+// Start creates the metrics of the configuration, serves them and starts the remote write as configured.
+// The returned function stops the remote write after the last push, and is called when the run ends.
+func Start(ctx context.Context, log logger.Logger, conf config.ValidMetricsConfig) (*Metrics, func(), error) {
+	m := New(conf.Labels)
+	if conf.Enabled {
+		if err := Serve(ctx, log, m, conf.Port, conf.Path); err != nil {
+			return nil, nil, fmt.Errorf("failed to serve the metrics: %w", err)
+		}
+	}
+	stop := func() {}
+	if conf.RemoteWrite.Enabled {
+		w := NewRemoteWriter(m, conf.RemoteWrite)
+		go w.Run(ctx, log)
+		stop = w.Stop
+	}
+	return m, stop, nil
+}
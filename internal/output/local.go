@@ -2,8 +2,13 @@ package output
 
 import (
 	"context"
-	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 
 	"github.com/ablankz/bloader/internal/config"
 	"github.com/ablankz/bloader/internal/logger"
@@ -36,8 +41,8 @@ func (o LocalOutput) HTTPDataWriteFactory(
 ) (HTTPDataWrite, Close, error) {
 	var filePath string
 	switch o.Format {
-	case config.OutputFormatCSV:
-		filePath = fmt.Sprintf("%s/%s.csv", o.BasePath, uniqueName)
+	case config.OutputFormatCSV, config.OutputFormatJSONL, config.OutputFormatParquet:
+		filePath = fmt.Sprintf("%s/%s.%s", o.BasePath, uniqueName, o.Format)
 	default:
 		return nil, nil, fmt.Errorf("unsupported output format: %s", o.Format)
 	}
@@ -47,40 +52,155 @@ func (o LocalOutput) HTTPDataWriteFactory(
 			logger.Value("error", err), logger.Value("on", "runAsyncProcessing"))
 		return nil, nil, fmt.Errorf("failed to create file: %w", err)
 	}
+	if err := o.sourceWrite(ctx, uniqueName); err != nil {
+		log.Warn(ctx, "failed to write source of the file",
+			logger.Value("error", err), logger.Value("on", "LocalOutput.HTTPDataWriteFactory"))
+	}
+	var rw rowWriter
 	switch o.Format {
 	case config.OutputFormatCSV:
-		writer := csv.NewWriter(f)
-		if err := writer.Write(header); err != nil {
+		if rw, err = newCSVRowWriter(f, header); err != nil {
 			log.Error(ctx, "failed to write header",
 				logger.Value("error", err), logger.Value("on", "runAsyncProcessing"))
 			return nil, nil, fmt.Errorf("failed to write header: %w", err)
 		}
-		writer.Flush()
+	case config.OutputFormatJSONL:
+		rw = newJSONLRowWriter(f, header)
+	case config.OutputFormatParquet:
+		rw = newParquetRowWriter(f, header)
 	}
 	return func(
 			ctx context.Context,
 			log logger.Logger,
-			data []string,
+			data []any,
 		) error {
 			if !enabled {
 				return nil
 			}
-			switch o.Format {
-			case config.OutputFormatCSV:
-				writer := csv.NewWriter(f)
-				log.Debug(ctx, "Writing data to csv",
-					logger.Value("data", data), logger.Value("on", "runAsyncProcessing"))
-				if err := writer.Write(data); err != nil {
-					log.Error(ctx, "failed to write data to csv",
-						logger.Value("error", err), logger.Value("on", "runAsyncProcessing"))
-				}
-				writer.Flush()
-				return nil
+			log.Debug(ctx, "Writing data",
+				logger.Value("format", o.Format), logger.Value("data", data), logger.Value("on", "runAsyncProcessing"))
+			if err := rw.write(ctx, log, data); err != nil {
+				log.Error(ctx, "failed to write data",
+					logger.Value("error", err), logger.Value("on", "runAsyncProcessing"))
 			}
-			return fmt.Errorf("unsupported output format: %s", o.Format)
+			return nil
 		}, func() error {
+			if err := rw.close(); err != nil {
+				_ = f.Close()
+				return err
+			}
 			return f.Close()
 		}, nil
 }
 
+// sourceWrite appends the source carried by the context of the file of the unique name
+// to the sources file in the directory of the run
+func (o LocalOutput) sourceWrite(ctx context.Context, uniqueName string) error {
+	id := runID(uniqueName)
+	src := SourceFrom(ctx)
+	data, err := json.Marshal(SourceRecord{
+		File:         strings.TrimPrefix(fmt.Sprintf("%s.%s", uniqueName, o.Format), id+"/"),
+		FlowID:       src.FlowID,
+		Loader:       src.Loader,
+		RequestIndex: src.RequestIndex,
+		SlaveID:      src.SlaveID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal source: %w", err)
+	}
+	return appendLine(fmt.Sprintf("%s/%s/%s", o.BasePath, id, SourcesFileName), data)
+}
+
+// summaryMu guards the read-modify-write of the summary files
+var summaryMu sync.Mutex
+
+// SummaryWrite appends the summary to the summary.json in the output root
+func (o LocalOutput) SummaryWrite(
+	ctx context.Context,
+	log logger.Logger,
+	outputRoot string,
+	data []byte,
+) error {
+	summaryMu.Lock()
+	defer summaryMu.Unlock()
+	filePath := fmt.Sprintf("%s/%s/summary.json", o.BasePath, outputRoot)
+	var summaries []json.RawMessage
+	existing, err := os.ReadFile(filePath)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(existing, &summaries); err != nil {
+			return fmt.Errorf("failed to unmarshal existing summary: %w", err)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return fmt.Errorf("failed to read existing summary: %w", err)
+	}
+	summaries = append(summaries, data)
+	content, err := json.MarshalIndent(summaries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal summary: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	if err := os.WriteFile(filePath, content, 0o644); err != nil {
+		log.Error(ctx, "failed to write file",
+			logger.Value("error", err), logger.Value("on", "LocalOutput.SummaryWrite"))
+		return fmt.Errorf("failed to write summary: %w", err)
+	}
+	return nil
+}
+
+// jsonlMu guards the append to the jsonl files
+var jsonlMu sync.Mutex
+
+// MetricsWrite appends the live metrics as a line to the metrics.jsonl in the output root
+func (o LocalOutput) MetricsWrite(
+	ctx context.Context,
+	log logger.Logger,
+	outputRoot string,
+	data []byte,
+) error {
+	filePath := fmt.Sprintf("%s/%s/metrics.jsonl", o.BasePath, outputRoot)
+	if err := appendLine(filePath, data); err != nil {
+		log.Error(ctx, "failed to append metrics",
+			logger.Value("error", err), logger.Value("on", "LocalOutput.MetricsWrite"))
+		return fmt.Errorf("failed to write metrics: %w", err)
+	}
+	return nil
+}
+
+// ResourcesWrite appends the resource usage sample as a line to the resources.jsonl in the output root
+func (o LocalOutput) ResourcesWrite(
+	ctx context.Context,
+	log logger.Logger,
+	outputRoot string,
+	data []byte,
+) error {
+	filePath := fmt.Sprintf("%s/%s/resources.jsonl", o.BasePath, outputRoot)
+	if err := appendLine(filePath, data); err != nil {
+		log.Error(ctx, "failed to append resource usage",
+			logger.Value("error", err), logger.Value("on", "LocalOutput.ResourcesWrite"))
+		return fmt.Errorf("failed to write resource usage: %w", err)
+	}
+	return nil
+}
+
+// appendLine appends the data as a line to the file, creating the file and its directory
+func appendLine(filePath string, data []byte) error {
+	jsonlMu.Lock()
+	defer jsonlMu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	f, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	return nil
+}
+
 var _ Output = LocalOutput{}
@@ -8,8 +8,9 @@ import (
 	"github.com/ablankz/bloader/internal/logger"
 )
 
-// HTTPDataWrite writes the data to the output
-type HTTPDataWrite func(ctx context.Context, log logger.Logger, data []string) error
+// HTTPDataWrite writes the data to the output.
+// The values keep their types for the typed formats, and are stringified for csv.
+type HTTPDataWrite func(ctx context.Context, log logger.Logger, data []any) error
 
 // Output represents a output to be scanned
 type Output interface {
@@ -21,6 +22,27 @@ type Output interface {
 		uniqueName string,
 		header []string,
 	) (HTTPDataWrite, Close, error)
+	// SummaryWrite writes the summary json of the execution to the output root
+	SummaryWrite(
+		ctx context.Context,
+		log logger.Logger,
+		outputRoot string,
+		data []byte,
+	) error
+	// MetricsWrite appends a point of the live metrics json to the output root
+	MetricsWrite(
+		ctx context.Context,
+		log logger.Logger,
+		outputRoot string,
+		data []byte,
+	) error
+	// ResourcesWrite appends a resource usage sample json to the output root
+	ResourcesWrite(
+		ctx context.Context,
+		log logger.Logger,
+		outputRoot string,
+		data []byte,
+	) error
 }
 
 // Container is a map of outputs
@@ -37,6 +59,8 @@ func NewContainer(env string, cfg config.ValidOutputConfig) Container {
 				switch val.Type {
 				case config.OutputTypeLocal:
 					t = NewLocalOutput(val)
+				case config.OutputTypeSQLite:
+					t = NewSQLiteOutput(val)
 				}
 				ok = true
 				break
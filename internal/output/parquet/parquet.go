@@ -0,0 +1,174 @@
+// Package parquet provides the writer of the parquet files on top of parquet-go.
+// The columns are flat and optional, and keep the order in which they are given.
+package parquet
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	parquetgo "github.com/parquet-go/parquet-go"
+)
+
+// Type represents the type of the column
+type Type int
+
+const (
+	// TypeBoolean represents the boolean column, written from bool
+	TypeBoolean Type = iota
+	// TypeInt64 represents the 64-bit integer column, written from int64
+	TypeInt64
+	// TypeDouble represents the double column, written from float64
+	TypeDouble
+	// TypeString represents the UTF-8 string column, written from string
+	TypeString
+)
+
+// String returns the name of the type
+func (t Type) String() string {
+	switch t {
+	case TypeBoolean:
+		return "boolean"
+	case TypeInt64:
+		return "int64"
+	case TypeDouble:
+		return "double"
+	case TypeString:
+		return "string"
+	}
+	return fmt.Sprintf("Type(%d)", int(t))
+}
+
+// node returns the parquet-go node of the type
+func (t Type) node() parquetgo.Node {
+	switch t {
+	case TypeBoolean:
+		return parquetgo.Leaf(parquetgo.BooleanType)
+	case TypeInt64:
+		return parquetgo.Int(64)
+	case TypeDouble:
+		return parquetgo.Leaf(parquetgo.DoubleType)
+	}
+	return parquetgo.String()
+}
+
+// DefaultRowGroupSize represents the default number of the rows buffered for a row group
+const DefaultRowGroupSize = 10000
+
+// Column represents a column of the file
+type Column struct {
+	Name string
+	Type Type
+}
+
+// columnGroup is the root of the schema with the fields in the order of the columns,
+// since parquetgo.Group sorts its fields by name
+type columnGroup struct {
+	parquetgo.Group
+	fields []parquetgo.Field
+}
+
+// Fields returns the fields in the order of the columns
+func (g columnGroup) Fields() []parquetgo.Field {
+	return g.fields
+}
+
+// columnField represents a field of columnGroup
+type columnField struct {
+	parquetgo.Node
+	name string
+}
+
+// Name returns the name of the column
+func (f columnField) Name() string {
+	return f.name
+}
+
+// Value returns the value of the column in the map of the row
+func (f columnField) Value(base reflect.Value) reflect.Value {
+	if base.Kind() == reflect.Interface {
+		if base.IsNil() {
+			return reflect.ValueOf(nil)
+		}
+		base = base.Elem()
+	}
+	return base.MapIndex(reflect.ValueOf(f.name))
+}
+
+// newSchema creates the schema of the columns
+func newSchema(columns []Column) *parquetgo.Schema {
+	g := columnGroup{
+		Group:  make(parquetgo.Group, len(columns)),
+		fields: make([]parquetgo.Field, len(columns)),
+	}
+	for i, col := range columns {
+		node := parquetgo.Optional(col.Type.node())
+		g.Group[col.Name] = node
+		g.fields[i] = columnField{Node: node, name: col.Name}
+	}
+	return parquetgo.NewSchema("schema", g)
+}
+
+// Writer writes the rows to the parquet file.
+// The rows are buffered and written as a row group of the size, and the metadata is written on Close.
+// It is not safe for concurrent use.
+type Writer struct {
+	writer  *parquetgo.Writer
+	columns []Column
+}
+
+// NewWriter creates a new Writer of the columns
+func NewWriter(w io.Writer, columns []Column, rowGroupSize int) *Writer {
+	if rowGroupSize <= 0 {
+		rowGroupSize = DefaultRowGroupSize
+	}
+	return &Writer{
+		writer: parquetgo.NewWriter(w,
+			newSchema(columns),
+			parquetgo.MaxRowsPerRowGroup(int64(rowGroupSize)),
+			parquetgo.CreatedBy("bloader", "", ""),
+		),
+		columns: columns,
+	}
+}
+
+// Write buffers the row. Each value must be nil or of the Go type of the column.
+func (w *Writer) Write(row []any) error {
+	if len(row) != len(w.columns) {
+		return fmt.Errorf("row has %d values, expected %d", len(row), len(w.columns))
+	}
+	values := make(parquetgo.Row, len(row))
+	for i, v := range row {
+		if v == nil {
+			values[i] = parquetgo.NullValue().Level(0, 0, i)
+			continue
+		}
+		var ok bool
+		switch w.columns[i].Type {
+		case TypeBoolean:
+			_, ok = v.(bool)
+		case TypeInt64:
+			_, ok = v.(int64)
+		case TypeDouble:
+			_, ok = v.(float64)
+		case TypeString:
+			_, ok = v.(string)
+		}
+		if !ok {
+			return fmt.Errorf("value %v of column %s is not %s", v, w.columns[i].Name, w.columns[i].Type)
+		}
+		values[i] = parquetgo.ValueOf(v).Level(0, 1, i)
+	}
+	if _, err := w.writer.WriteRows([]parquetgo.Row{values}); err != nil {
+		return fmt.Errorf("failed to write parquet: %w", err)
+	}
+	return nil
+}
+
+// Close writes the buffered rows and the metadata. The underlying writer is not closed.
+func (w *Writer) Close() error {
+	if err := w.writer.Close(); err != nil {
+		return fmt.Errorf("failed to write parquet: %w", err)
+	}
+	return nil
+}
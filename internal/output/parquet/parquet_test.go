@@ -0,0 +1,267 @@
+package parquet_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	parquetgo "github.com/parquet-go/parquet-go"
+
+	"github.com/ablankz/bloader/internal/output/parquet"
+)
+
+// readFile reads the names of the columns, the number of the row groups and the rows of the file
+func readFile(t *testing.T, b []byte) ([]string, int, [][]any) {
+	t.Helper()
+	f, err := parquetgo.OpenFile(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		t.Fatalf("failed to open parquet: %v", err)
+	}
+	var names []string
+	for _, field := range f.Schema().Fields() {
+		names = append(names, field.Name())
+	}
+	var rows [][]any
+	for _, group := range f.RowGroups() {
+		buf := make([]parquetgo.Row, group.NumRows())
+		reader := group.Rows()
+		n, err := reader.ReadRows(buf)
+		if err != nil && !errors.Is(err, io.EOF) {
+			t.Fatalf("failed to read rows: %v", err)
+		}
+		if err := reader.Close(); err != nil {
+			t.Fatalf("failed to close rows: %v", err)
+		}
+		for _, r := range buf[:n] {
+			row := make([]any, len(r))
+			for _, v := range r {
+				var value any
+				switch {
+				case v.IsNull():
+				case v.Kind() == parquetgo.Boolean:
+					value = v.Boolean()
+				case v.Kind() == parquetgo.Int64:
+					value = v.Int64()
+				case v.Kind() == parquetgo.Double:
+					value = v.Double()
+				case v.Kind() == parquetgo.ByteArray:
+					value = string(v.ByteArray())
+				default:
+					t.Fatalf("unexpected kind %s", v.Kind())
+				}
+				row[v.Column()] = value
+			}
+			rows = append(rows, row)
+		}
+	}
+	return names, len(f.RowGroups()), rows
+}
+
+// TestWriter tests the files written by the writer are read back with the columns, the types and the nulls.
+func TestWriter(t *testing.T) {
+	columns := []parquet.Column{
+		{Name: "Success", Type: parquet.TypeBoolean},
+		{Name: "Count", Type: parquet.TypeInt64},
+		{Name: "ResponseTime", Type: parquet.TypeDouble},
+		{Name: "Body", Type: parquet.TypeString},
+	}
+	rows := [][]any{
+		{true, int64(1), 12.5, `{"id":1}`},
+		{false, int64(-2), nil, ""},
+		{nil, nil, nil, nil},
+		{true, int64(1 << 40), 0.001, "テスト"},
+		{nil, int64(5), 3.0, nil},
+	}
+	tests := []struct {
+		name          string
+		rowGroupSize  int
+		rows          [][]any
+		wantRowGroups int
+	}{
+		{name: "SingleRowGroup", rowGroupSize: 0, rows: rows, wantRowGroups: 1},
+		{name: "MultipleRowGroups", rowGroupSize: 2, rows: rows, wantRowGroups: 3},
+		{name: "NoRow", rowGroupSize: 0, rows: nil, wantRowGroups: 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			var buf bytes.Buffer
+			w := parquet.NewWriter(&buf, columns, tc.rowGroupSize)
+			for _, row := range tc.rows {
+				if err := w.Write(row); err != nil {
+					tt.Fatalf("failed to write: %v", err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				tt.Fatalf("failed to close: %v", err)
+			}
+
+			names, rowGroups, got := readFile(tt, buf.Bytes())
+			wantNames := []string{"Success", "Count", "ResponseTime", "Body"}
+			if !reflect.DeepEqual(names, wantNames) {
+				tt.Errorf("expected columns %v, got %v", wantNames, names)
+			}
+			if rowGroups != tc.wantRowGroups {
+				tt.Errorf("expected %d row groups, got %d", tc.wantRowGroups, rowGroups)
+			}
+			if !reflect.DeepEqual(got, tc.rows) {
+				tt.Errorf("expected rows %v, got %v", tc.rows, got)
+			}
+		})
+	}
+}
+
+// TestWriterSchema tests the physical and the logical types of the columns.
+func TestWriterSchema(t *testing.T) {
+	var buf bytes.Buffer
+	w := parquet.NewWriter(&buf, []parquet.Column{
+		{Name: "b", Type: parquet.TypeBoolean},
+		{Name: "i", Type: parquet.TypeInt64},
+		{Name: "d", Type: parquet.TypeDouble},
+		{Name: "s", Type: parquet.TypeString},
+	}, 0)
+	if err := w.Close(); err != nil {
+		t.Fatalf("failed to close: %v", err)
+	}
+	f, err := parquetgo.OpenFile(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("failed to open parquet: %v", err)
+	}
+	wantKinds := []parquetgo.Kind{parquetgo.Boolean, parquetgo.Int64, parquetgo.Double, parquetgo.ByteArray}
+	for i, field := range f.Schema().Fields() {
+		if !field.Optional() {
+			t.Errorf("expected column %s to be optional", field.Name())
+		}
+		if kind := field.Type().Kind(); kind != wantKinds[i] {
+			t.Errorf("expected column %s of %s, got %s", field.Name(), wantKinds[i], kind)
+		}
+	}
+	if lt := f.Schema().Fields()[3].Type().LogicalType(); lt == nil || lt.UTF8 == nil {
+		t.Errorf("expected string column to be UTF8, got %v", lt)
+	}
+}
+
+// TestWriterInvalidRow tests the rows not matching the columns are rejected.
+func TestWriterInvalidRow(t *testing.T) {
+	columns := []parquet.Column{
+		{Name: "Count", Type: parquet.TypeInt64},
+		{Name: "Body", Type: parquet.TypeString},
+	}
+	tests := []struct {
+		name string
+		row  []any
+	}{
+		{name: "ShortRow", row: []any{int64(1)}},
+		{name: "LongRow", row: []any{int64(1), "a", "b"}},
+		{name: "TypeMismatch", row: []any{1, "a"}},
+		{name: "StringForInt", row: []any{"1", "a"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			w := parquet.NewWriter(io.Discard, columns, 0)
+			if err := w.Write(tc.row); err == nil {
+				tt.Errorf("expected an error for %v", tc.row)
+			}
+		})
+	}
+}
+
+// TestReader tests the rows are read back with the columns and the Go types of the columns.
+func TestReader(t *testing.T) {
+	type otherRow struct {
+		Name  *string  `parquet:"name,optional"`
+		Small int32    `parquet:"small"`
+		Ratio float32  `parquet:"ratio"`
+		Score *float64 `parquet:"score,optional"`
+	}
+	name := "a"
+	score := 0.5
+
+	var written bytes.Buffer
+	w := parquet.NewWriter(&written, []parquet.Column{
+		{Name: "Success", Type: parquet.TypeBoolean},
+		{Name: "Count", Type: parquet.TypeInt64},
+		{Name: "Body", Type: parquet.TypeString},
+	}, 2)
+	for _, row := range [][]any{{true, int64(1), "x"}, {nil, int64(2), nil}, {false, nil, "z"}} {
+		if err := w.Write(row); err != nil {
+			t.Fatalf("failed to write: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("failed to close: %v", err)
+	}
+
+	var other bytes.Buffer
+	ow := parquetgo.NewGenericWriter[otherRow](&other)
+	if _, err := ow.Write([]otherRow{{Name: &name, Small: 7, Ratio: 0.25, Score: &score}, {Small: -1}}); err != nil {
+		t.Fatalf("failed to write: %v", err)
+	}
+	if err := ow.Close(); err != nil {
+		t.Fatalf("failed to close: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		file        []byte
+		wantColumns []parquet.Column
+		wantRows    [][]any
+	}{
+		{
+			name: "WrittenByWriter",
+			file: written.Bytes(),
+			wantColumns: []parquet.Column{
+				{Name: "Success", Type: parquet.TypeBoolean},
+				{Name: "Count", Type: parquet.TypeInt64},
+				{Name: "Body", Type: parquet.TypeString},
+			},
+			wantRows: [][]any{{true, int64(1), "x"}, {nil, int64(2), nil}, {false, nil, "z"}},
+		},
+		{
+			name: "WrittenByOthers",
+			file: other.Bytes(),
+			wantColumns: []parquet.Column{
+				{Name: "name", Type: parquet.TypeString},
+				{Name: "small", Type: parquet.TypeInt64},
+				{Name: "ratio", Type: parquet.TypeDouble},
+				{Name: "score", Type: parquet.TypeDouble},
+			},
+			wantRows: [][]any{{"a", int64(7), 0.25, 0.5}, {nil, int64(-1), float64(0), nil}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			r, err := parquet.NewReader(bytes.NewReader(tc.file), int64(len(tc.file)))
+			if err != nil {
+				tt.Fatalf("failed to create reader: %v", err)
+			}
+			defer r.Close()
+			if !reflect.DeepEqual(r.Columns(), tc.wantColumns) {
+				tt.Errorf("expected columns %v, got %v", tc.wantColumns, r.Columns())
+			}
+			var rows [][]any
+			for {
+				row, err := r.Read()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					tt.Fatalf("failed to read: %v", err)
+				}
+				rows = append(rows, row)
+			}
+			if !reflect.DeepEqual(rows, tc.wantRows) {
+				tt.Errorf("expected rows %v, got %v", tc.wantRows, rows)
+			}
+		})
+	}
+}
+
+// TestReaderInvalidFile tests the files which are not parquet are rejected.
+func TestReaderInvalidFile(t *testing.T) {
+	b := []byte("Success,Count\ntrue,1\n")
+	if _, err := parquet.NewReader(bytes.NewReader(b), int64(len(b))); err == nil {
+		t.Errorf("expected an error for a csv file")
+	}
+}
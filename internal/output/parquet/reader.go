@@ -0,0 +1,132 @@
+package parquet
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	parquetgo "github.com/parquet-go/parquet-go"
+)
+
+// readBatchSize represents the number of the rows read from a row group at once
+const readBatchSize = 1024
+
+// Reader reads the rows of the parquet file of the flat columns, such as the files written by Writer.
+// It is not safe for concurrent use.
+type Reader struct {
+	columns   []Column
+	rowGroups []parquetgo.RowGroup
+	rows      parquetgo.Rows
+	buf       []parquetgo.Row
+	n         int
+	pos       int
+}
+
+// NewReader creates a new Reader of the file of the size
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	f, err := parquetgo.OpenFile(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open parquet: %w", err)
+	}
+	fields := f.Schema().Fields()
+	columns := make([]Column, len(fields))
+	for i, field := range fields {
+		if !field.Leaf() || field.Repeated() {
+			return nil, fmt.Errorf("column %s is not a flat column", field.Name())
+		}
+		columns[i].Name = field.Name()
+		switch kind := field.Type().Kind(); kind {
+		case parquetgo.Boolean:
+			columns[i].Type = TypeBoolean
+		case parquetgo.Int32, parquetgo.Int64:
+			columns[i].Type = TypeInt64
+		case parquetgo.Float, parquetgo.Double:
+			columns[i].Type = TypeDouble
+		case parquetgo.ByteArray:
+			columns[i].Type = TypeString
+		default:
+			return nil, fmt.Errorf("column %s of %s is not supported", field.Name(), kind)
+		}
+	}
+	return &Reader{
+		columns:   columns,
+		rowGroups: f.RowGroups(),
+		buf:       make([]parquetgo.Row, readBatchSize),
+	}, nil
+}
+
+// Columns returns the columns of the file
+func (r *Reader) Columns() []Column {
+	return r.columns
+}
+
+// Read reads the next row, each value is nil or of the Go type of its column.
+// It returns io.EOF at the end of the file.
+func (r *Reader) Read() ([]any, error) {
+	for r.pos >= r.n {
+		if err := r.next(); err != nil {
+			return nil, err
+		}
+	}
+	values := r.buf[r.pos]
+	r.pos++
+	row := make([]any, len(r.columns))
+	for _, v := range values {
+		i := v.Column()
+		if i < 0 || i >= len(row) || v.IsNull() {
+			continue
+		}
+		switch v.Kind() {
+		case parquetgo.Boolean:
+			row[i] = v.Boolean()
+		case parquetgo.Int32:
+			row[i] = int64(v.Int32())
+		case parquetgo.Int64:
+			row[i] = v.Int64()
+		case parquetgo.Float:
+			row[i] = float64(v.Float())
+		case parquetgo.Double:
+			row[i] = v.Double()
+		case parquetgo.ByteArray:
+			row[i] = string(v.ByteArray())
+		}
+	}
+	return row, nil
+}
+
+// next reads the next batch of the rows, moving to the next row group at the end of one
+func (r *Reader) next() error {
+	if r.rows == nil {
+		if len(r.rowGroups) == 0 {
+			return io.EOF
+		}
+		r.rows = r.rowGroups[0].Rows()
+		r.rowGroups = r.rowGroups[1:]
+	}
+	n, err := r.rows.ReadRows(r.buf)
+	r.n, r.pos = n, 0
+	if errors.Is(err, io.EOF) {
+		if cerr := r.rows.Close(); cerr != nil {
+			return fmt.Errorf("failed to read parquet: %w", cerr)
+		}
+		r.rows = nil
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read parquet: %w", err)
+	}
+	return nil
+}
+
+// Close closes the rows being read. The underlying reader is not closed.
+func (r *Reader) Close() error {
+	if r.rows == nil {
+		return nil
+	}
+	err := r.rows.Close()
+	r.rows = nil
+	if err != nil {
+		return fmt.Errorf("failed to close parquet: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+package output
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/output/parquet"
+)
+
+// ValueString returns the value as written to the csv, empty for nil
+func ValueString(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// rowWriter writes the rows of the http data in the format of the local output
+type rowWriter interface {
+	write(ctx context.Context, log logger.Logger, data []any) error
+	// close writes what is buffered, the file is closed by the caller
+	close() error
+}
+
+// csvRowWriter writes the rows as csv, every value is stringified
+type csvRowWriter struct {
+	w *csv.Writer
+}
+
+// newCSVRowWriter creates a new csvRowWriter, and writes the header
+func newCSVRowWriter(w io.Writer, header []string) (*csvRowWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return nil, fmt.Errorf("failed to write header: %w", err)
+	}
+	writer.Flush()
+	return &csvRowWriter{w: writer}, nil
+}
+
+func (w *csvRowWriter) write(_ context.Context, _ logger.Logger, data []any) error {
+	record := make([]string, len(data))
+	for i, v := range data {
+		record[i] = ValueString(v)
+	}
+	if err := w.w.Write(record); err != nil {
+		return fmt.Errorf("failed to write csv: %w", err)
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *csvRowWriter) close() error {
+	return nil
+}
+
+// jsonlRowWriter writes each row as a json object keyed by the header, keeping the types of the values
+type jsonlRowWriter struct {
+	w      io.Writer
+	header []string
+}
+
+// newJSONLRowWriter creates a new jsonlRowWriter
+func newJSONLRowWriter(w io.Writer, header []string) *jsonlRowWriter {
+	return &jsonlRowWriter{
+		w:      w,
+		header: header,
+	}
+}
+
+func (w *jsonlRowWriter) write(_ context.Context, _ logger.Logger, data []any) error {
+	b, err := marshalRow(w.header, data)
+	if err != nil {
+		return err
+	}
+	if _, err := w.w.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("failed to write jsonl: %w", err)
+	}
+	return nil
+}
+
+func (w *jsonlRowWriter) close() error {
+	return nil
+}
+
+// marshalRow marshals the row as a json object keyed by the header, in the order of the header.
+// The missing values are null and the extra values are dropped.
+func marshalRow(header []string, data []any) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range header {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		var v any
+		if i < len(data) {
+			v = data[i]
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal key %s: %w", key, err)
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal value of %s: %w", key, err)
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(b)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// parquetRowWriter writes the rows as parquet.
+// The types of the columns are taken from the first row: booleans, integers and numbers keep their types,
+// and the other values are written as strings, json for the objects and the arrays.
+// The columns with no value in the first row are strings.
+type parquetRowWriter struct {
+	w          io.Writer
+	header     []string
+	writer     *parquet.Writer
+	columns    []parquet.Column
+	mismatched map[string]struct{}
+}
+
+// newParquetRowWriter creates a new parquetRowWriter, the file is written on the first row
+func newParquetRowWriter(w io.Writer, header []string) *parquetRowWriter {
+	return &parquetRowWriter{
+		w:          w,
+		header:     header,
+		mismatched: make(map[string]struct{}),
+	}
+}
+
+// write writes the row. The value which cannot be converted to the type of the column is written as null.
+func (w *parquetRowWriter) write(ctx context.Context, log logger.Logger, data []any) error {
+	if w.writer == nil {
+		w.open(data)
+	}
+	row := make([]any, len(w.columns))
+	for i, col := range w.columns {
+		if i >= len(data) {
+			continue
+		}
+		v, ok := parquetValue(data[i], col.Type)
+		if !ok {
+			if _, logged := w.mismatched[col.Name]; !logged {
+				w.mismatched[col.Name] = struct{}{}
+				log.Warn(ctx, "value does not match the type of the parquet column, written as null",
+					logger.Value("column", col.Name), logger.Value("type", col.Type.String()),
+					logger.Value("value", data[i]), logger.Value("on", "parquetRowWriter.write"))
+			}
+		}
+		row[i] = v
+	}
+	if err := w.writer.Write(row); err != nil {
+		return fmt.Errorf("failed to write parquet: %w", err)
+	}
+	return nil
+}
+
+// open creates the parquet writer with the types of the columns taken from the row
+func (w *parquetRowWriter) open(data []any) {
+	w.columns = make([]parquet.Column, len(w.header))
+	for i, name := range w.header {
+		w.columns[i] = parquet.Column{
+			Name: name,
+			Type: parquet.TypeString,
+		}
+		if i < len(data) {
+			w.columns[i].Type = parquetType(data[i])
+		}
+	}
+	w.writer = parquet.NewWriter(w.w, w.columns, parquet.DefaultRowGroupSize)
+}
+
+func (w *parquetRowWriter) close() error {
+	if w.writer == nil {
+		w.open(nil)
+	}
+	if err := w.writer.Close(); err != nil {
+		return fmt.Errorf("failed to close parquet: %w", err)
+	}
+	return nil
+}
+
+// parquetType returns the type of the parquet column for the value
+func parquetType(v any) parquet.Type {
+	switch v.(type) {
+	case bool:
+		return parquet.TypeBoolean
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32:
+		return parquet.TypeInt64
+	case float32, float64:
+		return parquet.TypeDouble
+	}
+	return parquet.TypeString
+}
+
+// parquetValue converts the value to the Go type of the parquet column.
+// It returns false if the value cannot be converted.
+func parquetValue(v any, t parquet.Type) (any, bool) {
+	if v == nil {
+		return nil, true
+	}
+	switch t {
+	case parquet.TypeBoolean:
+		b, ok := v.(bool)
+		if !ok {
+			return nil, false
+		}
+		return b, true
+	case parquet.TypeInt64:
+		switch n := v.(type) {
+		case int:
+			return int64(n), true
+		case int8:
+			return int64(n), true
+		case int16:
+			return int64(n), true
+		case int32:
+			return int64(n), true
+		case int64:
+			return n, true
+		case uint:
+			return int64(n), true
+		case uint8:
+			return int64(n), true
+		case uint16:
+			return int64(n), true
+		case uint32:
+			return int64(n), true
+		case float64:
+			if n == math.Trunc(n) && math.Abs(n) < 1<<63 {
+				return int64(n), true
+			}
+		}
+		return nil, false
+	case parquet.TypeDouble:
+		switch n := v.(type) {
+		case float64:
+			return n, true
+		case float32:
+			return float64(n), true
+		}
+		if i, ok := parquetValue(v, parquet.TypeInt64); ok {
+			return float64(i.(int64)), true
+		}
+		return nil, false
+	}
+	switch s := v.(type) {
+	case string:
+		return s, true
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprint(s), true
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v), true
+	}
+	return string(b), true
+}
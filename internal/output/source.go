@@ -0,0 +1,64 @@
+package output
+
+import "context"
+
+// Source represents where the http data written to the output comes from
+type Source struct {
+	// FlowID is the ID of the innermost flow step running the loader, empty out of the flows
+	FlowID string
+	// Loader is the loader file of the runner
+	Loader string
+	// RequestIndex is the index of the request in the runner
+	RequestIndex int
+	// SlaveID is the ID of the slave running the loader, empty on the master
+	SlaveID string
+}
+
+// sourceKey is the context key of the Source
+type sourceKey struct{}
+
+// WithSource returns the context carrying the source
+func WithSource(ctx context.Context, src Source) context.Context {
+	return context.WithValue(ctx, sourceKey{}, src)
+}
+
+// SourceFrom returns the source carried by the context, the zero value if none
+func SourceFrom(ctx context.Context) Source {
+	src, _ := ctx.Value(sourceKey{}).(Source)
+	return src
+}
+
+// WithFlowID returns the context carrying the source with the flow ID
+func WithFlowID(ctx context.Context, flowID string) context.Context {
+	src := SourceFrom(ctx)
+	src.FlowID = flowID
+	return WithSource(ctx, src)
+}
+
+// WithLoader returns the context carrying the source with the loader
+func WithLoader(ctx context.Context, loader string) context.Context {
+	src := SourceFrom(ctx)
+	src.Loader = loader
+	return WithSource(ctx, src)
+}
+
+// WithRequestIndex returns the context carrying the source with the request index
+func WithRequestIndex(ctx context.Context, index int) context.Context {
+	src := SourceFrom(ctx)
+	src.RequestIndex = index
+	return WithSource(ctx, src)
+}
+
+// SourcesFileName represents the name of the file in the directory of the run,
+// listing the source of each file written by the local output
+const SourcesFileName = "sources.jsonl"
+
+// SourceRecord represents the line of the sources file
+type SourceRecord struct {
+	// File is the path of the file relative to the directory of the run
+	File         string `json:"file"`
+	FlowID       string `json:"flow_id,omitempty"`
+	Loader       string `json:"loader,omitempty"`
+	RequestIndex int    `json:"request_index"`
+	SlaveID      string `json:"slave_id,omitempty"`
+}
@@ -0,0 +1,477 @@
+package output
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	// registers the sqlite driver, written in pure Go without cgo
+	_ "modernc.org/sqlite"
+
+	"github.com/ablankz/bloader/internal/config"
+	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/output/parquet"
+)
+
+// SQLiteFileName represents the name of the database file in the directory of the run
+const SQLiteFileName = "results.db"
+
+const (
+	// sqliteBatchSize represents the number of the buffered rows inserted in a transaction
+	sqliteBatchSize = 500
+	// sqliteFlushInterval represents the interval at which the buffered rows are inserted at the latest
+	sqliteFlushInterval = time.Second
+	// sqliteDatetimeLayout represents the layout of the datetime columns in UTC,
+	// which sorts in time order and is understood by the date and time functions of SQLite
+	sqliteDatetimeLayout = "2006-01-02 15:04:05.000000"
+)
+
+// sqliteSchema creates the tables of the database.
+// Each output of a runner is a row of outputs, keyed by the run, the flow, the loader and the request index,
+// and its rows are the records with the typed columns taken from the header and the whole row as json.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS outputs (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	run_id TEXT NOT NULL,
+	flow_id TEXT NOT NULL,
+	loader TEXT NOT NULL,
+	request_index INTEGER NOT NULL,
+	slave_id TEXT NOT NULL,
+	name TEXT NOT NULL,
+	header TEXT NOT NULL,
+	created_at TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS outputs_source ON outputs (run_id, flow_id, loader, request_index);
+CREATE TABLE IF NOT EXISTS records (
+	output_id INTEGER NOT NULL REFERENCES outputs (id),
+	success INTEGER,
+	send_datetime TEXT,
+	received_datetime TEXT,
+	count INTEGER,
+	response_time INTEGER,
+	status_code INTEGER,
+	data TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS records_output_id ON records (output_id);
+CREATE INDEX IF NOT EXISTS records_send_datetime ON records (send_datetime);
+CREATE INDEX IF NOT EXISTS records_received_datetime ON records (received_datetime);
+CREATE INDEX IF NOT EXISTS records_status_code ON records (status_code);
+CREATE VIEW IF NOT EXISTS results AS
+	SELECT o.run_id, o.flow_id, o.loader, o.request_index, o.slave_id, o.name,
+		r.success, r.send_datetime, r.received_datetime, r.count, r.response_time, r.status_code, r.data
+	FROM records r JOIN outputs o ON o.id = r.output_id;
+CREATE TABLE IF NOT EXISTS summaries (
+	run_id TEXT NOT NULL,
+	output_root TEXT NOT NULL,
+	created_at TEXT NOT NULL,
+	data TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS metrics (
+	run_id TEXT NOT NULL,
+	output_root TEXT NOT NULL,
+	created_at TEXT NOT NULL,
+	data TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS resources (
+	run_id TEXT NOT NULL,
+	output_root TEXT NOT NULL,
+	created_at TEXT NOT NULL,
+	data TEXT NOT NULL
+);
+`
+
+// sqliteColumns maps the header of the http data to the typed columns of the records
+var sqliteColumns = map[string]string{
+	"Success":          "success",
+	"SendDatetime":     "send_datetime",
+	"ReceivedDatetime": "received_datetime",
+	"Count":            "count",
+	"ResponseTime":     "response_time",
+	"StatusCode":       "status_code",
+}
+
+// SQLiteOutput represents the sqlite output service.
+// The outputs of all the runners of a run, including the ones of the slaves,
+// are written to a single database in the directory of the run.
+type SQLiteOutput struct {
+	// BasePath of the output
+	BasePath string
+}
+
+// NewSQLiteOutput creates a new SQLiteOutput
+func NewSQLiteOutput(cfg config.ValidOutputRespectiveValueConfig) SQLiteOutput {
+	return SQLiteOutput{
+		BasePath: cfg.BasePath,
+	}
+}
+
+// runID returns the ID of the run, the first segment of the output root
+func runID(outputRoot string) string {
+	id, _, _ := strings.Cut(outputRoot, "/")
+	return id
+}
+
+// filePath returns the path of the database of the run the output root belongs to
+func (o SQLiteOutput) filePath(outputRoot string) string {
+	return fmt.Sprintf("%s/%s/%s", o.BasePath, runID(outputRoot), SQLiteFileName)
+}
+
+// HTTPDataWriteFactory returns the HTTPDataWrite function
+func (o SQLiteOutput) HTTPDataWriteFactory(
+	ctx context.Context,
+	log logger.Logger,
+	enabled bool,
+	uniqueName string,
+	header []string,
+) (HTTPDataWrite, Close, error) {
+	filePath := o.filePath(uniqueName)
+	db, err := acquireSQLite(filePath)
+	if err != nil {
+		log.Error(ctx, "failed to open database",
+			logger.Value("error", err), logger.Value("on", "SQLiteOutput.HTTPDataWriteFactory"))
+		return nil, nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	rw, err := newSQLiteRowWriter(ctx, db, uniqueName, header)
+	if err != nil {
+		_ = releaseSQLite(filePath)
+		log.Error(ctx, "failed to insert output",
+			logger.Value("error", err), logger.Value("on", "SQLiteOutput.HTTPDataWriteFactory"))
+		return nil, nil, fmt.Errorf("failed to insert output: %w", err)
+	}
+	return func(
+			ctx context.Context,
+			log logger.Logger,
+			data []any,
+		) error {
+			if !enabled {
+				return nil
+			}
+			log.Debug(ctx, "Writing data",
+				logger.Value("type", config.OutputTypeSQLite), logger.Value("data", data),
+				logger.Value("on", "SQLiteOutput.HTTPDataWrite"))
+			if err := rw.write(ctx, log, data); err != nil {
+				log.Error(ctx, "failed to write data",
+					logger.Value("error", err), logger.Value("on", "SQLiteOutput.HTTPDataWrite"))
+			}
+			return nil
+		}, func() error {
+			if err := rw.close(); err != nil {
+				_ = releaseSQLite(filePath)
+				return err
+			}
+			return releaseSQLite(filePath)
+		}, nil
+}
+
+// SummaryWrite inserts the summary into the summaries table
+func (o SQLiteOutput) SummaryWrite(
+	ctx context.Context,
+	log logger.Logger,
+	outputRoot string,
+	data []byte,
+) error {
+	if err := o.insertJSON("summaries", outputRoot, data); err != nil {
+		log.Error(ctx, "failed to insert summary",
+			logger.Value("error", err), logger.Value("on", "SQLiteOutput.SummaryWrite"))
+		return fmt.Errorf("failed to write summary: %w", err)
+	}
+	return nil
+}
+
+// MetricsWrite inserts the point of the live metrics into the metrics table
+func (o SQLiteOutput) MetricsWrite(
+	ctx context.Context,
+	log logger.Logger,
+	outputRoot string,
+	data []byte,
+) error {
+	if err := o.insertJSON("metrics", outputRoot, data); err != nil {
+		log.Error(ctx, "failed to insert metrics",
+			logger.Value("error", err), logger.Value("on", "SQLiteOutput.MetricsWrite"))
+		return fmt.Errorf("failed to write metrics: %w", err)
+	}
+	return nil
+}
+
+// ResourcesWrite inserts the resource usage sample into the resources table
+func (o SQLiteOutput) ResourcesWrite(
+	ctx context.Context,
+	log logger.Logger,
+	outputRoot string,
+	data []byte,
+) error {
+	if err := o.insertJSON("resources", outputRoot, data); err != nil {
+		log.Error(ctx, "failed to insert resource usage",
+			logger.Value("error", err), logger.Value("on", "SQLiteOutput.ResourcesWrite"))
+		return fmt.Errorf("failed to write resource usage: %w", err)
+	}
+	return nil
+}
+
+// insertJSON inserts the json data of the output root into the table
+func (o SQLiteOutput) insertJSON(table, outputRoot string, data []byte) error {
+	filePath := o.filePath(outputRoot)
+	db, err := acquireSQLite(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %w", err)
+	}
+	defer releaseSQLite(filePath)
+	//nolint:gosec
+	query := fmt.Sprintf("INSERT INTO %s (run_id, output_root, created_at, data) VALUES (?, ?, ?, ?)", table)
+	if _, err := db.Exec(
+		query,
+		runID(outputRoot),
+		outputRoot,
+		time.Now().UTC().Format(sqliteDatetimeLayout),
+		string(data),
+	); err != nil {
+		return fmt.Errorf("failed to insert into %s: %w", table, err)
+	}
+	return nil
+}
+
+var _ Output = SQLiteOutput{}
+
+// sqliteDB represents a database shared by the writers of a run
+type sqliteDB struct {
+	db   *sql.DB
+	refs int
+}
+
+// sqliteMu guards the shared databases
+var sqliteMu sync.Mutex
+
+// sqliteDBs holds the open databases, Key: file path
+var sqliteDBs = make(map[string]*sqliteDB)
+
+// acquireSQLite opens the database of the file, or returns the one already open.
+// The database must be released by releaseSQLite.
+func acquireSQLite(filePath string) (*sql.DB, error) {
+	sqliteMu.Lock()
+	defer sqliteMu.Unlock()
+	if d, ok := sqliteDBs[filePath]; ok {
+		d.refs++
+		return d.db, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+	db, err := sql.Open("sqlite", filePath+"?_pragma=busy_timeout(10000)")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite: %w", err)
+	}
+	// sqlite serializes the writes anyway, a single connection keeps them from failing as busy
+	db.SetMaxOpenConns(1)
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to create tables: %w", err)
+	}
+	sqliteDBs[filePath] = &sqliteDB{
+		db:   db,
+		refs: 1,
+	}
+	return db, nil
+}
+
+// releaseSQLite releases the database of the file, which is closed when released by all the writers
+func releaseSQLite(filePath string) error {
+	sqliteMu.Lock()
+	defer sqliteMu.Unlock()
+	d, ok := sqliteDBs[filePath]
+	if !ok {
+		return nil
+	}
+	d.refs--
+	if d.refs > 0 {
+		return nil
+	}
+	delete(sqliteDBs, filePath)
+	if err := d.db.Close(); err != nil {
+		return fmt.Errorf("failed to close sqlite: %w", err)
+	}
+	return nil
+}
+
+// sqliteRowWriter inserts the rows of an output into the records table.
+// The rows are buffered and inserted in a transaction by the batch, or at the flush interval.
+type sqliteRowWriter struct {
+	mu        sync.Mutex
+	db        *sql.DB
+	outputID  int64
+	header    []string
+	columns   map[string]int // Key: typed column, Value: index in the row
+	pending   [][]any
+	lastFlush time.Time
+}
+
+// newSQLiteRowWriter inserts the output with the source carried by the context, and creates a new sqliteRowWriter
+func newSQLiteRowWriter(ctx context.Context, db *sql.DB, uniqueName string, header []string) (*sqliteRowWriter, error) {
+	src := SourceFrom(ctx)
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal header: %w", err)
+	}
+	res, err := db.Exec(
+		`INSERT INTO outputs (run_id, flow_id, loader, request_index, slave_id, name, header, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+		runID(uniqueName),
+		src.FlowID,
+		src.Loader,
+		src.RequestIndex,
+		src.SlaveID,
+		uniqueName,
+		string(headerJSON),
+		time.Now().UTC().Format(sqliteDatetimeLayout),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert output: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get output id: %w", err)
+	}
+	columns := make(map[string]int)
+	for i, h := range header {
+		if col, ok := sqliteColumns[h]; ok {
+			columns[col] = i
+		}
+	}
+	return &sqliteRowWriter{
+		db:        db,
+		outputID:  id,
+		header:    header,
+		columns:   columns,
+		lastFlush: time.Now(),
+	}, nil
+}
+
+// write buffers the row, and inserts the buffered rows when the batch is full or the flush interval has passed
+func (w *sqliteRowWriter) write(_ context.Context, _ logger.Logger, data []any) error {
+	b, err := marshalRow(w.header, data)
+	if err != nil {
+		return err
+	}
+	args := []any{
+		w.outputID,
+		sqliteBool(w.value("success", data)),
+		sqliteDatetime(w.value("send_datetime", data)),
+		sqliteDatetime(w.value("received_datetime", data)),
+		sqliteInt(w.value("count", data)),
+		sqliteInt(w.value("response_time", data)),
+		sqliteInt(w.value("status_code", data)),
+		string(b),
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.pending = append(w.pending, args)
+	if len(w.pending) >= sqliteBatchSize || time.Since(w.lastFlush) >= sqliteFlushInterval {
+		return w.flush()
+	}
+	return nil
+}
+
+// value returns the value of the typed column in the row, nil if the header has no such column
+func (w *sqliteRowWriter) value(column string, data []any) any {
+	i, ok := w.columns[column]
+	if !ok || i >= len(data) {
+		return nil
+	}
+	return data[i]
+}
+
+// flush inserts the buffered rows in a transaction.
+// It is not bound to the context of the runner, so that the rows are kept on the cancellation.
+func (w *sqliteRowWriter) flush() error {
+	w.lastFlush = time.Now()
+	if len(w.pending) == 0 {
+		return nil
+	}
+	pending := w.pending
+	w.pending = nil
+	tx, err := w.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	stmt, err := tx.Prepare(`INSERT INTO records
+		(output_id, success, send_datetime, received_datetime, count, response_time, status_code, data)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to prepare insert: %w", err)
+	}
+	defer stmt.Close()
+	for _, args := range pending {
+		if _, err := stmt.Exec(args...); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to insert record: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// close inserts the buffered rows
+func (w *sqliteRowWriter) close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.flush()
+}
+
+// sqliteBool returns the boolean value as 1 or 0, nil if the value is not a boolean
+func sqliteBool(v any) any {
+	switch v := v.(type) {
+	case bool:
+		if v {
+			return 1
+		}
+		return 0
+	case string:
+		if b, err := strconv.ParseBool(v); err == nil {
+			return sqliteBool(b)
+		}
+	}
+	return nil
+}
+
+// sqliteInt returns the integer value, nil if the value is not an integer.
+// The strings of integers, such as the status codes, are parsed.
+func sqliteInt(v any) any {
+	if s, ok := v.(string); ok {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil
+		}
+		return n
+	}
+	if n, ok := parquetValue(v, parquet.TypeInt64); ok {
+		return n
+	}
+	return nil
+}
+
+// sqliteDatetime returns the RFC3339 datetime in the layout of the datetime columns, nil if the value is not a datetime
+func sqliteDatetime(v any) any {
+	var t time.Time
+	switch v := v.(type) {
+	case time.Time:
+		t = v
+	case string:
+		parsed, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return nil
+		}
+		t = parsed
+	default:
+		return nil
+	}
+	return t.UTC().Format(sqliteDatetimeLayout)
+}
@@ -0,0 +1,171 @@
+package output
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+// sqliteRefs returns the number of the writers holding the database of the file, 0 if it is closed
+func sqliteRefs(filePath string) int {
+	sqliteMu.Lock()
+	defer sqliteMu.Unlock()
+	if d, ok := sqliteDBs[filePath]; ok {
+		return d.refs
+	}
+	return 0
+}
+
+// TestSQLiteOutputConcurrentWriters tests two writers of the same run writing concurrently,
+// the typed columns of the results view and the close of the database once released by both.
+func TestSQLiteOutputConcurrentWriters(t *testing.T) {
+	const rows = 50
+	o := SQLiteOutput{BasePath: t.TempDir()}
+	log := logger.NewSlogLogger()
+	header := []string{"Success", "SendDatetime", "ReceivedDatetime", "Count", "ResponseTime", "StatusCode", "Body"}
+	filePath := filepath.Join(o.BasePath, "run1", SQLiteFileName)
+
+	ctx := WithLoader(WithSource(context.Background(), Source{FlowID: "flow1", SlaveID: "slave1"}), "loader.yaml")
+	var writes []HTTPDataWrite
+	var closes []Close
+	for i, name := range []string{"run1/a_0", "run1/a_1"} {
+		write, closeFn, err := o.HTTPDataWriteFactory(WithRequestIndex(ctx, i), log, true, name, header)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		writes = append(writes, write)
+		closes = append(closes, closeFn)
+	}
+	if got := sqliteRefs(filePath); got != 2 {
+		t.Errorf("expected %v, got %v", 2, got)
+	}
+
+	var wg sync.WaitGroup
+	for i, write := range writes {
+		wg.Add(1)
+		go func(i int, write HTTPDataWrite) {
+			defer wg.Done()
+			for n := 0; n < rows; n++ {
+				_ = write(context.Background(), log, []any{
+					i == 0,
+					"2024-01-02T03:04:05.123456+09:00",
+					"2024-01-02T03:04:05.223456+09:00",
+					n,
+					int64(100),
+					"200",
+					map[string]any{"n": n},
+				})
+			}
+		}(i, write)
+	}
+	wg.Wait()
+
+	for i, closeFn := range closes {
+		if err := closeFn(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got, want := sqliteRefs(filePath), 1-i; got != want {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	}
+
+	if err := o.SummaryWrite(context.Background(), log, "run1/a_0", []byte(`{"total":100}`)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := sqliteRefs(filePath); got != 0 {
+		t.Errorf("expected the database to be closed after the summary, got %v refs", got)
+	}
+
+	db, err := sql.Open("sqlite", filePath)
+	if err != nil {
+		t.Fatalf("failed to open sqlite: %v", err)
+	}
+	defer db.Close()
+
+	for i, name := range []string{"run1/a_0", "run1/a_1"} {
+		t.Run(name, func(tt *testing.T) {
+			var (
+				count        int
+				runID        string
+				flowID       string
+				loader       string
+				requestIndex int
+				slaveID      string
+				success      int
+				sendDatetime string
+				recvDatetime string
+				responseTime int64
+				statusCode   int64
+				sumCount     int64
+			)
+			if err := db.QueryRow(`SELECT COUNT(*), run_id, flow_id, loader, request_index, slave_id,
+				MIN(success), MIN(send_datetime), MIN(received_datetime), MIN(response_time), MIN(status_code), SUM(count)
+				FROM results WHERE name = ? GROUP BY run_id, flow_id, loader, request_index, slave_id`, name).Scan(
+				&count, &runID, &flowID, &loader, &requestIndex, &slaveID,
+				&success, &sendDatetime, &recvDatetime, &responseTime, &statusCode, &sumCount,
+			); err != nil {
+				tt.Fatalf("failed to query results: %v", err)
+			}
+			got := []any{count, runID, flowID, loader, requestIndex, slaveID,
+				success, sendDatetime, recvDatetime, responseTime, statusCode, sumCount}
+			want := []any{rows, "run1", "flow1", "loader.yaml", i, "slave1",
+				1 - i, "2024-01-01 18:04:05.123456", "2024-01-01 18:04:05.223456", int64(100), int64(200), int64(rows * (rows - 1) / 2)}
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				tt.Errorf("expected %v, got %v", want, got)
+			}
+
+			var data string
+			if err := db.QueryRow(`SELECT data FROM results WHERE name = ? AND count = 0`, name).Scan(&data); err != nil {
+				tt.Fatalf("failed to query data: %v", err)
+			}
+			wantData := fmt.Sprintf(`{"Success":%v,"SendDatetime":"2024-01-02T03:04:05.123456+09:00",`+
+				`"ReceivedDatetime":"2024-01-02T03:04:05.223456+09:00","Count":0,"ResponseTime":100,"StatusCode":"200","Body":{"n":0}}`,
+				i == 0)
+			if data != wantData {
+				tt.Errorf("expected %v, got %v", wantData, data)
+			}
+		})
+	}
+
+	var runID, outputRoot, summary string
+	if err := db.QueryRow(`SELECT run_id, output_root, data FROM summaries`).Scan(&runID, &outputRoot, &summary); err != nil {
+		t.Fatalf("failed to query summaries: %v", err)
+	}
+	if runID != "run1" || outputRoot != "run1/a_0" || summary != `{"total":100}` {
+		t.Errorf("expected %v, got %v", []string{"run1", "run1/a_0", `{"total":100}`}, []string{runID, outputRoot, summary})
+	}
+}
+
+// TestSQLiteTypedValues tests the conversion of the values of the typed columns.
+func TestSQLiteTypedValues(t *testing.T) {
+	tests := []struct {
+		name string
+		conv func(any) any
+		v    any
+		want any
+	}{
+		{name: "BoolTrue", conv: sqliteBool, v: true, want: 1},
+		{name: "BoolFalseString", conv: sqliteBool, v: "false", want: 0},
+		{name: "BoolInvalid", conv: sqliteBool, v: "yes", want: nil},
+		{name: "IntString", conv: sqliteInt, v: "404", want: int64(404)},
+		{name: "IntNumber", conv: sqliteInt, v: 12, want: int64(12)},
+		{name: "IntInvalid", conv: sqliteInt, v: "abc", want: nil},
+		{name: "IntNil", conv: sqliteInt, v: nil, want: nil},
+		{name: "DatetimeUTC", conv: sqliteDatetime, v: "2024-01-02T03:04:05Z", want: "2024-01-02 03:04:05.000000"},
+		{name: "DatetimeOffset", conv: sqliteDatetime, v: "2024-01-02T03:04:05.5+01:00", want: "2024-01-02 02:04:05.500000"},
+		{name: "DatetimeInvalid", conv: sqliteDatetime, v: "yesterday", want: nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			if got := test.conv(test.v); got != test.want {
+				tt.Errorf("expected %v (%T), got %v (%T)", test.want, test.want, got, got)
+			}
+		})
+	}
+}
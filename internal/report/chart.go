@@ -0,0 +1,129 @@
+package report
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	chartWidth  = 960
+	chartHeight = 260
+	// chartLeft, chartRight, chartTop and chartBottom represent the margins of the plot area
+	chartLeft   = 64
+	chartRight  = 16
+	chartTop    = 12
+	chartBottom = 36
+	// chartTicks represents the number of the intervals of the ticks of an axis
+	chartTicks = 5
+)
+
+// Chart represents a line chart rendered as inline svg
+type Chart struct {
+	Width  int
+	Height int
+	Left   int
+	Right  int
+	Top    int
+	Bottom int
+	Lines  []Line
+	XTicks []Tick
+	YTicks []Tick
+	XLabel string
+	YLabel string
+}
+
+// Line represents a line of the chart
+type Line struct {
+	Name   string
+	Color  string
+	Points string
+}
+
+// Tick represents a tick of an axis, at the position in pixels
+type Tick struct {
+	Pos   float64
+	Label string
+}
+
+// lineSpec represents the values of a line of the chart
+type lineSpec struct {
+	name  string
+	color string
+	value func(p point) (float64, bool)
+}
+
+// newChart creates the chart of the lines over the points
+func newChart(points []point, width float64, yLabel string, specs ...lineSpec) *Chart {
+	if len(points) == 0 {
+		return nil
+	}
+	c := &Chart{
+		Width:  chartWidth,
+		Height: chartHeight,
+		Left:   chartLeft,
+		Right:  chartWidth - chartRight,
+		Top:    chartTop,
+		Bottom: chartHeight - chartBottom,
+		XLabel: "elapsed (s)",
+		YLabel: yLabel,
+	}
+	var yMax float64
+	for _, spec := range specs {
+		for _, p := range points {
+			if v, ok := spec.value(p); ok && v > yMax {
+				yMax = v
+			}
+		}
+	}
+	yMax = niceCeil(yMax)
+	xMax := points[len(points)-1].elapsed + width
+	x := func(v float64) float64 {
+		return float64(c.Left) + v/xMax*float64(c.Right-c.Left)
+	}
+	y := func(v float64) float64 {
+		return float64(c.Bottom) - v/yMax*float64(c.Bottom-c.Top)
+	}
+	for _, spec := range specs {
+		var b strings.Builder
+		for _, p := range points {
+			v, ok := spec.value(p)
+			if !ok {
+				continue
+			}
+			fmt.Fprintf(&b, "%.1f,%.1f ", x(p.elapsed+width/2), y(v))
+		}
+		c.Lines = append(c.Lines, Line{
+			Name:   spec.name,
+			Color:  spec.color,
+			Points: strings.TrimSpace(b.String()),
+		})
+	}
+	for i := 0; i <= chartTicks; i++ {
+		xv := xMax * float64(i) / chartTicks
+		yv := yMax * float64(i) / chartTicks
+		c.XTicks = append(c.XTicks, Tick{Pos: x(xv), Label: formatTick(xv)})
+		c.YTicks = append(c.YTicks, Tick{Pos: y(yv), Label: formatTick(yv)})
+	}
+	return c
+}
+
+// niceCeil returns the round value not less than the value, 1 for the values not greater than 0
+func niceCeil(v float64) float64 {
+	if v <= 0 {
+		return 1
+	}
+	exp := math.Pow(10, math.Floor(math.Log10(v)))
+	for _, m := range []float64{1, 2, 2.5, 5, 10} {
+		if m*exp >= v {
+			return m * exp
+		}
+	}
+	return 10 * exp
+}
+
+// formatTick returns the label of the tick, without the trailing zeros
+func formatTick(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
@@ -0,0 +1,328 @@
+package report
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ablankz/bloader/internal/output"
+	"github.com/ablankz/bloader/internal/output/parquet"
+	"github.com/ablankz/bloader/internal/runner"
+)
+
+const (
+	// summaryFileName represents the name of the summary file written in the output root
+	summaryFileName = "summary.json"
+	// connectionsSuffix represents the suffix of the files of the websocket connections
+	connectionsSuffix = "_connections"
+)
+
+// ignoredFiles represents the files in the directory of the run which are not the rows of the requests
+var ignoredFiles = map[string]struct{}{
+	"metrics.jsonl":        {},
+	"resources.jsonl":      {},
+	output.SourcesFileName: {},
+}
+
+// sample represents a request read from a row of the output files
+type sample struct {
+	// sent is the time of sending the request in unix milliseconds, 0 if it was not sent
+	sent    int64
+	latency int64
+	success bool
+	status  string
+}
+
+// responded returns true if the response of the request is received
+func (s sample) responded() bool {
+	return s.sent > 0 && s.status != "" && s.status != "0"
+}
+
+// series represents the requests of an output file
+type series struct {
+	// path is the path of the file relative to the output directory
+	path    string
+	source  output.SourceRecord
+	samples []sample
+}
+
+// summaryFile represents the summaries written in a directory
+type summaryFile struct {
+	// dir is the directory of the summary relative to the output directory
+	dir       string
+	summaries []runner.RunSummary
+}
+
+// input represents what is read from the output directory
+type input struct {
+	series    []*series
+	summaries []summaryFile
+}
+
+// read reads the row files, the sources and the summaries under the output directory
+func read(dir string) (*input, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat output directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+	in := &input{}
+	sources := make(map[string]output.SourceRecord)
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		name := d.Name()
+		ext := filepath.Ext(name)
+		switch {
+		case name == output.SourcesFileName:
+			if err := readSources(path, filepath.Dir(rel), sources); err != nil {
+				return fmt.Errorf("failed to read %s: %w", rel, err)
+			}
+			return nil
+		case name == summaryFileName:
+			summaries, err := readSummaries(path)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", rel, err)
+			}
+			in.summaries = append(in.summaries, summaryFile{dir: filepath.Dir(rel), summaries: summaries})
+			return nil
+		}
+		if _, ok := ignoredFiles[name]; ok || strings.HasSuffix(strings.TrimSuffix(name, ext), connectionsSuffix) {
+			return nil
+		}
+		var samples []sample
+		var ok bool
+		switch ext {
+		case ".csv":
+			samples, ok, err = readCSV(path)
+		case ".jsonl":
+			samples, ok, err = readJSONL(path)
+		case ".parquet":
+			samples, ok, err = readParquet(path)
+		default:
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", rel, err)
+		}
+		if ok {
+			in.series = append(in.series, &series{path: rel, samples: samples})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range in.series {
+		s.source = sources[s.path]
+	}
+	return in, nil
+}
+
+// readSources reads the sources file in the directory, keyed by the path of the file relative to the output directory
+func readSources(path, dir string, sources map[string]output.SourceRecord) error {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var rec output.SourceRecord
+		if err := json.Unmarshal(line, &rec); err != nil {
+			return fmt.Errorf("failed to unmarshal source: %w", err)
+		}
+		sources[filepath.Join(dir, filepath.FromSlash(rec.File))] = rec
+	}
+	return scanner.Err()
+}
+
+// readSummaries reads the summaries of the summary file
+func readSummaries(path string) ([]runner.RunSummary, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	var summaries []runner.RunSummary
+	if err := json.Unmarshal(data, &summaries); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal summary: %w", err)
+	}
+	return summaries, nil
+}
+
+// requestColumns represents the columns of the rows of the requests
+var requestColumns = []string{"Success", "SendDatetime", "ResponseTime", "StatusCode"}
+
+// eventIndexColumn represents the column of the index of the event of the stream response types
+const eventIndexColumn = "EventIndex"
+
+// isRequestHeader returns true if the header has the columns of the rows of the requests
+func isRequestHeader(has func(column string) bool) bool {
+	for _, c := range requestColumns {
+		if !has(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseSample parses the values of the row keyed by the column,
+// false for the rows of the events following the first one of a response
+func parseSample(value func(column string) string) (sample, bool) {
+	if idx := value(eventIndexColumn); idx != "" && idx != "0" {
+		return sample{}, false
+	}
+	var s sample
+	s.success, _ = strconv.ParseBool(value("Success"))
+	if t, err := time.Parse(time.RFC3339Nano, value("SendDatetime")); err == nil && t.Year() > 1 {
+		s.sent = t.UnixMilli()
+	}
+	if v, err := strconv.ParseFloat(value("ResponseTime"), 64); err == nil {
+		s.latency = int64(v)
+	}
+	s.status = value("StatusCode")
+	return s, true
+}
+
+// readCSV reads the samples of the csv file, false if it is not a file of the requests
+func readCSV(path string) ([]sample, bool, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, false, err
+	}
+	defer f.Close()
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	header, err := r.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		columns[h] = i
+	}
+	if !isRequestHeader(func(c string) bool { _, ok := columns[c]; return ok }) {
+		return nil, false, nil
+	}
+	var samples []sample
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		s, ok := parseSample(func(c string) string {
+			if i, ok := columns[c]; ok && i < len(record) {
+				return record[i]
+			}
+			return ""
+		})
+		if ok {
+			samples = append(samples, s)
+		}
+	}
+	return samples, true, nil
+}
+
+// readJSONL reads the samples of the jsonl file, false if it is not a file of the requests
+func readJSONL(path string) ([]sample, bool, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, false, err
+	}
+	defer f.Close()
+	dec := json.NewDecoder(f)
+	var samples []sample
+	for {
+		var row map[string]any
+		if err := dec.Decode(&row); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, false, err
+		}
+		if len(samples) == 0 && !isRequestHeader(func(c string) bool { _, ok := row[c]; return ok }) {
+			return nil, false, nil
+		}
+		s, ok := parseSample(func(c string) string {
+			return output.ValueString(row[c])
+		})
+		if ok {
+			samples = append(samples, s)
+		}
+	}
+	return samples, len(samples) > 0, nil
+}
+
+// readParquet reads the samples of the parquet file, false if it is not a file of the requests
+func readParquet(path string) ([]sample, bool, error) {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, false, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, false, err
+	}
+	r, err := parquet.NewReader(f, info.Size())
+	if err != nil {
+		return nil, false, err
+	}
+	defer r.Close()
+	columns := make(map[string]int, len(r.Columns()))
+	for i, c := range r.Columns() {
+		columns[c.Name] = i
+	}
+	if !isRequestHeader(func(c string) bool { _, ok := columns[c]; return ok }) {
+		return nil, false, nil
+	}
+	var samples []sample
+	for {
+		row, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		s, ok := parseSample(func(c string) string {
+			if i, ok := columns[c]; ok {
+				return output.ValueString(row[i])
+			}
+			return ""
+		})
+		if ok {
+			samples = append(samples, s)
+		}
+	}
+	return samples, true, nil
+}
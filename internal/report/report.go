@@ -0,0 +1,238 @@
+// Package report renders the static HTML report of the outputs of the runs written by the local output.
+package report
+
+import (
+	_ "embed"
+	"fmt"
+	"html/template"
+	"io"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+//go:embed report.html
+var reportTemplate string
+
+var tmpl = template.Must(template.New("report").Funcs(template.FuncMap{
+	"float": func(v float64) string {
+		return fmt.Sprintf("%.2f", v)
+	},
+	"datetime": func(t time.Time) string {
+		if t.IsZero() {
+			return "-"
+		}
+		return t.Format(time.RFC3339)
+	},
+}).Parse(reportTemplate))
+
+const (
+	// masterName represents the name of the tab of the requests sent by the master
+	masterName = "master"
+	// noFlowName represents the name of the tab of the requests sent out of the flows
+	noFlowName = "(no flow)"
+)
+
+// Report represents the data of the HTML report
+type Report struct {
+	Dir         string
+	GeneratedAt time.Time
+	Tabs        []*Tab
+}
+
+// Tab represents a tab of the report, aggregating a group of the output files
+type Tab struct {
+	ID   string
+	Name string
+	stats
+	LatencyChart    *Chart
+	ThroughputChart *Chart
+	Errors          []Count
+	Requests        []Request
+	Files           []File
+}
+
+// Request represents a request of the summaries of the tab
+type Request struct {
+	Output    string
+	Name      string
+	Total     int64
+	Failure   int64
+	ErrorRate float64
+	RPS       float64
+	P50       float64
+	P99       float64
+	Terminate string
+}
+
+// File represents an output file of the tab
+type File struct {
+	Path         string
+	FlowID       string
+	SlaveID      string
+	Loader       string
+	RequestIndex int
+	Total        int64
+	Failure      int64
+	P50          float64
+	P99          float64
+}
+
+// Generate reads the outputs under the directory and writes the HTML report to the writer
+func Generate(dir string, w io.Writer) error {
+	in, err := read(dir)
+	if err != nil {
+		return err
+	}
+	if len(in.series) == 0 && len(in.summaries) == 0 {
+		return fmt.Errorf("no output of the requests found in %s", dir)
+	}
+	r := Report{
+		Dir:         dir,
+		GeneratedAt: time.Now(),
+	}
+	sort.Slice(in.series, func(i, j int) bool { return in.series[i].path < in.series[j].path })
+	r.Tabs = append(r.Tabs, newTab("All", in.series, in.summaries))
+	flows := groupBy(in.series, func(s *series) string { return s.source.FlowID })
+	if len(flows) > 1 || (len(flows) == 1 && flows[0].key != "") {
+		for _, g := range flows {
+			name := g.key
+			if name == "" {
+				name = noFlowName
+			}
+			r.Tabs = append(r.Tabs, newTab("Flow: "+name, g.series, summariesOf(g.series, in.summaries)))
+		}
+	}
+	slaves := groupBy(in.series, func(s *series) string { return s.source.SlaveID })
+	if len(slaves) > 1 || (len(slaves) == 1 && slaves[0].key != "") {
+		for _, g := range slaves {
+			name := g.key
+			if name == "" {
+				name = masterName
+			}
+			r.Tabs = append(r.Tabs, newTab("Slave: "+name, g.series, summariesOf(g.series, in.summaries)))
+		}
+	}
+	for i, t := range r.Tabs {
+		t.ID = fmt.Sprintf("tab-%d", i)
+	}
+	if err := tmpl.Execute(w, r); err != nil {
+		return fmt.Errorf("failed to render report: %w", err)
+	}
+	return nil
+}
+
+// group represents the series of the same key
+type group struct {
+	key    string
+	series []*series
+}
+
+// groupBy groups the series by the key, in the order of the keys
+func groupBy(series []*series, key func(s *series) string) []group {
+	index := make(map[string]int)
+	var groups []group
+	for _, s := range series {
+		k := key(s)
+		i, ok := index[k]
+		if !ok {
+			i = len(groups)
+			index[k] = i
+			groups = append(groups, group{key: k})
+		}
+		groups[i].series = append(groups[i].series, s)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].key < groups[j].key })
+	return groups
+}
+
+// summariesOf returns the summaries written in the directories of the series
+func summariesOf(series []*series, summaries []summaryFile) []summaryFile {
+	dirs := make(map[string]struct{})
+	for _, s := range series {
+		dirs[filepath.Dir(s.path)] = struct{}{}
+	}
+	var of []summaryFile
+	for _, sf := range summaries {
+		if _, ok := dirs[sf.dir]; ok {
+			of = append(of, sf)
+		}
+	}
+	return of
+}
+
+// newTab creates the tab of the series and the summaries
+func newTab(name string, ss []*series, summaries []summaryFile) *Tab {
+	t := &Tab{
+		Name:  name,
+		stats: aggregate(ss),
+	}
+	t.LatencyChart = newChart(t.points, t.width, "latency (ms)",
+		lineSpec{name: "p50", color: "#2b6cb0", value: func(p point) (float64, bool) { return p.p50, p.responded }},
+		lineSpec{name: "p90", color: "#dd6b20", value: func(p point) (float64, bool) { return p.p90, p.responded }},
+		lineSpec{name: "p99", color: "#c53030", value: func(p point) (float64, bool) { return p.p99, p.responded }},
+	)
+	t.ThroughputChart = newChart(t.points, t.width, "requests / s",
+		lineSpec{name: "requests", color: "#2f855a", value: func(p point) (float64, bool) { return p.rps, true }},
+		lineSpec{name: "failures", color: "#c53030", value: func(p point) (float64, bool) { return p.eps, true }},
+	)
+	for _, s := range ss {
+		st := aggregate([]*series{s})
+		t.Files = append(t.Files, File{
+			Path:         s.path,
+			FlowID:       s.source.FlowID,
+			SlaveID:      s.source.SlaveID,
+			Loader:       s.source.Loader,
+			RequestIndex: s.source.RequestIndex,
+			Total:        st.Total,
+			Failure:      st.Failure,
+			P50:          st.Latency.P50,
+			P99:          st.Latency.P99,
+		})
+	}
+	terminated := make(map[string]int64)
+	var errorTotal int64
+	for _, sf := range summaries {
+		for _, s := range sf.summaries {
+			for k, v := range s.Total.Errors {
+				terminated[k] += v
+				errorTotal += v
+			}
+			for _, req := range s.Requests {
+				terminate := req.TerminateType
+				if req.TerminateParam != "" {
+					terminate = fmt.Sprintf("%s(%s)", terminate, req.TerminateParam)
+				}
+				t.Requests = append(t.Requests, Request{
+					Output:    sf.dir,
+					Name:      req.Name,
+					Total:     req.Total,
+					Failure:   req.Failure,
+					ErrorRate: req.ErrorRate * 100,
+					RPS:       req.RPS,
+					P50:       req.Latency.P50,
+					P99:       req.Latency.P99,
+					Terminate: terminate,
+				})
+			}
+		}
+	}
+	for k, v := range terminated {
+		if v == 0 {
+			continue
+		}
+		t.Errors = append(t.Errors, Count{
+			Key:     k,
+			Count:   v,
+			Percent: float64(v) / float64(errorTotal) * 100,
+			Class:   "s5xx",
+		})
+	}
+	sort.Slice(t.Errors, func(i, j int) bool {
+		if t.Errors[i].Count != t.Errors[j].Count {
+			return t.Errors[i].Count > t.Errors[j].Count
+		}
+		return t.Errors[i].Key < t.Errors[j].Key
+	})
+	return t
+}
@@ -0,0 +1,194 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ablankz/bloader/internal/config"
+	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/output"
+	"github.com/ablankz/bloader/internal/runner"
+)
+
+// goldenStart represents the sending time of the first request of the golden output
+var goldenStart = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+// writeGolden writes the golden output of the format from the source to the unique name under the directory.
+// The requests are sent every 100ms with the latencies of 10ms to 90ms,
+// and the last two are a 500 and a request with no response.
+func writeGolden(t *testing.T, dir string, format config.OutputFormat, uniqueName string, src output.Source) {
+	t.Helper()
+	log := logger.NewSlogLogger()
+	o := output.LocalOutput{Format: format, BasePath: dir}
+	header := []string{"Success", "SendDatetime", "ReceivedDatetime", "Count", "ResponseTime", "StatusCode"}
+	write, closeFn, err := o.HTTPDataWriteFactory(output.WithSource(context.Background(), src), log, true, uniqueName, header)
+	if err != nil {
+		t.Fatalf("failed to create writer: %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		sent := goldenStart.Add(time.Duration(i) * 100 * time.Millisecond)
+		latency := (i + 1) * 10
+		data := runner.WriteData{
+			Success:          true,
+			SendDatetime:     sent.Format(time.RFC3339Nano),
+			ReceivedDatetime: sent.Add(time.Duration(latency) * time.Millisecond).Format(time.RFC3339Nano),
+			Count:            i + 1,
+			ResponseTime:     latency,
+			StatusCode:       "200",
+		}
+		switch i {
+		case 8:
+			data.Success = false
+			data.StatusCode = "500"
+		case 9:
+			data.Success = false
+			data.ReceivedDatetime = ""
+			data.ResponseTime = 0
+			data.StatusCode = "0"
+		}
+		if err := write(context.Background(), log, data.ToSlice()); err != nil {
+			t.Fatalf("failed to write: %v", err)
+		}
+	}
+	if err := closeFn(); err != nil {
+		t.Fatalf("failed to close writer: %v", err)
+	}
+}
+
+// TestAggregateGolden tests the stats computed from the golden output written in each format.
+func TestAggregateGolden(t *testing.T) {
+	want := stats{
+		Total:     10,
+		Success:   8,
+		Failure:   2,
+		ErrorRate: 20,
+		Start:     goldenStart,
+		End:       goldenStart.Add(900 * time.Millisecond),
+		Duration:  900 * time.Millisecond,
+		Latency:   Latency{Min: 10, Mean: 50, P50: 50, P90: 90, P95: 90, P99: 90, Max: 90},
+		Status: []Count{
+			{Key: "200", Count: 8, Percent: 80, Class: "s2xx"},
+			{Key: "500", Count: 1, Percent: 10, Class: "s5xx"},
+			{Key: noResponseKey, Count: 1, Percent: 10, Class: "none"},
+		},
+	}
+
+	for _, format := range []config.OutputFormat{config.OutputFormatCSV, config.OutputFormatJSONL, config.OutputFormatParquet} {
+		t.Run(string(format), func(tt *testing.T) {
+			dir := tt.TempDir()
+			writeGolden(tt, dir, format, "run1/req_0", output.Source{FlowID: "flow1", Loader: "loader.yaml"})
+
+			in, err := read(dir)
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			if len(in.series) != 1 {
+				tt.Fatalf("expected %v, got %v", 1, len(in.series))
+			}
+			s := in.series[0]
+			if wantPath := filepath.Join("run1", "req_0."+string(format)); s.path != wantPath {
+				tt.Errorf("expected %v, got %v", wantPath, s.path)
+			}
+			if s.source.FlowID != "flow1" || s.source.Loader != "loader.yaml" {
+				tt.Errorf("expected %v, got %v", "flow1 loader.yaml", s.source)
+			}
+
+			got := aggregate(in.series)
+			if wantRPS := 10 / 0.9; math.Abs(got.RPS-wantRPS) > 1e-9 {
+				tt.Errorf("expected %v, got %v", wantRPS, got.RPS)
+			}
+			if len(got.points) != 1 || got.width != 1 {
+				tt.Errorf("expected a single bucket of 1s, got %v of %vs", len(got.points), got.width)
+			}
+			got.RPS = 0
+			got.Start, got.End = got.Start.UTC(), got.End.UTC()
+			got.points, got.width = nil, 0
+			if !reflect.DeepEqual(got, want) {
+				tt.Errorf("expected %+v, got %+v", want, got)
+			}
+		})
+	}
+}
+
+// TestGenerate tests that the report of the outputs of the formats, the flows and the slaves is rendered.
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	writeGolden(t, dir, config.OutputFormatCSV, "run1/master/req_0", output.Source{})
+	writeGolden(t, dir, config.OutputFormatJSONL, "run1/slave/req_0", output.Source{FlowID: "flow1", SlaveID: "slave1"})
+	writeGolden(t, dir, config.OutputFormatParquet, "run1/slave/req_1", output.Source{FlowID: "flow1", SlaveID: "slave2"})
+	summary, err := json.Marshal(runner.RunSummary{
+		OutputRoot: "run1/master",
+		Requests:   []runner.ExecSummary{{Name: "req", Total: 10, Failure: 2, TerminateType: "count", TerminateParam: "10"}},
+		Total:      runner.ExecSummary{Name: "total", Errors: map[string]int64{"timeout": 2}},
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal summary: %v", err)
+	}
+	if err := (output.LocalOutput{BasePath: dir}).SummaryWrite(context.Background(), logger.NewSlogLogger(), "run1/master", summary); err != nil {
+		t.Fatalf("failed to write summary: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := Generate(dir, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	html := buf.String()
+	for _, want := range []string{
+		"<html",
+		"All",
+		"Flow: " + noFlowName,
+		"Flow: flow1",
+		"Slave: " + masterName,
+		"Slave: slave1",
+		"Slave: slave2",
+		filepath.Join("run1", "master", "req_0.csv"),
+		filepath.Join("run1", "slave", "req_0.jsonl"),
+		filepath.Join("run1", "slave", "req_1.parquet"),
+		"count(10)",
+		"timeout",
+		"<svg",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("expected the report to contain %q", want)
+		}
+	}
+	if got := strings.Count(html, `id="tab-`); got != 6 {
+		t.Errorf("expected %v, got %v", 6, got)
+	}
+
+	if err := Generate(t.TempDir(), &buf); err == nil {
+		t.Errorf("expected an error for the directory with no output, got nil")
+	}
+}
+
+// TestPercentile tests the nearest rank percentile.
+func TestPercentile(t *testing.T) {
+	sorted := []int64{10, 20, 30, 40, 50, 60, 70, 80, 90, 100}
+	tests := []struct {
+		p    float64
+		want float64
+	}{
+		{p: 0, want: 10},
+		{p: 10, want: 10},
+		{p: 50, want: 50},
+		{p: 90, want: 90},
+		{p: 95, want: 100},
+		{p: 100, want: 100},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.p), func(tt *testing.T) {
+			if got := percentile(sorted, test.p); got != test.want {
+				tt.Errorf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package report
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	// maxBuckets represents the highest number of the points of the charts over time
+	maxBuckets = 300
+	// minBucketWidth represents the lowest width of the buckets of the charts over time in milliseconds
+	minBucketWidth = int64(time.Second / time.Millisecond)
+)
+
+// Latency represents the latency percentiles in milliseconds
+type Latency struct {
+	Min  float64
+	Mean float64
+	P50  float64
+	P90  float64
+	P95  float64
+	P99  float64
+	Max  float64
+}
+
+// Count represents a counter of the breakdown
+type Count struct {
+	Key     string
+	Count   int64
+	Percent float64
+	// Class is the css class of the bar
+	Class string
+}
+
+// bucket represents the requests sent in a bucket of the time
+type bucket struct {
+	total     int64
+	failure   int64
+	latencies []int64
+}
+
+// point represents the values of a bucket of the time
+type point struct {
+	// elapsed is the start of the bucket from the start of the requests in seconds
+	elapsed float64
+	rps     float64
+	eps     float64
+	p50     float64
+	p90     float64
+	p99     float64
+	// responded is false if no response is received in the bucket
+	responded bool
+}
+
+// stats represents the aggregation of the samples
+type stats struct {
+	Total     int64
+	Success   int64
+	Failure   int64
+	ErrorRate float64
+	RPS       float64
+	Start     time.Time
+	End       time.Time
+	Duration  time.Duration
+	Latency   Latency
+	Status    []Count
+	points    []point
+	// width is the width of the buckets in seconds
+	width float64
+}
+
+// aggregate aggregates the samples of the series
+func aggregate(series []*series) stats {
+	var st stats
+	var latencies []int64
+	var sum float64
+	var first, last int64
+	status := make(map[string]int64)
+	for _, s := range series {
+		for _, v := range s.samples {
+			st.Total++
+			if v.success {
+				st.Success++
+			}
+			if v.sent > 0 {
+				if first == 0 || v.sent < first {
+					first = v.sent
+				}
+				if v.sent > last {
+					last = v.sent
+				}
+			}
+			if !v.responded() {
+				status[noResponseKey]++
+				continue
+			}
+			status[v.status]++
+			latencies = append(latencies, v.latency)
+			sum += float64(v.latency)
+		}
+	}
+	st.Failure = st.Total - st.Success
+	if st.Total > 0 {
+		st.ErrorRate = float64(st.Failure) / float64(st.Total) * 100
+	}
+	if first > 0 {
+		st.Start = time.UnixMilli(first)
+		st.End = time.UnixMilli(last)
+		st.Duration = st.End.Sub(st.Start)
+		if elapsed := st.Duration.Seconds(); elapsed > 0 {
+			st.RPS = float64(st.Total) / elapsed
+		}
+	}
+	if len(latencies) > 0 {
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		st.Latency = Latency{
+			Min:  float64(latencies[0]),
+			Mean: sum / float64(len(latencies)),
+			P50:  percentile(latencies, 50),
+			P90:  percentile(latencies, 90),
+			P95:  percentile(latencies, 95),
+			P99:  percentile(latencies, 99),
+			Max:  float64(latencies[len(latencies)-1]),
+		}
+	}
+	st.Status = statusCounts(status, st.Total)
+	if first > 0 {
+		st.points, st.width = timeline(series, first, last)
+	}
+	return st
+}
+
+// noResponseKey represents the key of the status codes of the requests with no response
+const noResponseKey = "no response"
+
+// statusCounts returns the counters of the status codes in the order of the codes
+func statusCounts(status map[string]int64, total int64) []Count {
+	counts := make([]Count, 0, len(status))
+	for k, v := range status {
+		c := Count{Key: k, Count: v, Class: statusClass(k)}
+		if total > 0 {
+			c.Percent = float64(v) / float64(total) * 100
+		}
+		counts = append(counts, c)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Key == noResponseKey || counts[j].Key == noResponseKey {
+			return counts[j].Key == noResponseKey && counts[i].Key != noResponseKey
+		}
+		return counts[i].Key < counts[j].Key
+	})
+	return counts
+}
+
+// statusClass returns the css class of the status code
+func statusClass(code string) string {
+	n, err := strconv.Atoi(code)
+	if err != nil {
+		return "none"
+	}
+	switch {
+	case n >= 500:
+		return "s5xx"
+	case n >= 400:
+		return "s4xx"
+	case n >= 300:
+		return "s3xx"
+	case n >= 200:
+		return "s2xx"
+	}
+	return "none"
+}
+
+// timeline returns the points of the buckets between the first and the last sending,
+// with the width of the buckets in seconds
+func timeline(series []*series, first, last int64) ([]point, float64) {
+	width := minBucketWidth
+	if span := last - first + 1; span > width*maxBuckets {
+		width = int64(math.Ceil(float64(span)/float64(maxBuckets*minBucketWidth))) * minBucketWidth
+	}
+	buckets := make([]bucket, (last-first)/width+1)
+	for _, s := range series {
+		for _, v := range s.samples {
+			if v.sent == 0 {
+				continue
+			}
+			b := &buckets[(v.sent-first)/width]
+			b.total++
+			if !v.success {
+				b.failure++
+			}
+			if v.responded() {
+				b.latencies = append(b.latencies, v.latency)
+			}
+		}
+	}
+	seconds := float64(width) / float64(minBucketWidth)
+	points := make([]point, len(buckets))
+	for i, b := range buckets {
+		p := point{
+			elapsed: float64(i) * seconds,
+			rps:     float64(b.total) / seconds,
+			eps:     float64(b.failure) / seconds,
+		}
+		if len(b.latencies) > 0 {
+			sort.Slice(b.latencies, func(i, j int) bool { return b.latencies[i] < b.latencies[j] })
+			p.p50 = percentile(b.latencies, 50)
+			p.p90 = percentile(b.latencies, 90)
+			p.p99 = percentile(b.latencies, 99)
+			p.responded = true
+		}
+		points[i] = p
+	}
+	return points, seconds
+}
+
+// percentile returns the nearest rank percentile of the sorted values
+func percentile(sorted []int64, p float64) float64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return float64(sorted[rank-1])
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/ablankz/bloader/internal/encrypt"
 	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/output"
 )
 
 // BaseExecutor represents the base executor
@@ -43,6 +44,7 @@ func (e BaseExecutor) Execute(
 ) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
+	ctx = output.WithLoader(ctx, filename)
 
 	fmt.Printf(
 		"BaseExecutor.Execute: filename=%s, outputRoot=%s, index=%d, callCount=%d\n",
@@ -303,6 +305,43 @@ func (e BaseExecutor) Execute(
 			return fmt.Errorf("failed to execute mass exec: %w", err)
 		}
 		e.Logger.Info(ctx, "executed mass exec")
+	case RunnerKindVirtualUsers:
+		var virtualUsers VirtualUsers
+		decoder := yaml.NewDecoder(&rawData)
+		if err := decoder.Decode(&virtualUsers); err != nil {
+			return fmt.Errorf("failed to decode yaml: %w", err)
+		}
+		var validVirtualUsers ValidVirtualUsers
+		if err := validate(ctx, eventCaster, func() error {
+			if validVirtualUsers, err = virtualUsers.Validate(
+				ctx,
+				e.Logger,
+				e.AuthFactor,
+				e.OutputFactor,
+				e.TargetFactor,
+				tmplStr,
+				data,
+			); err != nil {
+				return fmt.Errorf("failed to validate virtual users: %w", err)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := validVirtualUsers.Run(
+			ctx,
+			e.Logger,
+			outputRoot,
+			e.AuthFactor,
+			e.OutputFactor,
+			e.TargetFactor,
+		); err != nil {
+			if err := wait(ctx, e.Logger, validRunner, RunnerSleepValueAfterFailedExec, filename); err != nil {
+				return fmt.Errorf("failed to wait: %w", err)
+			}
+			return fmt.Errorf("failed to execute virtual users: %w", err)
+		}
+		e.Logger.Info(ctx, "executed virtual users")
 	case RunnerKindSlaveConnect:
 		var slaveConnect SlaveConnect
 		decoder := yaml.NewDecoder(&rawData)
@@ -311,21 +350,23 @@ func (e BaseExecutor) Execute(
 		}
 		var validSlaveConnect ValidSlaveConnect
 		if err := validate(ctx, eventCaster, func() error {
-			if validSlaveConnect, err = slaveConnect.Validate(); err != nil {
+			if validSlaveConnect, err = slaveConnect.Validate(ctx, e.OutputFactor); err != nil {
 				return fmt.Errorf("failed to validate slave connect: %w", err)
 			}
 			return nil
 		}); err != nil {
 			return err
 		}
-		if err := e.SlaveConnectContainer.Connect(
+		slaveIDs, err := e.SlaveConnectContainer.Connect(
 			ctx,
 			e.Logger,
 			e.Env,
 			e.EncryptCtr,
 			validSlaveConnect,
+			outputRoot,
 			eventCaster,
-		); err != nil {
+		)
+		if err != nil {
 			if err := wait(ctx, e.Logger, validRunner, RunnerSleepValueAfterFailedExec, filename); err != nil {
 				return fmt.Errorf("failed to wait: %w", err)
 			}
@@ -333,13 +374,13 @@ func (e BaseExecutor) Execute(
 		}
 		var atomicErr atomic.Pointer[syncError]
 		var wg sync.WaitGroup
-		for _, slave := range validSlaveConnect.Slaves {
+		for _, slaveID := range slaveIDs {
 			wg.Add(1)
-			mapData, ok := e.SlaveConnectContainer.Find(slave.ID)
+			mapData, ok := e.SlaveConnectContainer.Find(slaveID)
 			if !ok {
-				return fmt.Errorf("failed to find slave: %s", slave.ID)
+				return fmt.Errorf("failed to find slave: %s", slaveID)
 			}
-			slHandler := NewSlaveRequestHandler(mapData.ReqChan, mapData.Cli, mapData.ReceiveTermChan)
+			slHandler := NewSlaveRequestHandler(mapData)
 			go func(slaveHandler *SlaveRequestHandler) {
 				defer wg.Done()
 				if err := slaveHandler.HandleResponse(
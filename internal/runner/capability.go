@@ -0,0 +1,132 @@
+package runner
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
+)
+
+// ProtocolVersion represents the version of the protocol between the master and the slave.
+// It is incremented when the master and the slave built from different versions cannot work together.
+const ProtocolVersion = 1
+
+// BuildVersion represents the version of the build exchanged on the connection, set by the command
+var BuildVersion = "dev"
+
+// Feature represents an optional feature of the protocol between the master and the slave
+type Feature string
+
+const (
+	// FeatureHeartbeat represents the heartbeat of the slave
+	FeatureHeartbeat Feature = "heartbeat"
+	// FeatureLiveMetrics represents the live metrics streamed from the slave
+	FeatureLiveMetrics Feature = "liveMetrics"
+	// FeatureSyncStart represents the synchronized start of the slave command
+	FeatureSyncStart Feature = "syncStart"
+	// FeatureCancelCommand represents the graceful cancellation of the slave command
+	FeatureCancelCommand Feature = "cancelCommand"
+	// FeatureResources represents the resource usage streamed from the slave
+	FeatureResources Feature = "resources"
+	// FeatureTypedOutput represents the http data sent with the types of the values
+	FeatureTypedOutput Feature = "typedOutput"
+)
+
+// Capabilities represents the version, the runner kinds, the exec types and the features supported by a build
+type Capabilities struct {
+	BuildVersion    string
+	ProtocolVersion int32
+	RunnerKinds     []string
+	ExecTypes       []string
+	Features        []string
+}
+
+// LocalCapabilities returns the capabilities of this build
+func LocalCapabilities() Capabilities {
+	return Capabilities{
+		BuildVersion:    BuildVersion,
+		ProtocolVersion: ProtocolVersion,
+		RunnerKinds: []string{
+			string(RunnerKindStoreValue),
+			string(RunnerKindMemoryValue),
+			string(RunnerKindStoreImport),
+			string(RunnerKindOneExecute),
+			string(RunnerKindMassExecute),
+			string(RunnerKindVirtualUsers),
+			string(RunnerKindFlow),
+			string(RunnerKindSlaveConnect),
+		},
+		ExecTypes: []string{
+			string(MassExecTypeHTTP),
+			string(MassExecTypeGRPC),
+			string(MassExecTypeWebSocket),
+			string(MassExecTypeSocket),
+		},
+		Features: []string{
+			string(FeatureHeartbeat),
+			string(FeatureLiveMetrics),
+			string(FeatureSyncStart),
+			string(FeatureCancelCommand),
+			string(FeatureResources),
+			string(FeatureTypedOutput),
+		},
+	}
+}
+
+// NewCapabilitiesFromProto creates the capabilities from the proto, the second value is false
+// when the peer does not report them
+func NewCapabilitiesFromProto(c *pb.Capabilities) (Capabilities, bool) {
+	if c == nil {
+		return Capabilities{}, false
+	}
+	return Capabilities{
+		BuildVersion:    c.BuildVersion,
+		ProtocolVersion: c.ProtocolVersion,
+		RunnerKinds:     c.RunnerKinds,
+		ExecTypes:       c.ExecTypes,
+		Features:        c.Features,
+	}, true
+}
+
+// ToProto converts the capabilities to the proto
+func (c Capabilities) ToProto() *pb.Capabilities {
+	return &pb.Capabilities{
+		BuildVersion:    c.BuildVersion,
+		ProtocolVersion: c.ProtocolVersion,
+		RunnerKinds:     c.RunnerKinds,
+		ExecTypes:       c.ExecTypes,
+		Features:        c.Features,
+	}
+}
+
+// Compatibility compares the capabilities of the peer with c.
+// The problems make the peer unable to run what c runs, and the notes are the differences c can work with.
+func (c Capabilities) Compatibility(peer Capabilities) (problems []string, notes []string) {
+	missing := func(local, remote []string) []string {
+		var m []string
+		for _, v := range local {
+			if !slices.Contains(remote, v) {
+				m = append(m, v)
+			}
+		}
+		return m
+	}
+	if c.ProtocolVersion != peer.ProtocolVersion {
+		problems = append(problems,
+			fmt.Sprintf("protocol version %d does not match %d", peer.ProtocolVersion, c.ProtocolVersion))
+	}
+	if m := missing(c.RunnerKinds, peer.RunnerKinds); len(m) > 0 {
+		problems = append(problems, fmt.Sprintf("runner kinds %s are not supported", strings.Join(m, ", ")))
+	}
+	if m := missing(c.ExecTypes, peer.ExecTypes); len(m) > 0 {
+		problems = append(problems, fmt.Sprintf("exec types %s are not supported", strings.Join(m, ", ")))
+	}
+	if m := missing(c.Features, peer.Features); len(m) > 0 {
+		notes = append(notes, fmt.Sprintf("features %s are not supported", strings.Join(m, ", ")))
+	}
+	if c.BuildVersion != peer.BuildVersion {
+		notes = append(notes, fmt.Sprintf("build version %s differs from %s", peer.BuildVersion, c.BuildVersion))
+	}
+	return problems, notes
+}
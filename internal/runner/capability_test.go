@@ -0,0 +1,81 @@
+package runner_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ablankz/bloader/internal/runner"
+)
+
+// TestCapabilitiesCompatibility tests the problems and the notes of the peer capabilities.
+func TestCapabilitiesCompatibility(t *testing.T) {
+	local := runner.Capabilities{
+		BuildVersion:    "v1.0.0",
+		ProtocolVersion: 1,
+		RunnerKinds:     []string{"MassExecute", "OneExecute"},
+		ExecTypes:       []string{"http"},
+		Features:        []string{"thresholds", "syncStart"},
+	}
+	tests := []struct {
+		name         string
+		peer         func(c runner.Capabilities) runner.Capabilities
+		wantProblems []string
+		wantNotes    []string
+	}{
+		{
+			name: "Same",
+			peer: func(c runner.Capabilities) runner.Capabilities { return c },
+		},
+		{
+			name: "PeerHasMore",
+			peer: func(c runner.Capabilities) runner.Capabilities {
+				c.RunnerKinds = []string{"MassExecute", "OneExecute", "Flow"}
+				c.Features = []string{"thresholds", "syncStart", "split"}
+				return c
+			},
+		},
+		{
+			name: "ProtocolMismatch",
+			peer: func(c runner.Capabilities) runner.Capabilities {
+				c.ProtocolVersion = 2
+				return c
+			},
+			wantProblems: []string{"protocol version 2 does not match 1"},
+		},
+		{
+			name: "MissingRunnerKindsAndExecTypes",
+			peer: func(c runner.Capabilities) runner.Capabilities {
+				c.RunnerKinds = []string{}
+				c.ExecTypes = nil
+				return c
+			},
+			wantProblems: []string{
+				"runner kinds MassExecute, OneExecute are not supported",
+				"exec types http are not supported",
+			},
+		},
+		{
+			name: "MissingFeatureAndOtherBuild",
+			peer: func(c runner.Capabilities) runner.Capabilities {
+				c.BuildVersion = "v0.9.0"
+				c.Features = []string{"thresholds"}
+				return c
+			},
+			wantNotes: []string{
+				"features syncStart are not supported",
+				"build version v0.9.0 differs from v1.0.0",
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			problems, notes := local.Compatibility(tc.peer(local))
+			if !reflect.DeepEqual(problems, tc.wantProblems) {
+				tt.Errorf("expected problems %q, got %q", tc.wantProblems, problems)
+			}
+			if !reflect.DeepEqual(notes, tc.wantNotes) {
+				tt.Errorf("expected notes %q, got %q", tc.wantNotes, notes)
+			}
+		})
+	}
+}
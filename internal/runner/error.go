@@ -1,5 +1,10 @@
 package runner
 
+import "errors"
+
 type syncError struct {
 	Err error
 }
+
+// ErrThresholdViolated is returned when the thresholds are not satisfied
+var ErrThresholdViolated = errors.New("threshold violated")
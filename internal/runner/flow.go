@@ -8,9 +8,9 @@ import (
 	"io"
 	"sync"
 	"sync/atomic"
+	"time"
 
-	pb "buf.build/gen/go/cresplanex/bloader/protocolbuffers/go/cresplanex/bloader/v1"
-
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
 	"github.com/ablankz/bloader/internal/encrypt"
 	"github.com/ablankz/bloader/internal/logger"
 	"github.com/ablankz/bloader/internal/output"
@@ -19,21 +19,27 @@ import (
 
 // Flow represents the flow runner
 type Flow struct {
-	Step FlowStep `yaml:"step"`
+	Thresholds []string `yaml:"thresholds"`
+	Step       FlowStep `yaml:"step"`
 }
 
 // ValidFlow represents a valid flow runner
 type ValidFlow struct {
-	Step ValidFlowStep
+	Thresholds ValidThresholds
+	Step       ValidFlowStep
 }
 
 // Validate validates a flow runner
 func (r Flow) Validate() (ValidFlow, error) {
+	validThresholds, err := NewValidThresholds(r.Thresholds)
+	if err != nil {
+		return ValidFlow{}, fmt.Errorf("failed to validate thresholds: %w", err)
+	}
 	validFlowStep, err := r.Step.Validate()
 	if err != nil {
 		return ValidFlow{}, err
 	}
-	return ValidFlow{Step: validFlowStep}, nil
+	return ValidFlow{Thresholds: validThresholds, Step: validFlowStep}, nil
 }
 
 // FlowStep represents a flow step
@@ -127,6 +133,8 @@ type FlowStepFlow struct {
 	Flows            []FlowStepFlow          `yaml:"flows"`
 	Concurrency      *int                    `yaml:"concurrency"`
 	Executors        []FlowStepFlowExecutor  `yaml:"executors"`
+	SyncStart        FlowStepFlowSyncStart   `yaml:"sync_start"`
+	Split            FlowStepFlowSplit       `yaml:"split"`
 }
 
 // ValidFlowStepFlow represents a valid flow step flow
@@ -142,6 +150,8 @@ type ValidFlowStepFlow struct {
 	Flows            []ValidFlowStepFlow
 	Concurrency      int
 	Executors        []ValidFlowStepFlowExecutor
+	SyncStart        ValidFlowStepFlowSyncStart
+	Split            ValidFlowStepFlowSplit
 	waitFunc         func(ctx context.Context) error
 }
 
@@ -178,6 +188,7 @@ type FlowStepFlowExecutor struct {
 	InheritValues              bool                       `yaml:"inherit_values"`
 	AdditionalValues           []FlowStepFlowValue        `yaml:"additional_values"`
 	AdditionalThreadOnlyValues []FlowStepFlowValue        `yaml:"additional_thread_only_values"`
+	Weight                     *float64                   `yaml:"weight"`
 }
 
 // ValidFlowStepFlowExecutor represents a valid flow step flow executor
@@ -187,6 +198,7 @@ type ValidFlowStepFlowExecutor struct {
 	InheritValues              bool
 	AdditionalValues           []ValidFlowStepFlowValue
 	AdditionalThreadOnlyValues []ValidFlowStepFlowValue
+	Weight                     float64
 }
 
 // Validate validates a flow step flow executor
@@ -219,6 +231,13 @@ func (r FlowStepFlowExecutor) Validate() (ValidFlowStepFlowExecutor, error) {
 			valValue,
 		)
 	}
+	validFlowStepFlowExecutor.Weight = DefaultExecutorWeight
+	if r.Weight != nil {
+		if *r.Weight <= 0 {
+			return ValidFlowStepFlowExecutor{}, fmt.Errorf("weight must be greater than 0")
+		}
+		validFlowStepFlowExecutor.Weight = *r.Weight
+	}
 	return validFlowStepFlowExecutor, nil
 }
 
@@ -303,6 +322,16 @@ func (f FlowStepFlow) Validate(valid *ValidFlowStepFlow, idSet map[string]struct
 			}
 			valid.Executors = append(valid.Executors, validExecutor)
 		}
+		validSyncStart, err := f.SyncStart.Validate()
+		if err != nil {
+			return fmt.Errorf("failed to validate sync_start: %w", err)
+		}
+		valid.SyncStart = validSyncStart
+		validSplit, err := f.Split.Validate()
+		if err != nil {
+			return fmt.Errorf("failed to validate split: %w", err)
+		}
+		valid.Split = validSplit
 	case FlowStepFlowTypeFlow:
 		valid.Type = FlowStepFlowType(*f.Type)
 		if f.Concurrency == nil {
@@ -346,6 +375,7 @@ type flowExecutor struct {
 	waitFunc        func(ctx context.Context) error
 	castFunc        func(ctx context.Context) error
 	eventCaster     *utils.Broadcaster[Event]
+	flowID          string
 }
 
 type closer func() error
@@ -457,7 +487,12 @@ func (f *ValidFlow) Run(
 	if err := attachWaitChan(f.Step.Flows, broadCastMap); err != nil {
 		return err
 	}
-	return run(
+	var stats *ExecStats
+	if len(f.Thresholds) > 0 {
+		stats = NewExecStats()
+		ctx = WithStatsCollector(ctx, stats)
+	}
+	if err := run(
 		ctx,
 		env,
 		log,
@@ -475,7 +510,17 @@ func (f *ValidFlow) Run(
 		f.Step.Concurrency,
 		slaveValues,
 		broadCastMap,
-	)
+	); err != nil {
+		return err
+	}
+	if stats == nil {
+		return nil
+	}
+	violations := f.Thresholds.Evaluate(string(RunnerKindFlow), stats.Summary("flow"))
+	if err := reportThresholdViolations(ctx, violations); err != nil {
+		return fmt.Errorf("failed to satisfy thresholds: %w", err)
+	}
+	return nil
 }
 
 func run(
@@ -551,6 +596,7 @@ func run(
 					waitFunc:        flow.waitFunc,
 					castFunc:        castFunc,
 					eventCaster:     caster,
+					flowID:          flow.ID,
 				}
 				count++
 			}
@@ -575,6 +621,7 @@ func run(
 				waitFunc:        flow.waitFunc,
 				castFunc:        castFunc,
 				eventCaster:     caster,
+				flowID:          flow.ID,
 			}
 			count++
 		}
@@ -596,6 +643,7 @@ func run(
 					logger.Value("error", err), logger.Value("on", "Flow"))
 				return fmt.Errorf("failed to wait: %w", err)
 			}
+			ctx := output.WithFlowID(ctx, executor.flowID)
 			switch executor.flowType {
 			case FlowStepFlowTypeFile:
 				baseExecutor := BaseExecutor{
@@ -704,6 +752,7 @@ func run(
 
 				sem <- struct{}{}
 
+				ctx := output.WithFlowID(ctx, preExecutor.flowID)
 				switch preExecutor.flowType {
 				case FlowStepFlowTypeFile:
 					baseExecutor := BaseExecutor{
@@ -815,6 +864,7 @@ func slaveCmdRun(
 	outputRoot string,
 	f ValidFlowStepFlow,
 ) error {
+	ctx = output.WithLoader(ctx, f.File)
 	globalStr := make(map[string]any)
 	threadOnlyStr := make(map[string]any)
 	str.Range(func(key, value any) bool {
@@ -827,15 +877,49 @@ func slaveCmdRun(
 	for _, v := range f.ThreadOnlyValues {
 		threadOnlyStr[v.Key] = v.Value
 	}
-	slaveExecutors := make([]slaveExecutor, len(f.Executors))
+	type slaveTarget struct {
+		index   int
+		exec    ValidFlowStepFlowExecutor
+		mapData *ConnectionMapData
+	}
+	targets := make([]slaveTarget, 0, len(f.Executors))
 	for i, exec := range f.Executors {
-		slaveID := exec.SlaveID
-		mapData, ok := slaveConCtr.Find(slaveID)
+		mapData, ok := slaveConCtr.Find(exec.SlaveID)
 		if !ok {
-			log.Error(ctx, fmt.Sprintf("failed to find slave: %s", slaveID),
+			log.Error(ctx, fmt.Sprintf("failed to find slave: %s", exec.SlaveID),
 				logger.Value("on", "Flow"))
-			return fmt.Errorf("failed to find slave: %s", slaveID)
+			return fmt.Errorf("failed to find slave: %s", exec.SlaveID)
+		}
+		if health := mapData.Health(); health.State == SlaveHealthStateLost {
+			if mapData.FailurePolicy() == SlaveFailurePolicyContinue {
+				log.Warn(ctx, fmt.Sprintf("skip the lost slave: %s", exec.SlaveID),
+					logger.Value("lastError", health.LastError), logger.Value("on", "Flow"))
+				continue
+			}
+			return fmt.Errorf("slave is lost: %s", exec.SlaveID)
+		}
+		targets = append(targets, slaveTarget{index: i, exec: exec, mapData: mapData})
+	}
+	var splitValues []map[string]any
+	if f.Split.Enabled {
+		weights := make([]float64, len(targets))
+		for i, t := range targets {
+			switch f.Split.By {
+			case SplitByCapacity:
+				weights[i] = float64(t.mapData.SlaveCapacity())
+			default:
+				weights[i] = t.exec.Weight
+			}
 		}
+		splitValues = f.Split.Shares(weights)
+	}
+
+	slaveExecutors := make([]slaveExecutor, 0, len(targets))
+	for ti, target := range targets {
+		i, exec, mapData := target.index, target.exec, target.mapData
+		slaveID := exec.SlaveID
+		connectionID := mapData.ConnectionID()
+		sessionDone := mapData.SessionDone()
 		if exec.InheritValues {
 			str.Range(func(key, value any) bool {
 				if keyStr, ok := key.(string); ok {
@@ -858,8 +942,15 @@ func slaveCmdRun(
 			"SlaveID": slaveID,
 			"Index":   i,
 		}
+		if splitValues != nil {
+			for k, v := range splitValues[ti] {
+				slaveValuesMap[k] = v
+			}
+			log.Info(ctx, fmt.Sprintf("split values for slave: %s", slaveID),
+				logger.Value("values", splitValues[ti]), logger.Value("on", "Flow"))
+		}
 		res, err := mapData.Cli.SlaveCommand(ctx, &pb.SlaveCommandRequest{
-			ConnectionId: mapData.ConnectionID,
+			ConnectionId: connectionID,
 			LoaderId:     f.File,
 			OutputRoot:   oRoot,
 		})
@@ -887,7 +978,7 @@ func slaveCmdRun(
 				end = len(defaultStrBytes)
 			}
 			if err := stream.Send(&pb.SlaveCommandDefaultStoreRequest{
-				ConnectionId: mapData.ConnectionID,
+				ConnectionId: connectionID,
 				CommandId:    res.CommandId,
 				StoreType:    pb.SlaveCommandDefaultStoreType_SLAVE_COMMAND_DEFAULT_STORE_TYPE_STORE,
 				DefaultStore: defaultStrBytes[i:end],
@@ -910,7 +1001,7 @@ func slaveCmdRun(
 				end = len(defaultThreadOnlyStrBytes)
 			}
 			if err := stream.Send(&pb.SlaveCommandDefaultStoreRequest{
-				ConnectionId: mapData.ConnectionID,
+				ConnectionId: connectionID,
 				CommandId:    res.CommandId,
 				StoreType:    pb.SlaveCommandDefaultStoreType_SLAVE_COMMAND_DEFAULT_STORE_TYPE_THREAD_ONLY_STORE,
 				DefaultStore: defaultThreadOnlyStrBytes[i:end],
@@ -933,7 +1024,7 @@ func slaveCmdRun(
 				end = len(defaultSlaveValuesStrBytes)
 			}
 			if err := stream.Send(&pb.SlaveCommandDefaultStoreRequest{
-				ConnectionId: mapData.ConnectionID,
+				ConnectionId: connectionID,
 				CommandId:    res.CommandId,
 				StoreType:    pb.SlaveCommandDefaultStoreType_SLAVE_COMMAND_DEFAULT_STORE_TYPE_SLAVE_VALUES,
 				DefaultStore: defaultSlaveValuesStrBytes[i:end],
@@ -950,12 +1041,22 @@ func slaveCmdRun(
 			return fmt.Errorf("failed to receive slave command default store response: %w", err)
 		}
 
-		slaveExecutors[i] = slaveExecutor{
+		slaveExecutors = append(slaveExecutors, slaveExecutor{
 			slaveID:       slaveID,
 			cmdID:         res.CommandId,
+			connectionID:  connectionID,
+			sessionDone:   sessionDone,
 			mapData:       mapData,
 			outputEnabled: exec.Output.Enabled,
 			outFactor:     outFactor,
+		})
+	}
+
+	if f.SyncStart.Enabled && len(slaveExecutors) > 1 {
+		if err := syncStart(ctx, log, f.SyncStart, slaveExecutors); err != nil {
+			log.Error(ctx, "failed to synchronize start",
+				logger.Value("error", err), logger.Value("on", "Flow"))
+			return fmt.Errorf("failed to synchronize start: %w", err)
 		}
 	}
 
@@ -989,11 +1090,16 @@ func slaveCmdRun(
 }
 
 type slaveExecutor struct {
-	slaveID       string
-	cmdID         string
+	slaveID      string
+	cmdID        string
+	connectionID string
+	// sessionDone is closed when the session the command runs on is abandoned
+	sessionDone   <-chan struct{}
 	mapData       *ConnectionMapData
 	outputEnabled bool
 	outFactor     OutputFactor
+	// startAt is the start time of the command on the clock of the slave, zero to start on arrival
+	startAt time.Time
 }
 
 // exec executes a slave command
@@ -1001,9 +1107,35 @@ func (e slaveExecutor) exec(
 	ctx context.Context,
 	log logger.Logger,
 ) error {
+	parentCtx := ctx
+	// the command outlives the cancellation of the master, so that the slave stops it gracefully
+	// and the output sent during the shutdown is still written
+	ctx, cancel := context.WithCancel(context.WithoutCancel(parentCtx))
+	defer cancel()
+	go func() {
+		select {
+		case <-e.sessionDone:
+			cancel()
+		case <-parentCtx.Done():
+			reason := fmt.Sprintf("master is canceled: %v", context.Cause(parentCtx))
+			if err := e.cancelCommand(ctx, reason); err != nil {
+				log.Error(ctx, "failed to cancel command, abort it",
+					logger.Value("error", err), logger.Value("slaveID", e.slaveID), logger.Value("on", "Flow"))
+				cancel()
+			}
+		case <-ctx.Done():
+		}
+	}()
+
+	var startAt int64
+	if !e.startAt.IsZero() {
+		startAt = e.startAt.UnixNano()
+	}
 	stream, err := e.mapData.Cli.CallExec(ctx, &pb.CallExecRequest{
-		ConnectionId: e.mapData.ConnectionID,
-		CommandId:    e.cmdID,
+		ConnectionId:    e.connectionID,
+		CommandId:       e.cmdID,
+		StartAtUnixNano: startAt,
+		FlowId:          output.SourceFrom(ctx).FlowID,
 	})
 	if err != nil {
 		log.Error(ctx, "failed to call exec",
@@ -1068,7 +1200,7 @@ func (e slaveExecutor) exec(
 				httpOut := res.GetOutputHttp()
 				if isFirst {
 					httpDataWriter, closer, err := output.HTTPDataWriteFactory(
-						ctx,
+						withSlaveOutputSource(ctx, e.slaveID, httpOut.GetSource()),
 						log,
 						true,
 						res.OutputRoot,
@@ -1092,7 +1224,7 @@ func (e slaveExecutor) exec(
 				if err := writerData.httpDataWriter(
 					ctx,
 					log,
-					httpOut.Data,
+					OutputValuesFromProto(httpOut),
 				); err != nil {
 					log.Error(ctx, "failed to write http data",
 						logger.Value("error", err), logger.Value("on", "Flow"))
@@ -1102,6 +1234,21 @@ func (e slaveExecutor) exec(
 					}
 					return
 				}
+			case pb.CallExecOutputType_CALL_EXEC_OUTPUT_TYPE_SUMMARY:
+				if err := output.SummaryWrite(
+					ctx,
+					log,
+					res.OutputRoot,
+					res.GetOutputSummary().GetData(),
+				); err != nil {
+					log.Error(ctx, "failed to write summary",
+						logger.Value("error", err), logger.Value("on", "Flow"))
+					if err := stream.CloseSend(); err != nil {
+						log.Error(ctx, "failed to close send",
+							logger.Value("error", err), logger.Value("on", "Flow"))
+					}
+					return
+				}
 			case pb.CallExecOutputType_CALL_EXEC_OUTPUT_TYPE_UNSPECIFIED:
 				log.Error(ctx, "invalid output type",
 					logger.Value("on", "Flow"))
@@ -1122,19 +1269,63 @@ func (e slaveExecutor) exec(
 		}
 	}()
 	termRes, err := e.mapData.Cli.ReceiveLoadTermChannel(ctx, &pb.ReceiveLoadTermChannelRequest{
-		ConnectionId: e.mapData.ConnectionID,
+		ConnectionId: e.connectionID,
 		CommandId:    e.cmdID,
 	})
+	select {
+	case <-e.sessionDone:
+		if e.mapData.FailurePolicy() == SlaveFailurePolicyContinue {
+			log.Warn(ctx, "command is aborted, the connection to slave is lost",
+				logger.Value("slaveID", e.slaveID), logger.Value("on", "Flow"))
+			return nil
+		}
+		return fmt.Errorf("command is aborted, the connection to slave is lost: %s", e.slaveID)
+	default:
+	}
 	if err != nil {
 		log.Error(ctx, "failed to receive term channel",
 			logger.Value("error", err), logger.Value("on", "Flow"))
 		return fmt.Errorf("failed to receive term channel: %w", err)
 	}
+	if len(termRes.Stats) > 0 {
+		stats := NewExecStats()
+		if err := json.Unmarshal(termRes.Stats, stats); err != nil {
+			log.Error(ctx, "failed to unmarshal stats of slave",
+				logger.Value("slaveID", e.slaveID), logger.Value("error", err), logger.Value("on", "Flow"))
+		} else {
+			collectStats(ctx, stats)
+		}
+	}
+	switch termRes.Reason {
+	case pb.CommandTermReason_COMMAND_TERM_REASON_CANCELED:
+		log.Info(ctx, fmt.Sprintf("slave %s stopped cleanly", e.slaveID),
+			logger.Value("reason", termRes.Message), logger.Value("on", "Flow"))
+		return fmt.Errorf("command is canceled on slave %s: %s", e.slaveID, termRes.Message)
+	case pb.CommandTermReason_COMMAND_TERM_REASON_FORCED:
+		log.Warn(ctx, fmt.Sprintf("slave %s was forced to stop after the grace period", e.slaveID),
+			logger.Value("reason", termRes.Message), logger.Value("on", "Flow"))
+		return fmt.Errorf("command is forced to stop on slave %s: %s", e.slaveID, termRes.Message)
+	}
 	if !termRes.Success {
 		log.Error(ctx, "failed to receive term channel",
-			logger.Value("on", "Flow"))
+			logger.Value("message", termRes.Message), logger.Value("on", "Flow"))
 		return fmt.Errorf("failed to receive term channel: %s", e.slaveID)
 	}
 
 	return nil
 }
+
+// cancelCommand cancels the command on the slave, and waits until the slave stops it
+func (e slaveExecutor) cancelCommand(ctx context.Context, reason string) error {
+	ctx, cancel := context.WithTimeout(ctx, e.mapData.cancelGrace+cancelCommandTimeoutMargin)
+	defer cancel()
+	if _, err := e.mapData.Cli.CancelCommand(ctx, &pb.CancelCommandRequest{
+		ConnectionId:  e.connectionID,
+		CommandId:     e.cmdID,
+		Reason:        reason,
+		GracePeriodMs: e.mapData.cancelGrace.Milliseconds(),
+	}); err != nil {
+		return fmt.Errorf("failed to cancel command: %w", err)
+	}
+	return nil
+}
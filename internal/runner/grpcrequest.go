@@ -0,0 +1,147 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ablankz/bloader/internal/auth"
+	"github.com/ablankz/bloader/internal/executor/grpcexec"
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+// GRPCDescriptor represents the descriptor source of the gRPC request.
+// The server reflection is used when neither proto_files nor descriptor_set is specified.
+type GRPCDescriptor struct {
+	ProtoFiles    []string `yaml:"proto_files"`
+	ImportPaths   []string `yaml:"import_paths"`
+	DescriptorSet *string  `yaml:"descriptor_set"`
+}
+
+// ValidGRPCDescriptor represents the valid descriptor source of the gRPC request
+type ValidGRPCDescriptor struct {
+	ProtoFiles    []string
+	ImportPaths   []string
+	DescriptorSet string
+}
+
+// Validate validates the GRPCDescriptor
+func (d GRPCDescriptor) Validate() (ValidGRPCDescriptor, error) {
+	var valid ValidGRPCDescriptor
+	if d.DescriptorSet != nil {
+		if len(d.ProtoFiles) > 0 {
+			return ValidGRPCDescriptor{}, fmt.Errorf("descriptor_set cannot be specified with proto_files")
+		}
+		valid.DescriptorSet = *d.DescriptorSet
+	}
+	if len(d.ImportPaths) > 0 && len(d.ProtoFiles) == 0 {
+		return ValidGRPCDescriptor{}, fmt.Errorf("import_paths requires proto_files")
+	}
+	valid.ProtoFiles = d.ProtoFiles
+	valid.ImportPaths = d.ImportPaths
+	return valid, nil
+}
+
+// Source creates the descriptor source
+func (d ValidGRPCDescriptor) Source(
+	ctx context.Context,
+	conn grpc.ClientConnInterface,
+) (grpcexec.DescriptorSource, error) {
+	switch {
+	case d.DescriptorSet != "":
+		return grpcexec.NewDescriptorSetSource(d.DescriptorSet)
+	case len(d.ProtoFiles) > 0:
+		return grpcexec.NewProtoFileSource(ctx, d.ProtoFiles, d.ImportPaths)
+	}
+	return grpcexec.NewReflectionSource(conn), nil
+}
+
+// GRPCRequest represents the gRPC request
+type GRPCRequest struct {
+	Service      string
+	Method       string
+	Headers      map[string]any // map[string]any or map[string][]any, sent as the metadata
+	Body         any
+	Auth         auth.SetAuthor
+	TmplStr      string
+	ReplaceData  *sync.Map
+	OutputFactor OutputFactor
+	AuthFactor   AuthenticatorFactor
+	TargetFactor TargetFactor
+	IsMass       bool
+	ReqIndex     int
+}
+
+// CreateRequest creates the grpcexec.Request object for the call
+func (r GRPCRequest) CreateRequest(
+	ctx context.Context,
+	log logger.Logger,
+	count, stage int,
+) (*grpcexec.Request, error) {
+	if r.IsMass {
+		replaceData, _ := newDynamicReplaceData(r.ReplaceData, count, stage)
+		buffer, err := executeTmpl(r.TmplStr, replaceData)
+		if err != nil {
+			return nil, err
+		}
+		var massExec MassExec
+		if err := yaml.Unmarshal(buffer, &massExec); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal json: %w", err)
+		}
+		validMassExec, err := massExec.Validate(
+			ctx,
+			log,
+			r.AuthFactor,
+			r.OutputFactor,
+			r.TargetFactor,
+			r.TmplStr,
+			replaceData,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to validate mass exec: %w", err)
+		}
+		request := validMassExec.Requests[r.ReqIndex]
+
+		r.Service = request.Service
+		r.Method = request.Method
+		r.Headers = request.Headers
+		r.Body = request.Body
+	}
+
+	md := metadata.MD{}
+	if r.Auth != nil {
+		// the authenticators set the credentials on the http header, so they are copied into the metadata
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create auth carrier: %w", err)
+		}
+		r.Auth.SetOnRequest(ctx, req)
+		for key, values := range req.Header {
+			md.Append(strings.ToLower(key), values...)
+		}
+	}
+	for key, value := range r.Headers {
+		if arr, ok := value.([]any); ok {
+			for _, v := range arr {
+				md.Append(key, fmt.Sprint(v))
+			}
+			continue
+		}
+		md.Set(key, fmt.Sprint(value))
+	}
+
+	return &grpcexec.Request{
+		Service:  r.Service,
+		Method:   r.Method,
+		Body:     r.Body,
+		Metadata: md,
+	}, nil
+}
+
+var _ grpcexec.ExecReq = (*GRPCRequest)(nil)
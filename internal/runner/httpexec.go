@@ -10,6 +10,7 @@ import (
 
 	"github.com/ablankz/bloader/internal/executor/httpexec"
 	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/metrics"
 	"github.com/ablankz/bloader/internal/runner/matcher"
 )
 
@@ -21,17 +22,95 @@ type WriteData struct {
 	Count            int
 	ResponseTime     int
 	StatusCode       string
-	RawData          any
+	Stage            int
+	Operation        string
+	// Event is set for each event of the stream response types
+	Event   *EventWriteData
+	RawData any
 }
 
-// ToSlice converts WriteData to slice
-func (d WriteData) ToSlice() []string {
-	return []string{
-		strconv.FormatBool(d.Success),
+// EventWriteData represents the event columns of the stream response types
+type EventWriteData struct {
+	Index int
+	Name  string
+	// Time is the elapsed time from sending the request in milliseconds
+	Time int
+	// Gap is the time from the previous event in milliseconds, 0 for the first event
+	Gap int
+}
+
+// ToSlice converts EventWriteData to slice, null columns for nil
+func (d *EventWriteData) ToSlice() []any {
+	if d == nil {
+		return []any{nil, nil, nil, nil}
+	}
+	return []any{
+		d.Index,
+		d.Name,
+		d.Time,
+		d.Gap,
+	}
+}
+
+// responseRecord represents the body written and matched for the response
+type responseRecord struct {
+	body  any
+	event *EventWriteData
+}
+
+// newResponseRecords returns the records of the response.
+// For the stream response types, a record is returned for each event delivered as it is read,
+// and an empty record for the end of the stream if there is no event.
+func newResponseRecords(ctx context.Context, log logger.Logger, v httpexec.ResponseContent) []responseRecord {
+	if v.Event != nil {
+		return []responseRecord{{
+			body: v.Event.Event.Data,
+			event: &EventWriteData{
+				Index: v.Event.Index,
+				Name:  v.Event.Event.Name,
+				Time:  int(v.Event.Event.ReceivedTime.Sub(v.StartTime).Milliseconds()),
+				Gap:   int(v.Event.Gap.Milliseconds()),
+			},
+		}}
+	}
+	if v.Stream == nil {
+		var response any
+		if err := json.Unmarshal(v.ByteResponse, &response); err != nil {
+			log.Error(ctx, "The response is not a valid JSON",
+				logger.Value("error", err), logger.Value("on", "runResponseHandler"), logger.Value("count", v.Count))
+		}
+		return []responseRecord{{body: response}}
+	}
+	if len(v.Stream.Events) == 0 {
+		return []responseRecord{{}}
+	}
+	return nil
+}
+
+// newWriteData creates the WriteData of the record of the response
+func newWriteData(v httpexec.ResponseContent, record responseRecord) WriteData {
+	return WriteData{
+		Success:          v.Success,
+		SendDatetime:     v.StartTime.Format(time.RFC3339Nano),
+		ReceivedDatetime: v.EndTime.Format(time.RFC3339Nano),
+		Count:            v.Count,
+		ResponseTime:     int(v.ResponseTime),
+		StatusCode:       strconv.Itoa(v.StatusCode),
+		Stage:            v.Stage,
+		Operation:        v.Operation,
+		Event:            record.event,
+		RawData:          record.body,
+	}
+}
+
+// ToSlice converts WriteData to slice, keeping the types of the values
+func (d WriteData) ToSlice() []any {
+	return []any{
+		d.Success,
 		d.SendDatetime,
 		d.ReceivedDatetime,
-		strconv.Itoa(d.Count),
-		strconv.Itoa(d.ResponseTime),
+		d.Count,
+		d.ResponseTime,
 		d.StatusCode,
 	}
 }
@@ -60,13 +139,30 @@ func runResponseHandler(
 	uidChan <-chan uuid.UUID,
 	resChan <-chan httpexec.ResponseContent,
 	writeChan chan<- writeSendData,
+	stats *ExecStats,
 ) {
 	defer close(termChan)
+	live := liveMetricsFrom(ctx)
+	m := metrics.From(ctx)
 	var timeout <-chan time.Time
 	if request.Break.Time.Enabled && request.Break.Time.Time > 0 {
 		timeout = time.After(request.Break.Time.Time)
 	}
 	sentUID := make(map[uuid.UUID]struct{})
+	// streams is the last event of the stream responses of which the end is not delivered yet, keyed by the count
+	streams := make(map[int]httpexec.ResponseContent)
+	record := func(v httpexec.ResponseContent) {
+		stats.Record(v)
+		live.Record(v)
+		m.Record(request.TargetID, request.Endpoint, v)
+	}
+	// recordStopped records the stream responses stopped by the termination up to their last event
+	recordStopped := func() {
+		for count, v := range streams {
+			record(v)
+			delete(streams, count)
+		}
+	}
 	for {
 		select {
 		case uid := <-uidChan:
@@ -114,6 +210,7 @@ func runResponseHandler(
 				}
 				return
 			}
+			recordStopped()
 			log.Info(ctx, "Term Condition: Time",
 				logger.Value("id", id), logger.Value("on", "runResponseHandler"))
 			select {
@@ -148,6 +245,7 @@ func runResponseHandler(
 				}
 				return
 			}
+			recordStopped()
 			log.Info(ctx, "Term Condition: Context Done",
 				logger.Value("id", id), logger.Value("on", "runResponseHandler"))
 			select {
@@ -157,13 +255,18 @@ func runResponseHandler(
 			}
 			return
 		case v := <-resChan:
-			mustWrite := true
-			var response any
-			err := json.Unmarshal(v.ByteResponse, &response)
-			if err != nil {
-				log.Error(ctx, "The response is not a valid JSON",
-					logger.Value("error", err), logger.Value("on", "runResponseHandler"), logger.Value("count", v.Count))
+			// the events of the stream are recorded with the content of the end of the stream
+			switch {
+			case v.Event != nil:
+				streams[v.Count] = v
+			case v.Stream != nil:
+				delete(streams, v.Count)
+				record(v)
+			default:
+				record(v)
 			}
+			mustWrite := true
+			records := newResponseRecords(ctx, log, v)
 			_, isMatch := request.RecordExcludeFilter.CountFilter(v.Count)
 			if isMatch {
 				log.Debug(ctx, "Count output filter found",
@@ -177,7 +280,14 @@ func runResponseHandler(
 				mustWrite = false
 			}
 			var matchID string
-			matchID, isMatch, err = request.RecordExcludeFilter.ResponseBodyFilter(response)
+			var err error
+			excluded := make([]bool, len(records))
+			for i, record := range records {
+				// the filter is applied to each event for the stream response types
+				if matchID, excluded[i], err = request.RecordExcludeFilter.ResponseBodyFilter(record.body); err != nil {
+					break
+				}
+			}
 			if err != nil {
 				log.Error(ctx, "failed to search jmespath",
 					logger.Value("error", err), logger.Value("on", "runResponseHandler"), logger.Value("count", v.Count))
@@ -215,23 +325,17 @@ func runResponseHandler(
 				}
 				return
 			}
-			if isMatch {
-				log.Debug(ctx, "Response output filter found",
-					logger.Value("id", id), logger.Value("on", "runResponseHandler"), logger.Value("count", v.Count))
-				mustWrite = false
-			}
-
-			if mustWrite {
-				uid := uuid.New()
-				writeData := WriteData{
-					Success:          v.Success,
-					SendDatetime:     v.StartTime.Format(time.RFC3339Nano),
-					ReceivedDatetime: v.EndTime.Format(time.RFC3339Nano),
-					Count:            v.Count,
-					ResponseTime:     int(v.ResponseTime),
-					StatusCode:       strconv.Itoa(v.StatusCode),
-					RawData:          response,
+			for i, record := range records {
+				if !mustWrite {
+					break
+				}
+				if excluded[i] {
+					log.Debug(ctx, "Response output filter found",
+						logger.Value("id", id), logger.Value("on", "runResponseHandler"), logger.Value("count", v.Count))
+					continue
 				}
+				uid := uuid.New()
+				writeData := newWriteData(v, record)
 				sentUID[uid] = struct{}{}
 				go func() {
 					select {
@@ -322,6 +426,34 @@ func runResponseHandler(
 				log.Warn(ctx, "Parse error occurred",
 					logger.Value("id", id), logger.Value("on", "runResponseHandler"), logger.Value("count", v.Count))
 			}
+			if v.HasGraphQLErr {
+				if request.Break.GraphQLError {
+					sentLen := len(sentUID)
+					for sentLen > 0 {
+						select {
+						case <-reqTermChan:
+							return
+						case uid := <-uidChan:
+							delete(sentUID, uid)
+							sentLen--
+						case <-writeErrChan:
+							log.Warn(ctx, "write error occurred",
+								logger.Value("id", id), logger.Value("on", "runResponseHandler"), logger.Value("count", v.Count))
+						}
+					}
+					log.Warn(ctx, "Term Condition: GraphQL Error",
+						logger.Value("id", id), logger.Value("on", "runResponseHandler"), logger.Value("count", v.Count))
+					select {
+					case termChan <- NewTermChanType(matcher.TerminateTypeByGraphQLError, v.Operation):
+					case <-reqTermChan:
+						return
+					}
+					return
+				}
+				log.Warn(ctx, "GraphQL error occurred",
+					logger.Value("id", id), logger.Value("on", "runResponseHandler"),
+					logger.Value("count", v.Count), logger.Value("operation", v.Operation))
+			}
 			if v.WithCountLimit {
 				sentLen := len(sentUID)
 				writeErr := false
@@ -349,6 +481,7 @@ func runResponseHandler(
 					return
 				}
 
+				recordStopped()
 				log.Info(ctx, "Term Condition: Count Limit",
 					logger.Value("id", id), logger.Value("on", "runResponseHandler"), logger.Value("count", v.Count))
 				select {
@@ -358,7 +491,11 @@ func runResponseHandler(
 				}
 				return
 			}
-			matchID, isMatch, err = request.Break.ResponseBodyMatcher(response)
+			for _, record := range records {
+				if matchID, isMatch, err = request.Break.ResponseBodyMatcher(record.body); err != nil || isMatch {
+					break
+				}
+			}
 			if err != nil {
 				log.Error(ctx, "failed to search jmespath",
 					logger.Value("error", err), logger.Value("on", "runResponseHandler"), logger.Value("count", v.Count))
@@ -424,6 +561,7 @@ func runResponseHandler(
 					return
 				}
 
+				recordStopped()
 				log.Info(ctx, "Term Condition: Response Body",
 					logger.Value("id", id), logger.Value("on", "runResponseHandler"), logger.Value("count", v.Count))
 				select {
@@ -461,6 +599,7 @@ func runResponseHandler(
 					return
 				}
 
+				recordStopped()
 				log.Info(ctx, "Term Condition: Status Code",
 					logger.Value("id", id), logger.Value("on", "runResponseHandler"), logger.Value("count", v.Count))
 				select {
@@ -484,12 +623,25 @@ func RunAsyncProcessing(
 	termChan chan<- TermChanType,
 	resChan <-chan httpexec.ResponseContent,
 	consumer ResponseDataConsumer,
+	stats *ExecStats,
 ) {
 	writeChan := make(chan writeSendData)
 	wroteUIDChan := make(chan uuid.UUID)
 	writeErrChan := make(chan struct{})
 	go func() {
-		runResponseHandler(ctx, reqTermChan, log, id, request, termChan, writeErrChan, wroteUIDChan, resChan, writeChan)
+		runResponseHandler(
+			ctx,
+			reqTermChan,
+			log,
+			id,
+			request,
+			termChan,
+			writeErrChan,
+			wroteUIDChan,
+			resChan,
+			writeChan,
+			stats,
+		)
 	}()
 
 	go func() {
@@ -506,6 +658,7 @@ func RunAsyncProcessing(
 				if err := consumer(ctx, log, id, d.writeData); err != nil {
 					log.Error(ctx, "failed to write data",
 						logger.Value("error", err), logger.Value("on", "runAsyncProcessing"))
+					stats.RecordError(matcher.TerminateTypeByWriteError)
 					if request.Break.WriteError {
 						select {
 						case writeErrChan <- struct{}{}:
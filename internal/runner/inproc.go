@@ -0,0 +1,76 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+// InprocScheme represents the scheme of the URI of the in-process slave
+const InprocScheme = "inproc://"
+
+// inprocBufferSize is the size of the buffer of the in-memory connection
+const inprocBufferSize = 1024 * 1024
+
+// InprocServe starts the slave server of the name on the listener, it is stopped when the context is done
+type InprocServe func(ctx context.Context, name string, lis net.Listener) error
+
+// inprocSlave represents the in-process slave served on the in-memory listener
+type inprocSlave struct {
+	lis    *bufconn.Listener
+	cancel context.CancelFunc
+}
+
+// inprocName returns the name of the in-process slave of the URI
+func inprocName(uri string) (string, bool) {
+	if !strings.HasPrefix(uri, InprocScheme) {
+		return "", false
+	}
+	return strings.TrimPrefix(uri, InprocScheme), true
+}
+
+// inprocDialOption returns the dial option connecting to the in-process slave of the name.
+// The slave is started on the first connection, and shared by the later connections to the same name.
+// The caller must hold the lock of the container.
+func (c *ConnectionContainer) inprocDialOption(
+	ctx context.Context,
+	log logger.Logger,
+	name string,
+) (grpc.DialOption, error) {
+	slave, ok := c.inprocSlaves[name]
+	if !ok {
+		if c.inprocServe == nil {
+			return nil, fmt.Errorf("in-process slave is not supported here: %s", name)
+		}
+		serveCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		slave = &inprocSlave{
+			lis:    bufconn.Listen(inprocBufferSize),
+			cancel: cancel,
+		}
+		if err := c.inprocServe(serveCtx, name, slave.lis); err != nil {
+			cancel()
+			return nil, fmt.Errorf("failed to start in-process slave: %w", err)
+		}
+		c.inprocSlaves[name] = slave
+		log.Info(ctx, "started in-process slave",
+			logger.Value("name", name), logger.Value("on", "ConnectionContainer.inprocDialOption"))
+	}
+	return grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return slave.lis.DialContext(ctx)
+	}), nil
+}
+
+// stopInprocSlaves stops all the in-process slaves.
+// The caller must hold the lock of the container.
+func (c *ConnectionContainer) stopInprocSlaves() {
+	for name, slave := range c.inprocSlaves {
+		slave.cancel()
+		delete(c.inprocSlaves, name)
+	}
+}
@@ -0,0 +1,142 @@
+package runner
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ablankz/bloader/internal/executor/httpexec"
+)
+
+// LiveLatencyBounds represents the upper bounds of the latency buckets in milliseconds.
+// The responses slower than the last bound are counted in the overflow bucket.
+var LiveLatencyBounds = []float64{1, 2, 5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000}
+
+// LiveMetrics represents the cumulative metrics of the executions, read while they are running
+type LiveMetrics struct {
+	mu            sync.Mutex
+	requests      int64
+	success       int64
+	buckets       []int64
+	activeThreads atomic.Int32
+}
+
+// NewLiveMetrics creates a new LiveMetrics
+func NewLiveMetrics() *LiveMetrics {
+	return &LiveMetrics{
+		buckets: make([]int64, len(LiveLatencyBounds)+1),
+	}
+}
+
+// Record records the response, it does nothing on the nil metrics
+func (m *LiveMetrics) Record(res httpexec.ResponseContent) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests++
+	if res.Success {
+		m.success++
+	}
+	if res.StartTime.IsZero() {
+		return
+	}
+	ms := float64(res.EndTime.Sub(res.StartTime)) / float64(time.Millisecond)
+	m.buckets[sort.SearchFloat64s(LiveLatencyBounds, ms)]++
+}
+
+// ThreadStarted counts the thread as active, and returns the function called when the thread ends.
+// It does nothing on the nil metrics.
+func (m *LiveMetrics) ThreadStarted() func() {
+	if m == nil {
+		return func() {}
+	}
+	m.activeThreads.Add(1)
+	return func() {
+		m.activeThreads.Add(-1)
+	}
+}
+
+// Snapshot returns the current metrics
+func (m *LiveMetrics) Snapshot() LiveSnapshot {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return LiveSnapshot{
+		Time:          time.Now(),
+		Requests:      m.requests,
+		Success:       m.success,
+		Errors:        m.requests - m.success,
+		Buckets:       append([]int64(nil), m.buckets...),
+		ActiveThreads: int(m.activeThreads.Load()),
+	}
+}
+
+// LiveSnapshot represents the cumulative metrics at a point in time
+type LiveSnapshot struct {
+	Time     time.Time
+	Requests int64
+	Success  int64
+	Errors   int64
+	// Buckets is the count of the responses in each latency bucket of LiveLatencyBounds, and the overflow
+	Buckets       []int64
+	ActiveThreads int
+}
+
+// add adds the counters of the other snapshot
+func (s LiveSnapshot) add(other LiveSnapshot) LiveSnapshot {
+	s.Requests += other.Requests
+	s.Success += other.Success
+	s.Errors += other.Errors
+	s.ActiveThreads += other.ActiveThreads
+	buckets := make([]int64, len(LiveLatencyBounds)+1)
+	for i := range buckets {
+		if i < len(s.Buckets) {
+			buckets[i] += s.Buckets[i]
+		}
+		if i < len(other.Buckets) {
+			buckets[i] += other.Buckets[i]
+		}
+	}
+	s.Buckets = buckets
+	return s
+}
+
+// Quantile returns the upper bound of the bucket containing the quantile (0-100) in milliseconds.
+// The last bound is returned for the overflow bucket, and 0 for no responses.
+func (s LiveSnapshot) Quantile(q float64) float64 {
+	var total int64
+	for _, c := range s.Buckets {
+		total += c
+	}
+	if total == 0 {
+		return 0
+	}
+	rank := int64(float64(total) * q / 100)
+	var seen int64
+	for i, c := range s.Buckets {
+		seen += c
+		if seen > rank || seen == total {
+			if i >= len(LiveLatencyBounds) {
+				break
+			}
+			return LiveLatencyBounds[i]
+		}
+	}
+	return LiveLatencyBounds[len(LiveLatencyBounds)-1]
+}
+
+type liveMetricsKey struct{}
+
+// WithLiveMetrics returns the context which records the executions run under it to the metrics
+func WithLiveMetrics(ctx context.Context, metrics *LiveMetrics) context.Context {
+	return context.WithValue(ctx, liveMetricsKey{}, metrics)
+}
+
+// liveMetricsFrom returns the metrics of the context, nil if it has none
+func liveMetricsFrom(ctx context.Context) *LiveMetrics {
+	metrics, _ := ctx.Value(liveMetricsKey{}).(*LiveMetrics)
+	return metrics
+}
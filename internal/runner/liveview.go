@@ -0,0 +1,249 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
+	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/output"
+)
+
+// LiveLatency represents the latency quantiles of the live view in milliseconds.
+// Each value is the upper bound of the bucket containing the quantile.
+type LiveLatency struct {
+	P50 float64 `json:"p50_ms"`
+	P90 float64 `json:"p90_ms"`
+	P95 float64 `json:"p95_ms"`
+	P99 float64 `json:"p99_ms"`
+}
+
+// LiveSlaveRecord represents the metrics of a slave in the live view
+type LiveSlaveRecord struct {
+	Requests      int64 `json:"requests"`
+	Errors        int64 `json:"errors"`
+	ActiveThreads int   `json:"active_threads"`
+}
+
+// LiveRecord represents a point of the time series of the live view
+type LiveRecord struct {
+	Time            time.Time                  `json:"time"`
+	Slaves          map[string]LiveSlaveRecord `json:"slaves"`
+	ActiveThreads   int                        `json:"active_threads"`
+	Requests        int64                      `json:"requests"`
+	Success         int64                      `json:"success"`
+	Errors          int64                      `json:"errors"`
+	ErrorRate       float64                    `json:"error_rate"`
+	RPS             float64                    `json:"rps"`
+	Latency         LiveLatency                `json:"latency"`
+	LatencyBoundsMs []float64                  `json:"latency_bounds_ms"`
+	LatencyBuckets  []int64                    `json:"latency_buckets"`
+}
+
+// String returns the single line of the record printed during the run
+func (r LiveRecord) String() string {
+	return fmt.Sprintf(
+		"[live] %s slaves=%d threads=%d requests=%d errors=%d (%.2f%%) rps=%.2f p50<=%gms p95<=%gms p99<=%gms",
+		r.Time.Format(time.TimeOnly), len(r.Slaves), r.ActiveThreads, r.Requests, r.Errors,
+		r.ErrorRate*100, r.RPS, r.Latency.P50, r.Latency.P95, r.Latency.P99,
+	)
+}
+
+// liveSlave represents the latest snapshot of a slave.
+// The counters restart on the new session, so the snapshots of the previous sessions are kept in base.
+type liveSlave struct {
+	connectionID string
+	base         LiveSnapshot
+	last         LiveSnapshot
+}
+
+// liveView merges the snapshots streamed from the slaves into a single view,
+// and prints and writes it on the interval
+type liveView struct {
+	mu         *sync.Mutex
+	slaves     map[string]*liveSlave // Key: slaveID
+	interval   time.Duration
+	outputs    []output.Output
+	outputRoot string
+	prev       LiveRecord
+	stopChan   chan struct{}
+	stopOnce   *sync.Once
+	doneChan   chan struct{}
+}
+
+// newLiveView creates a new liveView
+func newLiveView(metrics ValidSlaveConnectMetrics, outputRoot string) *liveView {
+	return &liveView{
+		mu:         &sync.Mutex{},
+		slaves:     make(map[string]*liveSlave),
+		interval:   metrics.Interval,
+		outputs:    metrics.Output,
+		outputRoot: outputRoot,
+		stopChan:   make(chan struct{}),
+		stopOnce:   &sync.Once{},
+		doneChan:   make(chan struct{}),
+	}
+}
+
+// update replaces the latest snapshot of the slave
+func (v *liveView) update(slaveID, connectionID string, snapshot LiveSnapshot) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	slave, ok := v.slaves[slaveID]
+	if !ok {
+		v.slaves[slaveID] = &liveSlave{
+			connectionID: connectionID,
+			last:         snapshot,
+		}
+		return
+	}
+	if slave.connectionID != connectionID {
+		last := slave.last
+		last.ActiveThreads = 0
+		slave.base = slave.base.add(last)
+		slave.connectionID = connectionID
+	}
+	slave.last = snapshot
+}
+
+// record merges the snapshots of the slaves into the record
+func (v *liveView) record(now time.Time) LiveRecord {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	rec := LiveRecord{
+		Time:            now,
+		Slaves:          make(map[string]LiveSlaveRecord, len(v.slaves)),
+		LatencyBoundsMs: LiveLatencyBounds,
+	}
+	var total LiveSnapshot
+	for id, slave := range v.slaves {
+		snapshot := slave.base.add(slave.last)
+		rec.Slaves[id] = LiveSlaveRecord{
+			Requests:      snapshot.Requests,
+			Errors:        snapshot.Errors,
+			ActiveThreads: snapshot.ActiveThreads,
+		}
+		total = total.add(snapshot)
+	}
+	rec.ActiveThreads = total.ActiveThreads
+	rec.Requests = total.Requests
+	rec.Success = total.Success
+	rec.Errors = total.Errors
+	rec.LatencyBuckets = total.Buckets
+	if rec.LatencyBuckets == nil {
+		rec.LatencyBuckets = make([]int64, len(LiveLatencyBounds)+1)
+	}
+	if total.Requests > 0 {
+		rec.ErrorRate = float64(total.Errors) / float64(total.Requests)
+	}
+	if !v.prev.Time.IsZero() {
+		if elapsed := now.Sub(v.prev.Time).Seconds(); elapsed > 0 {
+			rec.RPS = float64(rec.Requests-v.prev.Requests) / elapsed
+		}
+	}
+	rec.Latency = LiveLatency{
+		P50: total.Quantile(50),
+		P90: total.Quantile(90),
+		P95: total.Quantile(95),
+		P99: total.Quantile(99),
+	}
+	v.prev = rec
+	return rec
+}
+
+// run prints and writes the view on the interval until the view is stopped or the context is done
+func (v *liveView) run(ctx context.Context, log logger.Logger) {
+	defer close(v.doneChan)
+	ticker := time.NewTicker(v.interval)
+	defer ticker.Stop()
+
+	for {
+		var last bool
+		select {
+		case <-ctx.Done():
+			last = true
+		case <-v.stopChan:
+			last = true
+		case <-ticker.C:
+		}
+		rec := v.record(time.Now())
+		fmt.Println(rec.String())
+		if err := v.write(context.WithoutCancel(ctx), log, rec); err != nil {
+			log.Error(ctx, "failed to write live metrics",
+				logger.Value("error", err), logger.Value("on", "liveView.run"))
+		}
+		if last {
+			return
+		}
+	}
+}
+
+// write appends the record to the outputs
+func (v *liveView) write(ctx context.Context, log logger.Logger, rec LiveRecord) error {
+	if len(v.outputs) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("failed to marshal live metrics: %w", err)
+	}
+	for _, o := range v.outputs {
+		if err := o.MetricsWrite(ctx, log, v.outputRoot, data); err != nil {
+			return fmt.Errorf("failed to write live metrics: %w", err)
+		}
+	}
+	return nil
+}
+
+// stop stops the view after the final record is printed and written
+func (v *liveView) stop() {
+	v.stopOnce.Do(func() {
+		close(v.stopChan)
+	})
+	<-v.doneChan
+}
+
+// streamMetrics receives the snapshots of the session from the slave until the session ends
+func (d *ConnectionMapData) streamMetrics(ctx context.Context, log logger.Logger, connectionID string) {
+	stream, err := d.Cli.StreamMetrics(ctx, &pb.StreamMetricsRequest{
+		ConnectionId: connectionID,
+		IntervalMs:   d.liveView.interval.Milliseconds(),
+	})
+	for err == nil {
+		var res *pb.StreamMetricsResponse
+		if res, err = stream.Recv(); err != nil {
+			break
+		}
+		snapshot := LiveSnapshot{
+			Time:          time.UnixMilli(res.TimestampMs),
+			Requests:      res.Requests,
+			Success:       res.Success,
+			Errors:        res.Errors,
+			ActiveThreads: int(res.ActiveThreads),
+		}
+		// the buckets of the slave with the different bounds cannot be merged
+		if slices.Equal(res.LatencyBoundsMs, LiveLatencyBounds) {
+			snapshot.Buckets = res.LatencyBuckets
+		}
+		d.liveView.update(d.SlaveID, connectionID, snapshot)
+	}
+	switch {
+	case ctx.Err() != nil:
+	case status.Code(err) == codes.Unimplemented:
+		log.Warn(ctx, "slave does not support the live metrics",
+			logger.Value("slaveID", d.SlaveID), logger.Value("on", "ConnectionMapData.streamMetrics"))
+	default:
+		log.Warn(ctx, "failed to receive live metrics",
+			logger.Value("error", err), logger.Value("slaveID", d.SlaveID),
+			logger.Value("on", "ConnectionMapData.streamMetrics"))
+	}
+}
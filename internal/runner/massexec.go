@@ -4,13 +4,21 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/ablankz/bloader/internal/auth"
+	"github.com/ablankz/bloader/internal/config"
+	"github.com/ablankz/bloader/internal/executor/grpcexec"
 	"github.com/ablankz/bloader/internal/executor/httpexec"
+	"github.com/ablankz/bloader/internal/executor/sockexec"
+	"github.com/ablankz/bloader/internal/executor/wsexec"
 	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/metrics"
 	"github.com/ablankz/bloader/internal/output"
 	"github.com/ablankz/bloader/internal/runner/matcher"
 	"github.com/ablankz/bloader/internal/utils"
@@ -22,22 +30,43 @@ type MassExecType string
 const (
 	// MassExecTypeHTTP represents the HTTP type
 	MassExecTypeHTTP MassExecType = "http"
+	// MassExecTypeGRPC represents the gRPC type
+	MassExecTypeGRPC MassExecType = "grpc"
+	// MassExecTypeWebSocket represents the WebSocket type
+	MassExecTypeWebSocket MassExecType = "websocket"
+	// MassExecTypeSocket represents the raw TCP/UDP socket type
+	MassExecTypeSocket MassExecType = "socket"
+)
+
+// WebSocketMessageType represents the type of the message sent on the WebSocket connection
+type WebSocketMessageType string
+
+const (
+	// WebSocketMessageTypeJSON represents the JSON text message
+	WebSocketMessageTypeJSON WebSocketMessageType = "json"
+	// WebSocketMessageTypeText represents the plain text message
+	WebSocketMessageTypeText WebSocketMessageType = "text"
+
+	// DefaultWebSocketMessageType represents the default WebSocket message type
+	DefaultWebSocketMessageType = WebSocketMessageTypeJSON
 )
 
 // MassExec represents the MassExec runner
 type MassExec struct {
-	Type     *string           `yaml:"type"`
-	Output   MassExecOutput    `yaml:"output"`
-	Auth     MassExecAuth      `yaml:"auth"`
-	Requests []MassExecRequest `yaml:"requests"`
+	Type       *string           `yaml:"type"`
+	Output     MassExecOutput    `yaml:"output"`
+	Auth       MassExecAuth      `yaml:"auth"`
+	Thresholds []string          `yaml:"thresholds"`
+	Requests   []MassExecRequest `yaml:"requests"`
 }
 
 // ValidMassExec represents the valid MassExec runner
 type ValidMassExec struct {
-	Type     MassExecType
-	Output   []output.Output
-	Auth     auth.SetAuthor
-	Requests []ValidMassExecRequest
+	Type       MassExecType
+	Output     []output.Output
+	Auth       auth.SetAuthor
+	Thresholds ValidThresholds
+	Requests   []ValidMassExecRequest
 }
 
 // Validate validates the MassExec
@@ -55,7 +84,7 @@ func (r MassExec) Validate(
 		return ValidMassExec{}, fmt.Errorf("type is required")
 	}
 	switch MassExecType(*r.Type) {
-	case MassExecTypeHTTP:
+	case MassExecTypeHTTP, MassExecTypeGRPC, MassExecTypeWebSocket, MassExecTypeSocket:
 		massExecType = MassExecType(*r.Type)
 	default:
 		return ValidMassExec{}, fmt.Errorf("invalid type value: %s", *r.Type)
@@ -70,11 +99,16 @@ func (r MassExec) Validate(
 	if err != nil {
 		return ValidMassExec{}, fmt.Errorf("failed to validate auth: %w", err)
 	}
+	validThresholds, err := NewValidThresholds(r.Thresholds)
+	if err != nil {
+		return ValidMassExec{}, fmt.Errorf("failed to validate thresholds: %w", err)
+	}
 	var validRequests []ValidMassExecRequest
 	for i, req := range r.Requests {
 		validRequest, err := req.Validate(
 			ctx,
 			log,
+			massExecType,
 			targetFactor,
 			tmplStr,
 			replaceData,
@@ -85,10 +119,11 @@ func (r MassExec) Validate(
 		validRequests = append(validRequests, validRequest)
 	}
 	return ValidMassExec{
-		Type:     massExecType,
-		Output:   validOutput,
-		Auth:     validAuth,
-		Requests: validRequests,
+		Type:       massExecType,
+		Output:     validOutput,
+		Auth:       validAuth,
+		Thresholds: validThresholds,
+		Requests:   validRequests,
 	}, nil
 }
 
@@ -151,6 +186,7 @@ type MassExecRequestBreak struct {
 	SysError     bool                         `yaml:"sys_error"`
 	ParseError   bool                         `yaml:"parse_error"`
 	WriteError   bool                         `yaml:"write_error"`
+	GraphQLError bool                         `yaml:"graphql_error"`
 	StatusCode   matcher.StatusCodeConditions `yaml:"status_code"`
 	ResponseBody matcher.BodyConditions       `yaml:"response_body"`
 }
@@ -165,6 +201,7 @@ type ValidMassExecRequestBreak struct {
 	SysError            bool
 	ParseError          bool
 	WriteError          bool
+	GraphQLError        bool
 	StatusCodeMatcher   matcher.StatusCodeConditionsMatcher
 	ResponseBodyMatcher matcher.BodyConditionsMatcher
 }
@@ -188,6 +225,7 @@ func (b MassExecRequestBreak) Validate(ctx context.Context, log logger.Logger) (
 	valid.SysError = b.SysError
 	valid.ParseError = b.ParseError
 	valid.WriteError = b.WriteError
+	valid.GraphQLError = b.GraphQLError
 	if valid.StatusCodeMatcher, err = b.StatusCode.MatcherGenerate(ctx, log); err != nil {
 		return ValidMassExecRequestBreak{}, fmt.Errorf("failed to generate status code matcher: %w", err)
 	}
@@ -236,6 +274,12 @@ type MassExecRequest struct {
 	TargetID            *string                            `yaml:"target_id"`
 	Endpoint            *string                            `yaml:"endpoint"`
 	Method              *string                            `yaml:"method"`
+	Service             *string                            `yaml:"service"`
+	Descriptor          GRPCDescriptor                     `yaml:"descriptor"`
+	Connections         *int                               `yaml:"connections"`
+	ReplyMatch          matcher.BodyConditions             `yaml:"reply_match"`
+	Framing             SocketFraming                      `yaml:"framing"`
+	Timeout             *string                            `yaml:"timeout"`
 	QueryParam          map[string]any                     `yaml:"query_param"`
 	PathVariables       map[string]string                  `yaml:"path_variables"`
 	Headers             map[string]any                     `yaml:"headers"`
@@ -244,6 +288,10 @@ type MassExecRequest struct {
 	ResponseType        *string                            `yaml:"response_type"`
 	Data                []ExecRequestData                  `yaml:"data"`
 	Interval            *string                            `yaml:"interval"`
+	Rate                *string                            `yaml:"rate"`
+	MaxInFlight         *int                               `yaml:"max_in_flight"`
+	OnMaxInFlight       *string                            `yaml:"on_max_in_flight"`
+	Stages              []MassExecRequestStage             `yaml:"stages"`
 	AwaitPrevResp       bool                               `yaml:"await_prev_response"`
 	SuccessBreak        []string                           `yaml:"success_break"`
 	Break               MassExecRequestBreak               `yaml:"break"`
@@ -252,8 +300,17 @@ type MassExecRequest struct {
 
 // ValidMassExecRequest represents the valid request configuration for the MassExec runner
 type ValidMassExecRequest struct {
+	// TargetID and Endpoint label the metrics of the request, the endpoint is "/Service/Method" for grpc
+	TargetID            string
+	Endpoint            string
 	URL                 string
 	Method              string
+	Service             string
+	Descriptor          ValidGRPCDescriptor
+	Connections         int
+	MessageType         WebSocketMessageType
+	ReplyMatcher        matcher.BodyConditionsMatcher
+	Socket              ValidSocketOptions
 	QueryParams         map[string]any
 	PathVariables       map[string]string
 	Headers             map[string]any
@@ -262,6 +319,7 @@ type ValidMassExecRequest struct {
 	ResponseType        string
 	Data                ValidExecRequestDataSlice
 	Interval            time.Duration
+	ArrivalRate         httpexec.ArrivalRate
 	AwaitPrevResp       bool
 	SuccessBreak        matcher.TerminateTypeAndParamsSlice
 	Break               ValidMassExecRequestBreak
@@ -274,6 +332,7 @@ type ValidMassExecRequest struct {
 func (r MassExecRequest) Validate(
 	ctx context.Context,
 	log logger.Logger,
+	execType MassExecType,
 	targetFactor TargetFactor,
 	tmplStr string,
 	replaceData map[string]any,
@@ -283,38 +342,139 @@ func (r MassExecRequest) Validate(
 	if r.TargetID == nil {
 		return ValidMassExecRequest{}, fmt.Errorf("target_id is required")
 	}
-	if r.Endpoint == nil {
-		return ValidMassExecRequest{}, fmt.Errorf("endpoint is required")
-	}
-	var urlRoot string
-	tg, err := targetFactor.Factorize(ctx, *r.TargetID)
-	if err != nil {
-		return ValidMassExecRequest{}, fmt.Errorf("failed to factorize target: %w", err)
-	}
-	urlRoot = tg.URL
-	valid.URL = fmt.Sprintf("%s%s", urlRoot, *r.Endpoint)
-	if r.Method == nil {
-		return ValidMassExecRequest{}, fmt.Errorf("method is required")
-	}
-	valid.Method = *r.Method
-	valid.QueryParams = r.QueryParam
-	valid.PathVariables = r.PathVariables
-	valid.Headers = r.Headers
-	valid.Body = r.Body
-	if r.BodyType == nil {
-		valid.BodyType = DefaultHTTPRequestBodyType
-	} else {
-		switch HTTPRequestBodyType(*r.BodyType) {
-		case HTTPRequestBodyTypeJSON, HTTPRequestBodyTypeForm, HTTPRequestBodyTypeMultipart:
-			valid.BodyType = HTTPRequestBodyTypeJSON
-		default:
-			return ValidMassExecRequest{}, fmt.Errorf("invalid body_type value: %s", *r.BodyType)
+	valid.TargetID = *r.TargetID
+	switch execType {
+	case MassExecTypeGRPC:
+		tg, err := targetFactor.Factorize(ctx, *r.TargetID)
+		if err != nil {
+			return ValidMassExecRequest{}, fmt.Errorf("failed to factorize target: %w", err)
 		}
+		if tg.Type != config.TargetTypeGRPC {
+			return ValidMassExecRequest{}, fmt.Errorf("target %s is not a grpc target", *r.TargetID)
+		}
+		valid.URL = tg.URL
+		if r.Service == nil {
+			return ValidMassExecRequest{}, fmt.Errorf("service is required")
+		}
+		valid.Service = *r.Service
+		if r.Method == nil {
+			return ValidMassExecRequest{}, fmt.Errorf("method is required")
+		}
+		valid.Method = *r.Method
+		valid.Endpoint = fmt.Sprintf("/%s/%s", valid.Service, valid.Method)
+		if valid.Descriptor, err = r.Descriptor.Validate(); err != nil {
+			return ValidMassExecRequest{}, fmt.Errorf("failed to validate descriptor: %w", err)
+		}
+		valid.Headers = r.Headers
+		valid.Body = r.Body
+	case MassExecTypeWebSocket:
+		if r.Endpoint == nil {
+			return ValidMassExecRequest{}, fmt.Errorf("endpoint is required")
+		}
+		tg, err := targetFactor.Factorize(ctx, *r.TargetID)
+		if err != nil {
+			return ValidMassExecRequest{}, fmt.Errorf("failed to factorize target: %w", err)
+		}
+		if tg.Type != config.TargetTypeHTTP {
+			return ValidMassExecRequest{}, fmt.Errorf("target %s is not a http target", *r.TargetID)
+		}
+		valid.URL = fmt.Sprintf("%s%s", tg.URL, *r.Endpoint)
+		valid.Endpoint = *r.Endpoint
+		valid.QueryParams = r.QueryParam
+		valid.PathVariables = r.PathVariables
+		valid.Headers = r.Headers
+		valid.Body = r.Body
+		if r.Connections == nil {
+			return ValidMassExecRequest{}, fmt.Errorf("connections is required")
+		}
+		if *r.Connections <= 0 {
+			return ValidMassExecRequest{}, fmt.Errorf("connections must be greater than 0")
+		}
+		valid.Connections = *r.Connections
+		if r.BodyType == nil {
+			valid.MessageType = DefaultWebSocketMessageType
+		} else {
+			switch WebSocketMessageType(*r.BodyType) {
+			case WebSocketMessageTypeJSON, WebSocketMessageTypeText:
+				valid.MessageType = WebSocketMessageType(*r.BodyType)
+			default:
+				return ValidMassExecRequest{}, fmt.Errorf("invalid body_type value: %s", *r.BodyType)
+			}
+		}
+		if len(r.ReplyMatch) > 0 {
+			if valid.ReplyMatcher, err = r.ReplyMatch.MatcherGenerate(ctx, log); err != nil {
+				return ValidMassExecRequest{}, fmt.Errorf("failed to generate reply matcher: %w", err)
+			}
+		}
+		if r.ResponseType == nil {
+			return ValidMassExecRequest{}, fmt.Errorf("response_type is required")
+		}
+		valid.ResponseType = *r.ResponseType
+		if httpexec.ResponseType(valid.ResponseType).IsStream() {
+			return ValidMassExecRequest{}, fmt.Errorf("response_type %s cannot be used with websocket type", valid.ResponseType)
+		}
+		if r.Rate != nil || len(r.Stages) > 0 {
+			return ValidMassExecRequest{}, fmt.Errorf("rate and stages cannot be used with websocket type")
+		}
+		if r.AwaitPrevResp {
+			return ValidMassExecRequest{}, fmt.Errorf("await_prev_response cannot be used with websocket type")
+		}
+	case MassExecTypeSocket:
+		if valid.Socket, err = validateSocketOptions(
+			ctx,
+			targetFactor,
+			*r.TargetID,
+			r.BodyType,
+			r.Body,
+			r.Framing,
+			r.Timeout,
+		); err != nil {
+			return ValidMassExecRequest{}, fmt.Errorf("failed to validate socket: %w", err)
+		}
+		valid.Body = r.Body
+		if valid.ResponseType, err = validateSocketResponseType(r.ResponseType); err != nil {
+			return ValidMassExecRequest{}, err
+		}
+	default:
+		if r.Endpoint == nil {
+			return ValidMassExecRequest{}, fmt.Errorf("endpoint is required")
+		}
+		var urlRoot string
+		tg, err := targetFactor.Factorize(ctx, *r.TargetID)
+		if err != nil {
+			return ValidMassExecRequest{}, fmt.Errorf("failed to factorize target: %w", err)
+		}
+		urlRoot = tg.URL
+		valid.URL = fmt.Sprintf("%s%s", urlRoot, *r.Endpoint)
+		valid.Endpoint = *r.Endpoint
+		if r.Method == nil {
+			return ValidMassExecRequest{}, fmt.Errorf("method is required")
+		}
+		valid.Method = *r.Method
+		valid.QueryParams = r.QueryParam
+		valid.PathVariables = r.PathVariables
+		valid.Headers = r.Headers
+		valid.Body = r.Body
+		if r.BodyType == nil {
+			valid.BodyType = DefaultHTTPRequestBodyType
+		} else {
+			switch HTTPRequestBodyType(*r.BodyType) {
+			case HTTPRequestBodyTypeJSON, HTTPRequestBodyTypeForm, HTTPRequestBodyTypeMultipart:
+				valid.BodyType = HTTPRequestBodyTypeJSON
+			case HTTPRequestBodyTypeGraphQL:
+				if _, err := NewGraphQLBody(r.Body); err != nil {
+					return ValidMassExecRequest{}, fmt.Errorf("failed to validate graphql body: %w", err)
+				}
+				valid.BodyType = HTTPRequestBodyTypeGraphQL
+			default:
+				return ValidMassExecRequest{}, fmt.Errorf("invalid body_type value: %s", *r.BodyType)
+			}
+		}
+		if r.ResponseType == nil {
+			return ValidMassExecRequest{}, fmt.Errorf("response_type is required")
+		}
+		valid.ResponseType = *r.ResponseType
 	}
-	if r.ResponseType == nil {
-		return ValidMassExecRequest{}, fmt.Errorf("response_type is required")
-	}
-	valid.ResponseType = *r.ResponseType
 	for i, d := range r.Data {
 		validData, err := d.Validate()
 		if err != nil {
@@ -322,11 +482,23 @@ func (r MassExecRequest) Validate(
 		}
 		valid.Data = append(valid.Data, validData)
 	}
-	if r.Interval == nil {
-		return ValidMassExecRequest{}, fmt.Errorf("interval is required")
-	}
-	if valid.Interval, err = time.ParseDuration(*r.Interval); err != nil {
-		return ValidMassExecRequest{}, fmt.Errorf("failed to parse interval: %w", err)
+	if r.Rate != nil || len(r.Stages) > 0 {
+		if r.Interval != nil {
+			return ValidMassExecRequest{}, fmt.Errorf("interval cannot be specified with rate or stages")
+		}
+		if r.AwaitPrevResp {
+			return ValidMassExecRequest{}, fmt.Errorf("await_prev_response cannot be used with rate or stages")
+		}
+		if valid.ArrivalRate, err = validateArrivalRate(r.Rate, r.Stages, r.MaxInFlight, r.OnMaxInFlight); err != nil {
+			return ValidMassExecRequest{}, fmt.Errorf("failed to validate rate: %w", err)
+		}
+	} else {
+		if r.Interval == nil {
+			return ValidMassExecRequest{}, fmt.Errorf("interval is required")
+		}
+		if valid.Interval, err = time.ParseDuration(*r.Interval); err != nil {
+			return ValidMassExecRequest{}, fmt.Errorf("failed to parse interval: %w", err)
+		}
 	}
 	valid.AwaitPrevResp = r.AwaitPrevResp
 	if valid.SuccessBreak, err = matcher.NewTerminateTypeAndParamsSliceFromStringSlice(r.SuccessBreak); err != nil {
@@ -335,6 +507,10 @@ func (r MassExecRequest) Validate(
 	if valid.Break, err = r.Break.Validate(ctx, log); err != nil {
 		return ValidMassExecRequest{}, fmt.Errorf("failed to validate break: %w", err)
 	}
+	if len(valid.ArrivalRate.Stages) > 0 && !valid.Break.Time.Enabled {
+		valid.Break.Time.Enabled = true
+		valid.Break.Time.Time = valid.ArrivalRate.TotalDuration()
+	}
 	if valid.RecordExcludeFilter, err = r.RecordExcludeFilter.Validate(ctx, log); err != nil {
 		return ValidMassExecRequest{}, fmt.Errorf("failed to validate record exclude filter: %w", err)
 	}
@@ -343,6 +519,102 @@ func (r MassExecRequest) Validate(
 	return valid, nil
 }
 
+// MassExecRequestStage represents a stage of the staged rate profile for the MassExec runner
+type MassExecRequestStage struct {
+	Duration *string `yaml:"duration"`
+	Target   *string `yaml:"target"`
+}
+
+// Validate validates the MassExecRequestStage
+func (s MassExecRequestStage) Validate() (httpexec.RateStage, error) {
+	var valid httpexec.RateStage
+	var err error
+	if s.Duration == nil {
+		return httpexec.RateStage{}, fmt.Errorf("duration is required")
+	}
+	if valid.Duration, err = time.ParseDuration(*s.Duration); err != nil {
+		return httpexec.RateStage{}, fmt.Errorf("failed to parse duration: %w", err)
+	}
+	if valid.Duration <= 0 {
+		return httpexec.RateStage{}, fmt.Errorf("duration must be greater than 0")
+	}
+	if s.Target == nil {
+		return httpexec.RateStage{}, fmt.Errorf("target is required")
+	}
+	if valid.Target, err = parseRate(*s.Target); err != nil {
+		return httpexec.RateStage{}, fmt.Errorf("failed to parse target: %w", err)
+	}
+	return valid, nil
+}
+
+func validateArrivalRate(
+	rate *string,
+	stages []MassExecRequestStage,
+	maxInFlight *int,
+	onMaxInFlight *string,
+) (httpexec.ArrivalRate, error) {
+	valid := httpexec.ArrivalRate{
+		Enabled:       true,
+		OnMaxInFlight: httpexec.DefaultMaxInFlightPolicy,
+	}
+	var err error
+	if rate != nil {
+		if valid.Rate, err = parseRate(*rate); err != nil {
+			return httpexec.ArrivalRate{}, err
+		}
+	}
+	for i, s := range stages {
+		stage, err := s.Validate()
+		if err != nil {
+			return httpexec.ArrivalRate{}, fmt.Errorf("failed to validate stages[%d]: %w", i, err)
+		}
+		valid.Stages = append(valid.Stages, stage)
+	}
+	if len(valid.Stages) == 0 && valid.Rate <= 0 {
+		return httpexec.ArrivalRate{}, fmt.Errorf("rate must be greater than 0")
+	}
+	if maxInFlight != nil {
+		if *maxInFlight < 0 {
+			return httpexec.ArrivalRate{}, fmt.Errorf("max_in_flight must be greater than or equal to 0")
+		}
+		valid.MaxInFlight = *maxInFlight
+	}
+	if onMaxInFlight != nil {
+		switch httpexec.MaxInFlightPolicy(*onMaxInFlight) {
+		case httpexec.MaxInFlightPolicyDrop, httpexec.MaxInFlightPolicyDelay:
+			valid.OnMaxInFlight = httpexec.MaxInFlightPolicy(*onMaxInFlight)
+		default:
+			return httpexec.ArrivalRate{}, fmt.Errorf("invalid on_max_in_flight value: %s", *onMaxInFlight)
+		}
+	}
+	return valid, nil
+}
+
+// parseRate parses a rate such as "500/s", "30/m" or "5/100ms" into requests per second.
+// A bare number is treated as requests per second.
+func parseRate(s string) (float64, error) {
+	value, unit, found := strings.Cut(strings.TrimSpace(s), "/")
+	count, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid rate value: %s", s)
+	}
+	if count < 0 {
+		return 0, fmt.Errorf("rate must not be negative: %s", s)
+	}
+	if !found {
+		return count, nil
+	}
+	unit = strings.TrimSpace(unit)
+	if unit != "" && (unit[0] < '0' || unit[0] > '9') {
+		unit = "1" + unit
+	}
+	per, err := time.ParseDuration(unit)
+	if err != nil || per <= 0 {
+		return 0, fmt.Errorf("invalid rate unit: %s", s)
+	}
+	return count / per.Seconds(), nil
+}
+
 // Run runs the MassExec runner
 func (r ValidMassExec) Run(
 	ctx context.Context,
@@ -354,31 +626,43 @@ func (r ValidMassExec) Run(
 ) error {
 	switch r.Type {
 	case MassExecTypeHTTP:
-		return r.runHTTP(ctx, log, outputRoot, authFactor, outFactor, targetFactor)
+		return r.run(ctx, log, outputRoot, r.httpExecutorFactory(authFactor, outFactor, targetFactor))
+	case MassExecTypeGRPC:
+		return r.run(ctx, log, outputRoot, r.grpcExecutorFactory(authFactor, outFactor, targetFactor))
+	case MassExecTypeWebSocket:
+		return r.run(ctx, log, outputRoot, r.webSocketExecutorFactory(authFactor, outFactor, targetFactor))
+	case MassExecTypeSocket:
+		return r.run(ctx, log, outputRoot, r.socketExecutorFactory(authFactor, outFactor, targetFactor))
 	}
 	return nil
 }
 
-func (r ValidMassExec) runHTTP(
+// massExecutorFactory creates the executor of the i-th request, and the closer of its resources.
+// uName is the unique name of the output of the request.
+type massExecutorFactory func(
 	ctx context.Context,
 	log logger.Logger,
-	outputRoot string,
+	i int,
+	uName string,
+	request ValidMassExecRequest,
+	resChan chan<- httpexec.ResponseContent,
+	arrivalRate httpexec.ArrivalRate,
+) (httpexec.MassRequestExecutor, output.Close, error)
+
+func (r ValidMassExec) httpExecutorFactory(
 	authFactor AuthenticatorFactor,
 	outFactor OutputFactor,
 	targetFactor TargetFactor,
-) error {
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-	concurrentCount := len(r.Requests)
-	threadExecutors := make([]*MassiveExecThreadExecutor, concurrentCount)
-	uniqueName := fmt.Sprintf("%s/%s", outputRoot, utils.GenerateUniqueID())
-
-	for i := 0; i < concurrentCount; i++ {
-		request := r.Requests[i]
-		threadExecutors[i] = &MassiveExecThreadExecutor{
-			ID: i,
-		}
-
+) massExecutorFactory {
+	return func(
+		_ context.Context,
+		_ logger.Logger,
+		i int,
+		_ string,
+		request ValidMassExecRequest,
+		resChan chan<- httpexec.ResponseContent,
+		arrivalRate httpexec.ArrivalRate,
+	) (httpexec.MassRequestExecutor, output.Close, error) {
 		req := HTTPRequest{
 			Method:        request.Method,
 			URL:           request.URL,
@@ -402,37 +686,289 @@ func (r ValidMassExec) runHTTP(
 			TargetFactor: targetFactor,
 			ReqIndex:     i,
 		}
-		resChan := make(chan httpexec.ResponseContent)
-		exe := httpexec.MassRequestContent[HTTPRequest]{
+		return httpexec.MassRequestContent[HTTPRequest]{
+			Req:          req,
+			Interval:     request.Interval,
+			ResponseWait: request.AwaitPrevResp,
+			ResChan:      resChan,
+			CountLimit:   request.Break.Count,
+			ResponseType: httpexec.ResponseType(request.ResponseType),
+			ArrivalRate:  arrivalRate,
+		}, nil, nil
+	}
+}
+
+func (r ValidMassExec) grpcExecutorFactory(
+	authFactor AuthenticatorFactor,
+	outFactor OutputFactor,
+	targetFactor TargetFactor,
+) massExecutorFactory {
+	return func(
+		ctx context.Context,
+		_ logger.Logger,
+		i int,
+		_ string,
+		request ValidMassExecRequest,
+		resChan chan<- httpexec.ResponseContent,
+		arrivalRate httpexec.ArrivalRate,
+	) (httpexec.MassRequestExecutor, output.Close, error) {
+		conn, err := grpcexec.Dial(request.URL)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to dial: %w", err)
+		}
+		source, err := request.Descriptor.Source(ctx, conn)
+		if err != nil {
+			_ = conn.Close()
+			return nil, nil, fmt.Errorf("failed to create descriptor source: %w", err)
+		}
+		req := GRPCRequest{
+			Service:      request.Service,
+			Method:       request.Method,
+			Headers:      request.Headers,
+			Body:         request.Body,
+			Auth:         r.Auth,
+			IsMass:       true,
+			TmplStr:      request.TmplStr,
+			ReplaceData:  request.ReplaceData,
+			OutputFactor: outFactor,
+			AuthFactor:   authFactor,
+			TargetFactor: targetFactor,
+			ReqIndex:     i,
+		}
+		return grpcexec.MassRequestContent[GRPCRequest]{
 			Req:          req,
+			Conn:         conn,
+			Source:       source,
 			Interval:     request.Interval,
 			ResponseWait: request.AwaitPrevResp,
 			ResChan:      resChan,
 			CountLimit:   request.Break.Count,
+			ArrivalRate:  arrivalRate,
+		}, conn.Close, nil
+	}
+}
+
+func (r ValidMassExec) socketExecutorFactory(
+	authFactor AuthenticatorFactor,
+	outFactor OutputFactor,
+	targetFactor TargetFactor,
+) massExecutorFactory {
+	return func(
+		_ context.Context,
+		_ logger.Logger,
+		i int,
+		_ string,
+		request ValidMassExecRequest,
+		resChan chan<- httpexec.ResponseContent,
+		arrivalRate httpexec.ArrivalRate,
+	) (httpexec.MassRequestExecutor, output.Close, error) {
+		req := SocketRequest{
+			PayloadType:  request.Socket.PayloadType,
+			Body:         request.Body,
+			IsMass:       true,
+			TmplStr:      request.TmplStr,
+			ReplaceData:  request.ReplaceData,
+			OutputFactor: outFactor,
+			AuthFactor:   authFactor,
+			TargetFactor: targetFactor,
+			ReqIndex:     i,
+		}
+		return sockexec.MassRequestContent[SocketRequest]{
+			Req:          req,
+			Network:      request.Socket.Network,
+			Address:      request.Socket.Address,
+			Framing:      request.Socket.Framing,
+			Timeout:      request.Socket.Timeout,
 			ResponseType: httpexec.ResponseType(request.ResponseType),
+			Interval:     request.Interval,
+			ResponseWait: request.AwaitPrevResp,
+			ResChan:      resChan,
+			CountLimit:   request.Break.Count,
+			ArrivalRate:  arrivalRate,
+		}, nil, nil
+	}
+}
+
+func (r ValidMassExec) webSocketExecutorFactory(
+	authFactor AuthenticatorFactor,
+	outFactor OutputFactor,
+	targetFactor TargetFactor,
+) massExecutorFactory {
+	return func(
+		ctx context.Context,
+		log logger.Logger,
+		i int,
+		uName string,
+		request ValidMassExecRequest,
+		resChan chan<- httpexec.ResponseContent,
+		_ httpexec.ArrivalRate,
+	) (httpexec.MassRequestExecutor, output.Close, error) {
+		wsURL, err := webSocketURL(request)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create url: %w", err)
+		}
+		header, err := webSocketHeader(ctx, request, r.Auth)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create header: %w", err)
+		}
+
+		var writers []output.HTTPDataWrite
+		var closers []output.Close
+		for _, o := range r.Output {
+			writer, closer, err := o.HTTPDataWriteFactory(
+				output.WithRequestIndex(ctx, i),
+				log,
+				true,
+				uName+"_connections",
+				[]string{
+					"ConnectionID",
+					"ConnectDatetime",
+					"CloseDatetime",
+					"Lifetime",
+					"HandshakeStatus",
+					"CloseCode",
+					"CloseReason",
+					"Sent",
+					"Received",
+				},
+			)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to create connection writer: %w", err)
+			}
+			writers = append(writers, writer)
+			closers = append(closers, closer)
+		}
+		var writeMu sync.Mutex
+		onClose := func(c wsexec.ConnectionContent) {
+			writeMu.Lock()
+			defer writeMu.Unlock()
+			for _, w := range writers {
+				if err := w(ctx, log, []any{
+					c.ID,
+					c.ConnectTime.Format(time.RFC3339Nano),
+					c.CloseTime.Format(time.RFC3339Nano),
+					c.CloseTime.Sub(c.ConnectTime).Milliseconds(),
+					c.HandshakeStatus,
+					c.CloseCode,
+					c.CloseReason,
+					c.Sent,
+					c.Received,
+				}); err != nil {
+					log.Error(ctx, "failed to write connection data",
+						logger.Value("error", err), logger.Value("on", "ValidMassExec.webSocketExecutorFactory"))
+				}
+			}
+		}
+
+		var replyMatcher wsexec.ReplyMatcher
+		if request.ReplyMatcher != nil {
+			replyMatcher = func(res any) (bool, error) {
+				_, match, err := request.ReplyMatcher(res)
+				return match, err
+			}
+		}
+		var running sync.WaitGroup
+		req := WebSocketRequest{
+			MessageType:  request.MessageType,
+			Body:         request.Body,
+			TmplStr:      request.TmplStr,
+			ReplaceData:  request.ReplaceData,
+			OutputFactor: outFactor,
+			AuthFactor:   authFactor,
+			TargetFactor: targetFactor,
+			ReqIndex:     i,
+		}
+		closer := func() error {
+			// the connections write their lifetime on close
+			running.Wait()
+			for _, c := range closers {
+				if err := c(); err != nil {
+					return fmt.Errorf("failed to close connection writer: %w", err)
+				}
+			}
+			return nil
+		}
+		return wsexec.MassRequestContent[WebSocketRequest]{
+			Req:          req,
+			URL:          wsURL,
+			Header:       header,
+			Connections:  request.Connections,
+			Interval:     request.Interval,
+			ResChan:      resChan,
+			CountLimit:   request.Break.Count,
+			ResponseType: httpexec.ResponseType(request.ResponseType),
+			ReplyMatcher: replyMatcher,
+			OnClose:      onClose,
+			Running:      &running,
+		}, closer, nil
+	}
+}
+
+func (r ValidMassExec) run(
+	ctx context.Context,
+	log logger.Logger,
+	outputRoot string,
+	newExecutor massExecutorFactory,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	concurrentCount := len(r.Requests)
+	threadExecutors := make([]*MassiveExecThreadExecutor, concurrentCount)
+	uniqueName := fmt.Sprintf("%s/%s", outputRoot, utils.GenerateUniqueID())
+
+	for i := 0; i < concurrentCount; i++ {
+		request := r.Requests[i]
+		threadExecutors[i] = &MassiveExecThreadExecutor{
+			ID:       i,
+			Stats:    NewExecStats(),
+			target:   request.TargetID,
+			endpoint: request.Endpoint,
+		}
+
+		resChan := make(chan httpexec.ResponseContent)
+		arrivalRate := request.ArrivalRate
+		if arrivalRate.Enabled {
+			arrivalRate.Stats = &httpexec.ArrivalRateStats{}
+		}
+		uName := fmt.Sprintf("%s_%d", uniqueName, i)
+		exe, exeCloser, err := newExecutor(ctx, log, i, uName, request, resChan, arrivalRate)
+		if err != nil {
+			return fmt.Errorf("failed to create executor: %w", err)
 		}
 
 		reqTermChan := make(chan struct{})
 		writers := make([]output.HTTPDataWrite, 0)
-		uName := fmt.Sprintf("%s_%d", uniqueName, i)
 		var writeCloser []output.Close
+		if exeCloser != nil {
+			writeCloser = append(writeCloser, exeCloser)
+		}
+		staged := len(request.ArrivalRate.Stages) > 0
+		header := []string{
+			"Success",
+			"SendDatetime",
+			"ReceivedDatetime",
+			"Count",
+			"ResponseTime",
+			"StatusCode",
+		}
+		if staged {
+			header = append(header, "Stage")
+		}
+		graphQL := request.BodyType == HTTPRequestBodyTypeGraphQL
+		if graphQL {
+			header = append(header, "Operation")
+		}
+		stream := httpexec.ResponseType(request.ResponseType).IsStream()
+		if stream {
+			header = append(header, "EventIndex", "EventName", "EventTime", "EventGap")
+		}
 		for _, o := range r.Output {
 			writer, closer, err := o.HTTPDataWriteFactory(
-				ctx,
+				output.WithRequestIndex(ctx, i),
 				log,
 				true,
 				uName,
-				append(
-					[]string{
-						"Success",
-						"SendDatetime",
-						"ReceivedDatetime",
-						"Count",
-						"ResponseTime",
-						"StatusCode",
-					},
-					request.Data.ExtractHeader()...,
-				),
+				append(header, request.Data.ExtractHeader()...),
 			)
 			if err != nil {
 				return fmt.Errorf("failed to create writer: %w", err)
@@ -457,6 +993,7 @@ func (r ValidMassExec) runHTTP(
 		threadExecutors[i].TermChan = termChan
 		threadExecutors[i].successBreak = request.SuccessBreak
 		threadExecutors[i].ReqTermChan = reqTermChan
+		threadExecutors[i].arrivalStats = arrivalRate.Stats
 
 		consumer := func(
 			ctx context.Context,
@@ -464,13 +1001,22 @@ func (r ValidMassExec) runHTTP(
 			_ int,
 			data WriteData,
 		) error {
-			var additionalData []string
+			var additionalData []any
+			if staged {
+				additionalData = append(additionalData, data.Stage)
+			}
+			if graphQL {
+				additionalData = append(additionalData, data.Operation)
+			}
+			if stream {
+				additionalData = append(additionalData, data.Event.ToSlice()...)
+			}
 			for _, d := range request.Data {
 				result, err := d.Extractor.Extract(data.RawData)
 				if err != nil {
 					return fmt.Errorf("failed to extract data: %w", err)
 				}
-				additionalData = append(additionalData, fmt.Sprint(result))
+				additionalData = append(additionalData, result)
 			}
 
 			for _, w := range writers {
@@ -491,6 +1037,7 @@ func (r ValidMassExec) runHTTP(
 			termChan,
 			resChan,
 			consumer,
+			threadExecutors[i].Stats,
 		)
 	}
 
@@ -519,15 +1066,55 @@ func (r ValidMassExec) runHTTP(
 	close(startChan)
 	wg.Wait()
 
+	summary, totalStats := r.summarize(outputRoot, threadExecutors)
+	summary.Print(os.Stdout)
+	if err := writeSummary(ctx, log, r.Output, outputRoot, summary); err != nil {
+		log.Error(ctx, "failed to write summary",
+			logger.Value("error", err), logger.Value("on", "ValidMassExec.run"))
+	}
+	collectStats(ctx, totalStats)
+
 	if syncErr := atomicErr.Load(); syncErr != nil {
 		log.Error(ctx, "failed to find error",
-			logger.Value("error", syncErr.Err), logger.Value("on", "ValidMassExec.runHTTP"))
+			logger.Value("error", syncErr.Err), logger.Value("on", "ValidMassExec.run"))
 		return syncErr.Err
 	}
 
+	violations := r.Thresholds.Evaluate(string(RunnerKindMassExecute), summary.Total)
+	if err := reportThresholdViolations(ctx, violations); err != nil {
+		return fmt.Errorf("failed to satisfy thresholds: %w", err)
+	}
+
 	return nil
 }
 
+// summarize builds the summary of the executors and returns it with the merged stats
+func (r ValidMassExec) summarize(outputRoot string, executors []*MassiveExecThreadExecutor) (RunSummary, *ExecStats) {
+	summary := RunSummary{
+		Kind:       RunnerKindMassExecute,
+		OutputRoot: outputRoot,
+	}
+	total := NewExecStats()
+	var arrivalTotal *ArrivalRateSummary
+	for _, exec := range executors {
+		total.Merge(exec.Stats)
+		reqSummary := exec.Stats.Summary(fmt.Sprintf("request[%d]", exec.ID))
+		reqSummary.TerminateType = exec.termType.termType.String()
+		reqSummary.TerminateParam = exec.termType.param
+		reqSummary.ArrivalRate = newArrivalRateSummary(exec.arrivalStats)
+		if reqSummary.ArrivalRate != nil {
+			if arrivalTotal == nil {
+				arrivalTotal = &ArrivalRateSummary{}
+			}
+			arrivalTotal.add(*reqSummary.ArrivalRate)
+		}
+		summary.Requests = append(summary.Requests, reqSummary)
+	}
+	summary.Total = total.Summary("total")
+	summary.Total.ArrivalRate = arrivalTotal
+	return summary, total
+}
+
 // TermChanType represents the type of termChan
 type TermChanType struct {
 	termType matcher.TerminateType
@@ -549,7 +1136,12 @@ type MassiveExecThreadExecutor struct {
 	TermChan        chan TermChanType
 	ReqTermChan     chan<- struct{}
 	successBreak    matcher.TerminateTypeAndParamsSlice
+	Stats           *ExecStats
 	closer          func() error
+	arrivalStats    *httpexec.ArrivalRateStats
+	termType        TermChanType
+	target          string
+	endpoint        string
 }
 
 // Execute executes the MassiveExecThreadExecutor
@@ -566,6 +1158,14 @@ func (e *MassiveExecThreadExecutor) Execute(
 		return nil
 	case <-startChan:
 	}
+	defer liveMetricsFrom(ctx).ThreadStarted()()
+	m := metrics.From(ctx)
+	defer m.ThreadStarted()()
+	if m != nil {
+		ctx = httpexec.WithSendHook(ctx, func() func() {
+			return m.RequestStarted(e.target, e.endpoint)
+		})
+	}
 
 	log.Info(ctx, "Execute Start",
 		logger.Value("ExecutorID", e.ID))
@@ -575,9 +1175,14 @@ func (e *MassiveExecThreadExecutor) Execute(
 	}
 
 	termType := <-e.TermChan
+	e.termType = termType
 	log.Info(ctx, "Execute End For Break",
 		logger.Value("ExecuteID", e.ID))
-	if e.successBreak.Match(termType.termType, termType.param) {
+	success := e.successBreak.Match(termType.termType, termType.param)
+	if termType.termType != matcher.TerminateTypeByContext {
+		m.BreakEvent(string(RunnerKindMassExecute), termType.termType.String(), success)
+	}
+	if success {
 		fmt.Println("Execute End For Success Break", termType.termType.String())
 		log.Info(ctx, "Execute End For Success Break", logger.Value("ExecuteID", e.ID))
 		return nil
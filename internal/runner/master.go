@@ -8,14 +8,15 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"sync"
+	"time"
 
-	rpc "buf.build/gen/go/cresplanex/bloader/grpc/go/cresplanex/bloader/v1/bloaderv1grpc"
-	pb "buf.build/gen/go/cresplanex/bloader/protocolbuffers/go/cresplanex/bloader/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
 	"github.com/ablankz/bloader/internal/encrypt"
 	"github.com/ablankz/bloader/internal/logger"
 	"github.com/ablankz/bloader/internal/master"
@@ -37,26 +38,246 @@ const (
 )
 
 // ConnectionMapData is a struct that holds the connection information.
+// The session on the slave is replaced when the connection is reconnected,
+// so the connection ID must be read through ConnectionID.
 type ConnectionMapData struct {
-	ConnectionID    string
+	SlaveID         string
+	Labels          map[string]string
+	Capacity        int
+	registeredID    string
+	liveView        *liveView
+	resourceMonitor *resourceMonitor
+	mu              *sync.RWMutex
+	connectionID    string
 	conn            *grpc.ClientConn
-	Cli             rpc.BloaderSlaveServiceClient
+	Cli             pb.BloaderSlaveServiceClient
 	ReqChan         <-chan *pb.ReceiveChanelConnectResponse
-	termChan        chan<- struct{}
+	reqChan         chan *pb.ReceiveChanelConnectResponse
+	termChan        chan struct{}
 	ReceiveTermChan <-chan ReceiveTermType
+	receiveTermChan chan ReceiveTermType
+	heartbeat       ValidSlaveConnectHeartbeat
+	cancelGrace     time.Duration
+	compatibility   SlaveCompatibility
+	health          SlaveHealth
+	session         *slaveSession
+	lostChan        chan struct{}
+	lostOnce        *sync.Once
+}
+
+// slaveSession represents a session opened on the slave by the Connect call
+type slaveSession struct {
+	done      chan struct{}
+	cancel    context.CancelFunc
+	abandoned bool
+}
+
+// SlaveCapacity returns the capacity of the slave, reported by the slave at connect time
+// or registered on the master
+func (d *ConnectionMapData) SlaveCapacity() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.Capacity
+}
+
+// ConnectionID returns the connection ID of the current session
+func (d *ConnectionMapData) ConnectionID() string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.connectionID
+}
+
+// SessionDone returns the channel closed when the current session is abandoned,
+// the commands running on the session can no longer complete.
+func (d *ConnectionMapData) SessionDone() <-chan struct{} {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.session.done
+}
+
+// Lost returns the channel closed when the slave is given up
+func (d *ConnectionMapData) Lost() <-chan struct{} {
+	return d.lostChan
+}
+
+// FailurePolicy returns the policy applied when the slave stops responding
+func (d *ConnectionMapData) FailurePolicy() SlaveFailurePolicy {
+	return d.heartbeat.OnFailure
+}
+
+// checkCompatibility compares the capabilities reported by the slave with the master.
+// The incompatible slave is refused unless the compatibility is warn.
+func (d *ConnectionMapData) checkCompatibility(ctx context.Context, log logger.Logger, c *pb.Capabilities) error {
+	remote, ok := NewCapabilitiesFromProto(c)
+	var problems, notes []string
+	if ok {
+		problems, notes = LocalCapabilities().Compatibility(remote)
+	} else {
+		problems = []string{"slave does not report its capabilities, it is built from an older version"}
+	}
+	for _, note := range notes {
+		log.Warn(ctx, fmt.Sprintf("slave %s: %s", d.SlaveID, note),
+			logger.Value("on", "ConnectionMapData.checkCompatibility"))
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	if d.compatibility == SlaveCompatibilityWarn {
+		for _, problem := range problems {
+			log.Warn(ctx, fmt.Sprintf("slave %s is incompatible: %s", d.SlaveID, problem),
+				logger.Value("on", "ConnectionMapData.checkCompatibility"))
+		}
+		return nil
+	}
+	return fmt.Errorf("slave %s is incompatible: %s", d.SlaveID, strings.Join(problems, "; "))
+}
+
+// open opens a new session on the slave and starts receiving the requests of the session
+func (d *ConnectionMapData) open(ctx context.Context, log logger.Logger, env string) error {
+	res, err := d.Cli.Connect(ctx, &pb.ConnectRequest{
+		Environment:  env,
+		Capabilities: LocalCapabilities().ToProto(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to connect to slave: %w", err)
+	}
+	if err := d.checkCompatibility(ctx, log, res.Capabilities); err != nil {
+		if _, disErr := d.Cli.Disconnect(ctx, &pb.DisconnectRequest{
+			ConnectionId: res.ConnectionId,
+		}); disErr != nil {
+			log.Warn(ctx, "failed to disconnect from incompatible slave",
+				logger.Value("error", disErr), logger.Value("slaveID", d.SlaveID),
+				logger.Value("on", "ConnectionMapData.open"))
+		}
+		return err
+	}
+
+	sessionCtx, cancel := context.WithCancel(ctx)
+	receiveStream, err := d.Cli.ReceiveChanelConnect(
+		sessionCtx,
+		&pb.ReceiveChanelConnectRequest{
+			ConnectionId: res.ConnectionId,
+		},
+	)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("failed to receive channel connect: %w", err)
+	}
+
+	d.mu.Lock()
+	d.connectionID = res.ConnectionId
+	if res.Capacity > 0 {
+		d.Capacity = int(res.Capacity)
+	}
+	d.session = &slaveSession{
+		done:   make(chan struct{}),
+		cancel: cancel,
+	}
+	d.mu.Unlock()
+
+	go d.receive(sessionCtx, log, receiveStream)
+	if d.liveView != nil {
+		go d.streamMetrics(sessionCtx, log, res.ConnectionId)
+	}
+	if d.resourceMonitor != nil {
+		go d.streamResources(sessionCtx, log, res.ConnectionId)
+	}
+
+	return nil
+}
+
+// abandonSession abandons the current session
+func (d *ConnectionMapData) abandonSession() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.session.abandoned {
+		return
+	}
+	d.session.abandoned = true
+	close(d.session.done)
+	d.session.cancel()
+}
+
+// receive forwards the requests from the slave to ReqChan until the session ends
+func (d *ConnectionMapData) receive(
+	ctx context.Context,
+	log logger.Logger,
+	receiveStream grpc.ServerStreamingClient[pb.ReceiveChanelConnectResponse],
+) {
+	for {
+		res, err := receiveStream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				log.Info(ctx, "context done")
+				return
+			}
+			select {
+			case <-d.termChan:
+				return
+			default:
+			}
+			termType := ReceiveTermTypeReceiveTermTypeResponseReceiveError
+			if errors.Is(err, io.EOF) {
+				log.Info(ctx, "receiveChan EOF")
+				termType = ReceiveTermTypeReceiveTermTypeEOF
+			} else {
+				log.Error(ctx, "failed to receive channel connect: %v",
+					logger.Value("error", err), logger.Value("slaveID", d.SlaveID))
+			}
+			if d.heartbeat.Enabled && d.heartbeat.OnFailure != SlaveFailurePolicyFail {
+				// the heartbeat decides whether the slave is lost
+				return
+			}
+			select {
+			case <-ctx.Done():
+				log.Info(ctx, "context done")
+			case d.receiveTermChan <- termType:
+			}
+			return
+		}
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "context done")
+			return
+		case <-d.termChan:
+			log.Info(ctx, "termChan")
+			select {
+			case <-ctx.Done():
+				log.Info(ctx, "context done")
+			case d.receiveTermChan <- ReceiveTermTypeReceiveTermTypeDisconnected:
+				log.Info(ctx, "receiveChan disconnected")
+			}
+			return
+		case d.reqChan <- res:
+		}
+	}
 }
 
 // ConnectionContainer is a struct that holds the connection information.
 type ConnectionContainer struct {
-	mu     *sync.RWMutex
-	conMap map[string]*ConnectionMapData // Key: slaveID
+	mu           *sync.RWMutex
+	conMap       map[string]*ConnectionMapData // Key: slaveID
+	registry     *master.Registry
+	liveView     *liveView
+	resources    *resourceMonitor
+	inprocServe  InprocServe
+	inprocSlaves map[string]*inprocSlave // Key: name
 }
 
 // NewConnectionContainer creates a new ConnectMap.
-func NewConnectionContainer() *ConnectionContainer {
+// The registry resolves the selector of the slaves, nil when the registration is not enabled.
+// The inprocServe starts the slaves of the "inproc://" URI, nil when they are not supported.
+func NewConnectionContainer(registry *master.Registry, inprocServe InprocServe) *ConnectionContainer {
 	return &ConnectionContainer{
-		mu:     &sync.RWMutex{},
-		conMap: make(map[string]*ConnectionMapData),
+		mu:           &sync.RWMutex{},
+		conMap:       make(map[string]*ConnectionMapData),
+		registry:     registry,
+		inprocServe:  inprocServe,
+		inprocSlaves: make(map[string]*inprocSlave),
 	}
 }
 
@@ -73,20 +294,22 @@ func (c *ConnectionContainer) Find(slaveID string) (*ConnectionMapData, bool) {
 	return conn, true
 }
 
-// Connect adds a connection to the map.
+// Connect adds a connection to the map, and returns the IDs of the connected slaves.
+// The slaves selected from the registry are identified by the ID suffixed with the index, like "eu-0".
 func (c *ConnectionContainer) Connect(
 	ctx context.Context,
 	log logger.Logger,
 	env string,
 	encryptCtr encrypt.Container,
 	conInfo ValidSlaveConnect,
+	outputRoot string,
 	eventCaster EventCaster,
-) error {
+) ([]string, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	if err := eventCaster.CastEvent(ctx, SlaveConnectRunnerEventConnecting); err != nil {
-		return fmt.Errorf("failed to cast event: %w", err)
+		return nil, fmt.Errorf("failed to cast event: %w", err)
 	}
 	defer func() {
 		if err := eventCaster.CastEvent(ctx, SlaveConnectRunnerEventConnected); err != nil {
@@ -94,155 +317,192 @@ func (c *ConnectionContainer) Connect(
 		}
 	}()
 
-	for _, slave := range conInfo.Slaves {
-		if _, ok := c.conMap[slave.ID]; ok {
-			return fmt.Errorf("connection already exists: %s", slave.ID)
+	var view *liveView
+	if conInfo.Metrics.Enabled {
+		// the slaves of all SlaveConnect are merged into the view started first
+		if c.liveView == nil {
+			c.liveView = newLiveView(conInfo.Metrics, outputRoot)
+			go c.liveView.run(ctx, log)
 		}
-		grpcDialOptions := []grpc.DialOption{}
-		if slave.Certificate.Enabled {
-			b, err := os.ReadFile(slave.Certificate.CACert)
-			if err != nil {
-				return fmt.Errorf("credentials: failed to read CA certificate: %w", err)
-			}
-			cp := x509.NewCertPool()
-			if !cp.AppendCertsFromPEM(b) {
-				return fmt.Errorf("credentials: failed to append certificates")
-			}
-			creds := credentials.NewTLS(&tls.Config{
-				ServerName: slave.Certificate.ServerNameOverride,
-				//nolint:gosec
-				InsecureSkipVerify: slave.Certificate.InsecureSkipVerify,
-				RootCAs:            cp,
-			})
-			grpcDialOptions = append(grpcDialOptions, grpc.WithTransportCredentials(creds))
-		} else {
-			grpcDialOptions = append(grpcDialOptions, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		view = c.liveView
+	}
+	var monitor *resourceMonitor
+	if conInfo.Resources.Enabled {
+		// the samples of all SlaveConnect are written by the monitor started first
+		if c.resources == nil {
+			c.resources = newResourceMonitor(conInfo.Resources, outputRoot)
+			go c.resources.run(ctx, log)
 		}
+		monitor = c.resources
+	}
 
-		if slave.Encrypt.Enabled {
-			encrypter, ok := encryptCtr[slave.Encrypt.EncryptID]
-			if !ok {
-				return fmt.Errorf("encrypter not found: %s", slave.Encrypt.EncryptID)
+	var slaveIDs []string
+	for _, slave := range conInfo.Slaves {
+		if !slave.Selector.Enabled {
+			if err := c.connect(ctx, log, env, encryptCtr, view, monitor, slave, slave.ID, master.RegisteredSlave{
+				URI:      slave.URI,
+				Capacity: DefaultSlaveCapacity,
+			}); err != nil {
+				return nil, err
 			}
-			grpcDialOptions = append(
-				grpcDialOptions,
-				grpc.WithUnaryInterceptor(master.UnaryClientEncryptInterceptor(encrypter)),
-				grpc.WithStreamInterceptor(master.StreamClientInterceptor(encrypter)),
-			)
+			slaveIDs = append(slaveIDs, slave.ID)
+			continue
 		}
 
-		conn, err := grpc.NewClient(slave.URI, grpcDialOptions...)
+		if c.registry == nil {
+			return nil, fmt.Errorf("selector requires the registration to be enabled on the server: %s", slave.ID)
+		}
+		claimed := make(map[string]struct{})
+		for _, mapData := range c.conMap {
+			if mapData.registeredID != "" {
+				claimed[mapData.registeredID] = struct{}{}
+			}
+		}
+		selected, err := c.registry.Select(
+			ctx,
+			slave.Selector.Labels,
+			slave.Selector.Count,
+			slave.Selector.WaitTimeout,
+			claimed,
+		)
 		if err != nil {
-			return fmt.Errorf("failed to connect to slave: %w", err)
+			return nil, fmt.Errorf("failed to select slaves for %s: %w", slave.ID, err)
 		}
-
-		cli := rpc.NewBloaderSlaveServiceClient(conn)
-
-		conReq := &pb.ConnectRequest{
-			Environment: env,
+		for i, registered := range selected {
+			slaveID := fmt.Sprintf("%s-%d", slave.ID, i)
+			log.Info(ctx, "selected registered slave",
+				logger.Value("slaveID", slaveID), logger.Value("registeredID", registered.ID),
+				logger.Value("uri", registered.URI), logger.Value("on", "ConnectionContainer.Connect"))
+			if err := c.connect(ctx, log, env, encryptCtr, view, monitor, slave, slaveID, registered); err != nil {
+				return nil, err
+			}
+			slaveIDs = append(slaveIDs, slaveID)
 		}
+	}
+
+	return slaveIDs, nil
+}
 
-		res, err := cli.Connect(ctx, conReq)
+// connect connects to the slave on the URI of the registered slave.
+// The ID of the registered slave is empty when the slave is connected by the URI.
+func (c *ConnectionContainer) connect(
+	ctx context.Context,
+	log logger.Logger,
+	env string,
+	encryptCtr encrypt.Container,
+	view *liveView,
+	monitor *resourceMonitor,
+	slave ValidSlaveConnectData,
+	slaveID string,
+	registered master.RegisteredSlave,
+) error {
+	if _, ok := c.conMap[slaveID]; ok {
+		return fmt.Errorf("connection already exists: %s", slaveID)
+	}
+	grpcDialOptions := []grpc.DialOption{}
+	if slave.Certificate.Enabled {
+		b, err := os.ReadFile(slave.Certificate.CACert)
 		if err != nil {
-			return fmt.Errorf("failed to connect to slave: %w", err)
+			return fmt.Errorf("credentials: failed to read CA certificate: %w", err)
+		}
+		cp := x509.NewCertPool()
+		if !cp.AppendCertsFromPEM(b) {
+			return fmt.Errorf("credentials: failed to append certificates")
 		}
+		tlsConfig := &tls.Config{
+			ServerName: slave.Certificate.ServerNameOverride,
+			//nolint:gosec
+			InsecureSkipVerify: slave.Certificate.InsecureSkipVerify,
+			RootCAs:            cp,
+		}
+		if slave.Certificate.ClientCert != "" {
+			cert, err := tls.LoadX509KeyPair(slave.Certificate.ClientCert, slave.Certificate.ClientKey)
+			if err != nil {
+				return fmt.Errorf("credentials: failed to load client certificate: %w", err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+		creds := credentials.NewTLS(tlsConfig)
+		grpcDialOptions = append(grpcDialOptions, grpc.WithTransportCredentials(creds))
+	} else {
+		grpcDialOptions = append(grpcDialOptions, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
 
-		receiveStream, err := cli.ReceiveChanelConnect(
-			ctx,
-			&pb.ReceiveChanelConnectRequest{
-				ConnectionId: res.ConnectionId,
-			},
+	if slave.Auth.Enabled {
+		grpcDialOptions = append(
+			grpcDialOptions,
+			grpc.WithChainUnaryInterceptor(master.UnaryClientTokenInterceptor(slave.Auth.Token)),
+			grpc.WithChainStreamInterceptor(master.StreamClientTokenInterceptor(slave.Auth.Token)),
+		)
+	}
+	if slave.Encrypt.Enabled {
+		encrypter, ok := encryptCtr[slave.Encrypt.EncryptID]
+		if !ok {
+			return fmt.Errorf("encrypter not found: %s", slave.Encrypt.EncryptID)
+		}
+		grpcDialOptions = append(
+			grpcDialOptions,
+			grpc.WithChainUnaryInterceptor(master.UnaryClientEncryptInterceptor(encrypter)),
+			grpc.WithChainStreamInterceptor(master.StreamClientInterceptor(encrypter)),
 		)
+	}
+
+	target := registered.URI
+	if name, ok := inprocName(registered.URI); ok {
+		dialOption, err := c.inprocDialOption(ctx, log, name)
 		if err != nil {
-			return fmt.Errorf("failed to receive channel connect: %w", err)
+			return err
 		}
+		target = "passthrough:///" + name
+		grpcDialOptions = append(grpcDialOptions, dialOption)
+	}
 
-		reqChan := make(chan *pb.ReceiveChanelConnectResponse)
-		receiveTermChan := make(chan ReceiveTermType)
-		termChan := make(chan struct{})
-
-		ctx, cancel := context.WithCancel(ctx)
-
-		go func() {
-			defer cancel()
-			defer close(reqChan)
-			defer close(receiveTermChan)
-
-			for {
-				res, err := receiveStream.Recv()
-				if errors.Is(err, io.EOF) {
-					log.Info(ctx, "receiveChan EOF")
-					select {
-					case <-ctx.Done():
-						log.Info(ctx, "context done")
-						return
-					case receiveTermChan <- ReceiveTermTypeReceiveTermTypeEOF:
-						log.Info(ctx, "receiveChan EOF")
-					}
-
-					return
-				}
-				if err != nil {
-					log.Error(ctx, "failed to receive channel connect: %v",
-						logger.Value("error", err), logger.Value("slaveID", slave.ID))
-					if err := receiveStream.CloseSend(); err != nil {
-						log.Error(ctx, "failed to close receiveChan: %v", logger.Value("error", err))
-					}
-					select {
-					case <-ctx.Done():
-						log.Info(ctx, "context done")
-						return
-					case receiveTermChan <- ReceiveTermTypeReceiveTermTypeResponseReceiveError:
-						log.Info(ctx, "receiveChan response receive error")
-					}
-
-					return
-				}
-				select {
-				case <-ctx.Done():
-					log.Info(ctx, "context done")
-					if err := receiveStream.CloseSend(); err != nil {
-						log.Error(ctx, "failed to close receiveChan: %v", logger.Value("error", err))
-					}
-					return
-				case <-receiveStream.Context().Done():
-					log.Info(ctx, "receiveChan context done")
-					select {
-					case <-ctx.Done():
-						log.Info(ctx, "context done")
-						return
-					case receiveTermChan <- ReceiveTermTypeReceiveTermTypeStreamContextDone:
-						log.Info(ctx, "receiveChan context done")
-					}
-					return
-				case <-termChan:
-					log.Info(ctx, "termChan")
-					if err := receiveStream.CloseSend(); err != nil {
-						log.Error(ctx, "failed to close receiveChan: %v", logger.Value("error", err))
-					}
-					select {
-					case <-ctx.Done():
-						log.Info(ctx, "context done")
-						return
-					case receiveTermChan <- ReceiveTermTypeReceiveTermTypeDisconnected:
-						log.Info(ctx, "receiveChan disconnected")
-					}
-					return
-				case reqChan <- res:
-				}
-			}
-		}()
-
-		c.conMap[slave.ID] = &ConnectionMapData{
-			ConnectionID:    res.ConnectionId,
-			conn:            conn,
-			Cli:             cli,
-			ReqChan:         reqChan,
-			termChan:        termChan,
-			ReceiveTermChan: receiveTermChan,
+	conn, err := grpc.NewClient(target, grpcDialOptions...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to slave: %w", err)
+	}
+
+	reqChan := make(chan *pb.ReceiveChanelConnectResponse)
+	receiveTermChan := make(chan ReceiveTermType)
+	mapData := &ConnectionMapData{
+		SlaveID:         slaveID,
+		Labels:          registered.Labels,
+		Capacity:        registered.Capacity,
+		registeredID:    registered.ID,
+		liveView:        view,
+		resourceMonitor: monitor,
+		mu:              &sync.RWMutex{},
+		conn:            conn,
+		Cli:             pb.NewBloaderSlaveServiceClient(conn),
+		ReqChan:         reqChan,
+		reqChan:         reqChan,
+		termChan:        make(chan struct{}),
+		ReceiveTermChan: receiveTermChan,
+		receiveTermChan: receiveTermChan,
+		heartbeat:       slave.Heartbeat,
+		cancelGrace:     slave.CancelGracePeriod,
+		compatibility:   slave.Compatibility,
+		health: SlaveHealth{
+			State:         SlaveHealthStateHealthy,
+			LastHeartbeat: time.Now(),
+		},
+		lostChan: make(chan struct{}),
+		lostOnce: &sync.Once{},
+	}
+	if err := mapData.open(ctx, log, env); err != nil {
+		if closeErr := conn.Close(); closeErr != nil {
+			log.Warn(ctx, "failed to close connection",
+				logger.Value("error", closeErr), logger.Value("slaveID", slaveID),
+				logger.Value("on", "ConnectionContainer.connect"))
 		}
+		return err
+	}
+	if slave.Heartbeat.Enabled {
+		go mapData.runHeartbeat(ctx, log, env)
 	}
 
+	c.conMap[slaveID] = mapData
+
 	return nil
 }
 
@@ -253,13 +513,15 @@ func (c *ConnectionContainer) disconnect(slaveID string) error {
 		return fmt.Errorf("connection not found: %s", slaveID)
 	}
 	close(conn.termChan)
-	disReq := &pb.DisconnectRequest{
-		ConnectionId: conn.ConnectionID,
-	}
+	if conn.Health().State != SlaveHealthStateLost {
+		disReq := &pb.DisconnectRequest{
+			ConnectionId: conn.ConnectionID(),
+		}
 
-	_, err := conn.Cli.Disconnect(context.Background(), disReq)
-	if err != nil {
-		return fmt.Errorf("failed to disconnect from slave: %w", err)
+		_, err := conn.Cli.Disconnect(context.Background(), disReq)
+		if err != nil {
+			return fmt.Errorf("failed to disconnect from slave: %w", err)
+		}
 	}
 	if err := conn.conn.Close(); err != nil {
 		return fmt.Errorf("failed to close connection: %w", err)
@@ -274,6 +536,13 @@ func (c *ConnectionContainer) AllDisconnect(_ context.Context) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	if c.liveView != nil {
+		c.liveView.stop()
+	}
+	if c.resources != nil {
+		c.resources.stop()
+	}
+	defer c.stopInprocSlaves()
 	for slaveID := range c.conMap {
 		if err := c.disconnect(slaveID); err != nil {
 			return fmt.Errorf("failed to disconnect from slave: %w", err)
@@ -33,6 +33,8 @@ const (
 	TerminateTypeByResponseBody TerminateType = "responseBody"
 	// TerminateTypeByStatusCode represents the status code type
 	TerminateTypeByStatusCode TerminateType = "statusCode"
+	// TerminateTypeByGraphQLError represents the GraphQL error type
+	TerminateTypeByGraphQLError TerminateType = "graphqlError"
 )
 
 // String returns the string representation of the terminate type
@@ -77,6 +79,8 @@ func NewTerminateTypeFromString(s string) (TerminateTypeAndParams, error) {
 		return NewTerminateTypeAndParams(TerminateTypeByCreateRequestError, nil), nil
 	case TerminateTypeByParseResponseError:
 		return NewTerminateTypeAndParams(TerminateTypeByParseResponseError, nil), nil
+	case TerminateTypeByGraphQLError:
+		return NewTerminateTypeAndParams(TerminateTypeByGraphQLError, params), nil
 	case TerminateTypeByWriteError:
 		return NewTerminateTypeAndParams(TerminateTypeByWriteError, nil), nil
 	case TerminateTypeByTimeout:
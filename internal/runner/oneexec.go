@@ -7,7 +7,10 @@ import (
 	"sync"
 
 	"github.com/ablankz/bloader/internal/auth"
+	"github.com/ablankz/bloader/internal/config"
+	"github.com/ablankz/bloader/internal/executor/grpcexec"
 	"github.com/ablankz/bloader/internal/executor/httpexec"
+	"github.com/ablankz/bloader/internal/executor/sockexec"
 	"github.com/ablankz/bloader/internal/logger"
 	"github.com/ablankz/bloader/internal/output"
 	"github.com/ablankz/bloader/internal/utils"
@@ -19,6 +22,10 @@ type OneExecType string
 const (
 	// OneExecTypeHTTP represents the HTTP type
 	OneExecTypeHTTP OneExecType = "http"
+	// OneExecTypeGRPC represents the gRPC type
+	OneExecTypeGRPC OneExecType = "grpc"
+	// OneExecTypeSocket represents the raw TCP/UDP socket type
+	OneExecTypeSocket OneExecType = "socket"
 )
 
 // OneExec represents the OneExec runner
@@ -49,7 +56,7 @@ func (r OneExec) Validate(
 		return ValidOneExec{}, fmt.Errorf("type is required")
 	}
 	switch OneExecType(*r.Type) {
-	case OneExecTypeHTTP:
+	case OneExecTypeHTTP, OneExecTypeGRPC, OneExecTypeSocket:
 		oneExecType = OneExecType(*r.Type)
 	default:
 		return ValidOneExec{}, fmt.Errorf("invalid type value: %s", *r.Type)
@@ -67,7 +74,7 @@ func (r OneExec) Validate(
 	if r.Request == nil {
 		return ValidOneExec{}, fmt.Errorf("request is required")
 	}
-	validRequest, err := r.Request.Validate(ctx, targetFactor)
+	validRequest, err := r.Request.Validate(ctx, oneExecType, targetFactor)
 	if err != nil {
 		return ValidOneExec{}, fmt.Errorf("failed to validate request: %w", err)
 	}
@@ -136,6 +143,10 @@ type OneExecRequest struct {
 	TargetID      *string                `yaml:"target_id"`
 	Endpoint      *string                `yaml:"endpoint"`
 	Method        *string                `yaml:"method"`
+	Service       *string                `yaml:"service"`
+	Descriptor    GRPCDescriptor         `yaml:"descriptor"`
+	Framing       SocketFraming          `yaml:"framing"`
+	Timeout       *string                `yaml:"timeout"`
 	QueryParam    map[string]any         `yaml:"query_param"`
 	PathVariables map[string]string      `yaml:"path_variables"`
 	Headers       map[string]any         `yaml:"headers"`
@@ -151,6 +162,9 @@ type OneExecRequest struct {
 type ValidOneExecRequest struct {
 	URL           string
 	Method        string
+	Service       string
+	Descriptor    ValidGRPCDescriptor
+	Socket        ValidSocketOptions
 	QueryParam    map[string]any
 	PathVariables map[string]string
 	Headers       map[string]any
@@ -163,45 +177,95 @@ type ValidOneExecRequest struct {
 }
 
 // Validate validates the OneExecRequest
-func (r OneExecRequest) Validate(ctx context.Context, targetFactor TargetFactor) (ValidOneExecRequest, error) {
+func (r OneExecRequest) Validate(
+	ctx context.Context,
+	execType OneExecType,
+	targetFactor TargetFactor,
+) (ValidOneExecRequest, error) {
 	var valid ValidOneExecRequest
-	var err error
 	if r.TargetID == nil {
 		return ValidOneExecRequest{}, fmt.Errorf("target_id is required")
 	}
-	if r.Endpoint == nil {
-		return ValidOneExecRequest{}, fmt.Errorf("endpoint is required")
-	}
-	var urlRoot string
-	tg, err := targetFactor.Factorize(ctx, *r.TargetID)
-	if err != nil {
-		return ValidOneExecRequest{}, fmt.Errorf("failed to factorize target: %w", err)
-	}
-	urlRoot = tg.URL
-	valid.URL = fmt.Sprintf("%s%s", urlRoot, *r.Endpoint)
-	if r.Method == nil {
-		return ValidOneExecRequest{}, fmt.Errorf("method is required")
-	}
-	valid.Method = *r.Method
+	switch execType {
+	case OneExecTypeGRPC:
+		tg, err := targetFactor.Factorize(ctx, *r.TargetID)
+		if err != nil {
+			return ValidOneExecRequest{}, fmt.Errorf("failed to factorize target: %w", err)
+		}
+		if tg.Type != config.TargetTypeGRPC {
+			return ValidOneExecRequest{}, fmt.Errorf("target %s is not a grpc target", *r.TargetID)
+		}
+		valid.URL = tg.URL
+		if r.Service == nil {
+			return ValidOneExecRequest{}, fmt.Errorf("service is required")
+		}
+		valid.Service = *r.Service
+		if r.Method == nil {
+			return ValidOneExecRequest{}, fmt.Errorf("method is required")
+		}
+		valid.Method = *r.Method
+		if valid.Descriptor, err = r.Descriptor.Validate(); err != nil {
+			return ValidOneExecRequest{}, fmt.Errorf("failed to validate descriptor: %w", err)
+		}
+		valid.Headers = r.Headers
+		valid.Body = r.Body
+	case OneExecTypeSocket:
+		var err error
+		if valid.Socket, err = validateSocketOptions(
+			ctx,
+			targetFactor,
+			*r.TargetID,
+			r.BodyType,
+			r.Body,
+			r.Framing,
+			r.Timeout,
+		); err != nil {
+			return ValidOneExecRequest{}, fmt.Errorf("failed to validate socket: %w", err)
+		}
+		valid.Body = r.Body
+		if valid.ResponseType, err = validateSocketResponseType(r.ResponseType); err != nil {
+			return ValidOneExecRequest{}, err
+		}
+	default:
+		if r.Endpoint == nil {
+			return ValidOneExecRequest{}, fmt.Errorf("endpoint is required")
+		}
+		var urlRoot string
+		tg, err := targetFactor.Factorize(ctx, *r.TargetID)
+		if err != nil {
+			return ValidOneExecRequest{}, fmt.Errorf("failed to factorize target: %w", err)
+		}
+		urlRoot = tg.URL
+		valid.URL = fmt.Sprintf("%s%s", urlRoot, *r.Endpoint)
+		if r.Method == nil {
+			return ValidOneExecRequest{}, fmt.Errorf("method is required")
+		}
+		valid.Method = *r.Method
 
-	valid.QueryParam = r.QueryParam
-	valid.PathVariables = r.PathVariables
-	valid.Headers = r.Headers
-	valid.Body = r.Body
-	if r.BodyType == nil {
-		valid.BodyType = DefaultHTTPRequestBodyType
-	} else {
-		switch HTTPRequestBodyType(*r.BodyType) {
-		case HTTPRequestBodyTypeJSON, HTTPRequestBodyTypeForm, HTTPRequestBodyTypeMultipart:
-			valid.BodyType = HTTPRequestBodyTypeJSON
-		default:
-			return ValidOneExecRequest{}, fmt.Errorf("invalid body_type value: %s", *r.BodyType)
+		valid.QueryParam = r.QueryParam
+		valid.PathVariables = r.PathVariables
+		valid.Headers = r.Headers
+		valid.Body = r.Body
+		if r.BodyType == nil {
+			valid.BodyType = DefaultHTTPRequestBodyType
+		} else {
+			switch HTTPRequestBodyType(*r.BodyType) {
+			case HTTPRequestBodyTypeJSON, HTTPRequestBodyTypeForm, HTTPRequestBodyTypeMultipart:
+				valid.BodyType = HTTPRequestBodyTypeJSON
+			case HTTPRequestBodyTypeGraphQL:
+				if _, err := NewGraphQLBody(r.Body); err != nil {
+					return ValidOneExecRequest{}, fmt.Errorf("failed to validate graphql body: %w", err)
+				}
+				valid.BodyType = HTTPRequestBodyTypeGraphQL
+			default:
+				return ValidOneExecRequest{}, fmt.Errorf("invalid body_type value: %s", *r.BodyType)
+			}
 		}
+		if r.ResponseType == nil {
+			return ValidOneExecRequest{}, fmt.Errorf("response_type is required")
+		}
+		valid.ResponseType = *r.ResponseType
 	}
-	if r.ResponseType == nil {
-		return ValidOneExecRequest{}, fmt.Errorf("response_type is required")
-	}
-	valid.ResponseType = *r.ResponseType
 	for _, d := range r.Data {
 		validData, err := d.Validate()
 		if err != nil {
@@ -237,6 +301,10 @@ func (r ValidOneExec) Run(
 	switch r.Type {
 	case OneExecTypeHTTP:
 		return r.runHTTP(ctx, outputRoot, str, log, store)
+	case OneExecTypeGRPC:
+		return r.runGRPC(ctx, outputRoot, str, log, store)
+	case OneExecTypeSocket:
+		return r.runSocket(ctx, outputRoot, str, log, store)
 	}
 	return nil
 }
@@ -266,6 +334,70 @@ func (r ValidOneExec) runHTTP(
 		ResponseType: httpexec.ResponseType(r.Request.ResponseType),
 	}
 
+	return r.run(ctx, outputRoot, str, log, store, exe)
+}
+
+func (r ValidOneExec) runGRPC(
+	ctx context.Context,
+	outputRoot string,
+	str *sync.Map,
+	log logger.Logger,
+	store Store,
+) error {
+	conn, err := grpcexec.Dial(r.Request.URL)
+	if err != nil {
+		return fmt.Errorf("failed to dial: %w", err)
+	}
+	defer conn.Close()
+	source, err := r.Request.Descriptor.Source(ctx, conn)
+	if err != nil {
+		return fmt.Errorf("failed to create descriptor source: %w", err)
+	}
+	exe := grpcexec.RequestContent[GRPCRequest]{
+		Req: GRPCRequest{
+			Service: r.Request.Service,
+			Method:  r.Request.Method,
+			Headers: r.Request.Headers,
+			Body:    r.Request.Body,
+			Auth:    r.Auth,
+		},
+		Conn:   conn,
+		Source: source,
+	}
+
+	return r.run(ctx, outputRoot, str, log, store, exe)
+}
+
+func (r ValidOneExec) runSocket(
+	ctx context.Context,
+	outputRoot string,
+	str *sync.Map,
+	log logger.Logger,
+	store Store,
+) error {
+	exe := sockexec.RequestContent[SocketRequest]{
+		Req: SocketRequest{
+			PayloadType: r.Request.Socket.PayloadType,
+			Body:        r.Request.Body,
+		},
+		Network:      r.Request.Socket.Network,
+		Address:      r.Request.Socket.Address,
+		Framing:      r.Request.Socket.Framing,
+		Timeout:      r.Request.Socket.Timeout,
+		ResponseType: httpexec.ResponseType(r.Request.ResponseType),
+	}
+
+	return r.run(ctx, outputRoot, str, log, store, exe)
+}
+
+func (r ValidOneExec) run(
+	ctx context.Context,
+	outputRoot string,
+	str *sync.Map,
+	log logger.Logger,
+	store Store,
+	exe httpexec.RequestExecutor,
+) error {
 	writers := make([]output.HTTPDataWrite, 0)
 	uniqueName := fmt.Sprintf("%s/%s", outputRoot, utils.GenerateUniqueID())
 	for _, o := range r.Output {
@@ -297,13 +429,13 @@ func (r ValidOneExec) runHTTP(
 	if err != nil {
 		return fmt.Errorf("failed to execute request: %w", err)
 	}
-	var data []string
+	var data []any
 	for _, d := range r.Request.Data {
 		result, err := d.Extractor.Extract(resp.Res)
 		if err != nil {
 			return fmt.Errorf("failed to extract data: %w", err)
 		}
-		data = append(data, fmt.Sprint(result))
+		data = append(data, result)
 	}
 	for _, w := range writers {
 		if err := w(ctx, log, append(resp.ToWriteHTTPData().ToSlice(), data...)); err != nil {
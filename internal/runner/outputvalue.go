@@ -0,0 +1,104 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
+	"github.com/ablankz/bloader/internal/output"
+)
+
+// OutputValuesToProto converts the values of the http data to send them to the master with their types.
+// The values other than the strings, the booleans and the numbers are sent as json.
+func OutputValuesToProto(data []any) []*pb.CallExecOutputValue {
+	values := make([]*pb.CallExecOutputValue, len(data))
+	for i, v := range data {
+		value := &pb.CallExecOutputValue{}
+		switch v := v.(type) {
+		case nil:
+		case string:
+			value.Value = &pb.CallExecOutputValue_StringValue{StringValue: v}
+		case bool:
+			value.Value = &pb.CallExecOutputValue_BoolValue{BoolValue: v}
+		case int:
+			value.Value = &pb.CallExecOutputValue_IntValue{IntValue: int64(v)}
+		case int32:
+			value.Value = &pb.CallExecOutputValue_IntValue{IntValue: int64(v)}
+		case int64:
+			value.Value = &pb.CallExecOutputValue_IntValue{IntValue: v}
+		case float32:
+			value.Value = &pb.CallExecOutputValue_DoubleValue{DoubleValue: float64(v)}
+		case float64:
+			value.Value = &pb.CallExecOutputValue_DoubleValue{DoubleValue: v}
+		default:
+			b, err := json.Marshal(v)
+			if err != nil {
+				value.Value = &pb.CallExecOutputValue_StringValue{StringValue: fmt.Sprint(v)}
+				break
+			}
+			value.Value = &pb.CallExecOutputValue_JsonValue{JsonValue: string(b)}
+		}
+		values[i] = value
+	}
+	return values
+}
+
+// OutputValuesFromProto converts the values of the http data sent from the slave.
+// The http data of the slave without the typed output is read from the strings.
+func OutputValuesFromProto(httpOut *pb.CallExecOutputHTTP) []any {
+	if len(httpOut.Values) == 0 {
+		data := make([]any, len(httpOut.Data))
+		for i, v := range httpOut.Data {
+			data[i] = v
+		}
+		return data
+	}
+	data := make([]any, len(httpOut.Values))
+	for i, v := range httpOut.Values {
+		switch v := v.Value.(type) {
+		case *pb.CallExecOutputValue_StringValue:
+			data[i] = v.StringValue
+		case *pb.CallExecOutputValue_BoolValue:
+			data[i] = v.BoolValue
+		case *pb.CallExecOutputValue_IntValue:
+			data[i] = v.IntValue
+		case *pb.CallExecOutputValue_DoubleValue:
+			data[i] = v.DoubleValue
+		case *pb.CallExecOutputValue_JsonValue:
+			var value any
+			if err := json.Unmarshal([]byte(v.JsonValue), &value); err != nil {
+				data[i] = v.JsonValue
+				break
+			}
+			data[i] = value
+		}
+	}
+	return data
+}
+
+// OutputSourceToProto converts the source of the http data to send it to the master
+func OutputSourceToProto(src output.Source) *pb.CallExecOutputSource {
+	return &pb.CallExecOutputSource{
+		FlowId:       src.FlowID,
+		Loader:       src.Loader,
+		RequestIndex: int64(src.RequestIndex),
+	}
+}
+
+// withSlaveOutputSource returns the context carrying the source of the http data sent by the slave.
+// The source of the master is kept for the fields the slave does not send.
+func withSlaveOutputSource(ctx context.Context, slaveID string, src *pb.CallExecOutputSource) context.Context {
+	s := output.SourceFrom(ctx)
+	s.SlaveID = slaveID
+	if src != nil {
+		if src.FlowId != "" {
+			s.FlowID = src.FlowId
+		}
+		if src.Loader != "" {
+			s.Loader = src.Loader
+		}
+		s.RequestIndex = int(src.RequestIndex)
+	}
+	return output.WithSource(ctx, s)
+}
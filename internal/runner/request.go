@@ -16,10 +16,13 @@ import (
 	"text/template"
 
 	"github.com/Masterminds/sprig/v3"
+	"go.opentelemetry.io/otel/attribute"
 	"gopkg.in/yaml.v3"
 
 	"github.com/ablankz/bloader/internal/executor/httpexec"
 	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/output"
+	"github.com/ablankz/bloader/internal/tracing"
 )
 
 // HTTPRequestBodyType represents the HTTP request body type
@@ -32,11 +35,50 @@ const (
 	HTTPRequestBodyTypeForm HTTPRequestBodyType = "form"
 	// HTTPRequestBodyTypeMultipart represents the multipart body type
 	HTTPRequestBodyTypeMultipart HTTPRequestBodyType = "multipart"
+	// HTTPRequestBodyTypeGraphQL represents the GraphQL body type
+	HTTPRequestBodyTypeGraphQL HTTPRequestBodyType = "graphql"
 
 	// DefaultHTTPRequestBodyType represents the default HTTP request body type
 	DefaultHTTPRequestBodyType = HTTPRequestBodyTypeJSON
 )
 
+// GraphQLBody represents the body of the GraphQL body type
+type GraphQLBody struct {
+	Query         string         `json:"query"`
+	Variables     map[string]any `json:"variables,omitempty"`
+	OperationName string         `json:"operationName,omitempty"`
+}
+
+// NewGraphQLBody creates a new GraphQLBody from the body with query, variables and operation_name
+func NewGraphQLBody(body any) (GraphQLBody, error) {
+	m, ok := body.(map[string]any)
+	if !ok {
+		return GraphQLBody{}, fmt.Errorf("body of graphql must be an object")
+	}
+	var valid GraphQLBody
+	if valid.Query, ok = m["query"].(string); !ok || valid.Query == "" {
+		return GraphQLBody{}, fmt.Errorf("query is required")
+	}
+	if v, exists := m["variables"]; exists && v != nil {
+		if valid.Variables, ok = v.(map[string]any); !ok {
+			return GraphQLBody{}, fmt.Errorf("variables must be an object")
+		}
+	}
+	if v, exists := m["operation_name"]; exists && v != nil {
+		if valid.OperationName, ok = v.(string); !ok {
+			return GraphQLBody{}, fmt.Errorf("operation_name must be a string")
+		}
+	}
+	for key := range m {
+		switch key {
+		case "query", "variables", "operation_name":
+		default:
+			return GraphQLBody{}, fmt.Errorf("invalid field of graphql body: %s", key)
+		}
+	}
+	return valid, nil
+}
+
 // AttachRequestInfo represents the request info
 type AttachRequestInfo func(ctx context.Context, req *http.Request) error
 
@@ -56,6 +98,9 @@ type HTTPRequest struct {
 	AuthFactor        AuthenticatorFactor
 	TargetFactor      TargetFactor
 	IsMass            bool
+	IsVirtualUser     bool
+	VUID              int
+	VUValues          *sync.Map
 	ReqIndex          int
 }
 
@@ -66,62 +111,118 @@ func solvePathVariables(path string, pathVariables map[string]string) string {
 	return path
 }
 
-// CreateRequest creates the http.Request object for the query
-func (r HTTPRequest) CreateRequest(ctx context.Context, log logger.Logger, count int) (*http.Request, error) {
-	if r.IsMass {
-		replaceData := make(map[string]any)
-		dynamicData := make(map[string]any)
-		r.ReplaceData.Range(func(key, value any) bool {
-			keyStr, ok := key.(string)
-			if !ok {
-				return true
-			}
-			if keyStr == "Dynamic" {
-				if mapV, ok := value.(map[string]any); ok {
-					for k, v := range mapV {
-						dynamicData[k] = v
-					}
+// newDynamicReplaceData copies the replace data and sets the dynamic values of the request.
+// The returned dynamic data is referenced by the replace data, so it can be extended afterwards.
+func newDynamicReplaceData(data *sync.Map, count, stage int) (map[string]any, map[string]any) {
+	replaceData := make(map[string]any)
+	dynamicData := make(map[string]any)
+	data.Range(func(key, value any) bool {
+		keyStr, ok := key.(string)
+		if !ok {
+			return true
+		}
+		if keyStr == "Dynamic" {
+			if mapV, ok := value.(map[string]any); ok {
+				for k, v := range mapV {
+					dynamicData[k] = v
 				}
-			} else {
-				replaceData[keyStr] = value
 			}
-			return true
-		})
-		dynamicData["RequestLoopCount"] = count
-		replaceData["Dynamic"] = dynamicData
-		tmpl, err := template.New("yaml").Funcs(sprig.TxtFuncMap()).Parse(r.TmplStr)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse yaml: %w", err)
-		}
-		var buffer bytes.Buffer
-		if err := tmpl.Execute(&buffer, replaceData); err != nil {
-			return nil, fmt.Errorf("failed to execute template: %w", err)
-		}
-		var massExec MassExec
-		if err := yaml.Unmarshal(buffer.Bytes(), &massExec); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal json: %w", err)
-		}
-		validMassExec, err := massExec.Validate(
-			ctx,
-			log,
-			r.AuthFactor,
-			r.OutputFactor,
-			r.TargetFactor,
-			r.TmplStr,
-			replaceData,
-		)
+		} else {
+			replaceData[keyStr] = value
+		}
+		return true
+	})
+	dynamicData["RequestLoopCount"] = count
+	dynamicData["Stage"] = stage
+	replaceData["Dynamic"] = dynamicData
+	return replaceData, dynamicData
+}
+
+// executeTmpl renders the loader template with the replace data
+func executeTmpl(tmplStr string, replaceData map[string]any) ([]byte, error) {
+	tmpl, err := template.New("yaml").Funcs(sprig.TxtFuncMap()).Parse(tmplStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse yaml: %w", err)
+	}
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, replaceData); err != nil {
+		return nil, fmt.Errorf("failed to execute template: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+// CreateRequest creates the http.Request object for the query
+func (r HTTPRequest) CreateRequest(ctx context.Context, log logger.Logger, count, stage int) (*http.Request, error) {
+	if r.IsMass || r.IsVirtualUser {
+		replaceData, dynamicData := newDynamicReplaceData(r.ReplaceData, count, stage)
+		if r.IsVirtualUser {
+			dynamicData["VUID"] = r.VUID
+			vuValues := make(map[string]any)
+			r.VUValues.Range(func(key, value any) bool {
+				if keyStr, ok := key.(string); ok {
+					vuValues[keyStr] = value
+				}
+				return true
+			})
+			replaceData["VUValues"] = vuValues
+		}
+		buffer, err := executeTmpl(r.TmplStr, replaceData)
 		if err != nil {
-			return nil, fmt.Errorf("failed to validate mass exec: %w", err)
+			return nil, err
 		}
-		request := validMassExec.Requests[r.ReqIndex]
+		if r.IsVirtualUser {
+			var virtualUsers VirtualUsers
+			if err := yaml.Unmarshal(buffer, &virtualUsers); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal json: %w", err)
+			}
+			validVirtualUsers, err := virtualUsers.Validate(
+				ctx,
+				log,
+				r.AuthFactor,
+				r.OutputFactor,
+				r.TargetFactor,
+				r.TmplStr,
+				replaceData,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to validate virtual users: %w", err)
+			}
+			request := validVirtualUsers.Requests[r.ReqIndex]
+
+			r.URL = request.URL
+			r.Method = request.Method
+			r.Headers = request.Headers
+			r.QueryParams = request.QueryParams
+			r.PathVariables = request.PathVariables
+			r.BodyType = request.BodyType
+			r.Body = request.Body
+		} else {
+			var massExec MassExec
+			if err := yaml.Unmarshal(buffer, &massExec); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal json: %w", err)
+			}
+			validMassExec, err := massExec.Validate(
+				ctx,
+				log,
+				r.AuthFactor,
+				r.OutputFactor,
+				r.TargetFactor,
+				r.TmplStr,
+				replaceData,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to validate mass exec: %w", err)
+			}
+			request := validMassExec.Requests[r.ReqIndex]
 
-		r.URL = request.URL
-		r.Method = request.Method
-		r.Headers = request.Headers
-		r.QueryParams = request.QueryParams
-		r.PathVariables = request.PathVariables
-		r.BodyType = request.BodyType
-		r.Body = request.Body
+			r.URL = request.URL
+			r.Method = request.Method
+			r.Headers = request.Headers
+			r.QueryParams = request.QueryParams
+			r.PathVariables = request.PathVariables
+			r.BodyType = request.BodyType
+			r.Body = request.Body
+		}
 	}
 
 	reqURL := solvePathVariables(r.URL, r.PathVariables)
@@ -145,7 +246,20 @@ func (r HTTPRequest) CreateRequest(ctx context.Context, log logger.Logger, count
 
 	var body io.Reader
 	header := http.Header{}
+	var graphQLBody *GraphQLBody
 	switch r.BodyType {
+	case HTTPRequestBodyTypeGraphQL:
+		gb, err := NewGraphQLBody(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create graphql body: %w", err)
+		}
+		bodyBytes, err := json.Marshal(gb)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		body = bytes.NewReader(bodyBytes)
+		header.Set("Content-Type", "application/json")
+		graphQLBody = &gb
 	case HTTPRequestBodyTypeJSON:
 		if r.Body == nil {
 			break
@@ -237,6 +351,21 @@ func (r HTTPRequest) CreateRequest(ctx context.Context, log logger.Logger, count
 		}
 		req.Header.Set(key, fmt.Sprint(value))
 	}
+	if graphQLBody != nil {
+		req = httpexec.WithGraphQLOperation(req, graphQLBody.OperationName)
+	}
+
+	src := output.SourceFrom(ctx)
+	attrs := []attribute.KeyValue{
+		tracing.FlowIDKey.String(src.FlowID),
+		tracing.LoaderKey.String(src.Loader),
+		tracing.RequestIndexKey.Int(r.ReqIndex),
+		tracing.CountKey.Int(count),
+	}
+	if r.IsVirtualUser {
+		attrs = append(attrs, tracing.VUIDKey.Int(r.VUID))
+	}
+	req = tracing.StartRequestSpan(ctx, req, attrs...)
 
 	return req, nil
 }
@@ -0,0 +1,256 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/shirou/gopsutil/v3/cpu"
+	"github.com/shirou/gopsutil/v3/net"
+	"github.com/shirou/gopsutil/v3/process"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
+	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/output"
+)
+
+// ResourceNodeMaster represents the node name of the master in the resource samples
+const ResourceNodeMaster = "master"
+
+// ResourceSample represents the resource usage of a load generator at a point in time.
+// The values unavailable on the platform are 0.
+type ResourceSample struct {
+	Time time.Time `json:"time"`
+	// Node is the slave ID, or "master" for the master itself
+	Node string `json:"node"`
+	// ProcessCPUPercent is the CPU usage of the process, 100 for a single core
+	ProcessCPUPercent float64 `json:"process_cpu_percent"`
+	// HostCPUPercent is the CPU usage of the host across all the cores, up to 100
+	HostCPUPercent     float64 `json:"host_cpu_percent"`
+	RSSBytes           uint64  `json:"rss_bytes"`
+	Goroutines         int     `json:"goroutines"`
+	OpenFDs            int     `json:"open_fds"`
+	NetSentBytesPerSec float64 `json:"net_sent_bytes_per_sec"`
+	NetRecvBytesPerSec float64 `json:"net_recv_bytes_per_sec"`
+}
+
+// String returns the single line of the sample logged on the threshold
+func (s ResourceSample) String() string {
+	return fmt.Sprintf(
+		"[resources] %s %s host_cpu=%.1f%% process_cpu=%.1f%% rss=%dMiB goroutines=%d fds=%d",
+		s.Time.Format(time.TimeOnly), s.Node, s.HostCPUPercent, s.ProcessCPUPercent,
+		s.RSSBytes/(1<<20), s.Goroutines, s.OpenFDs,
+	)
+}
+
+// ResourceSampler samples the resource usage of the current process and its host.
+// The CPU usage and the network throughput are measured since the previous sample,
+// so they are 0 on the first sample.
+type ResourceSampler struct {
+	proc     *process.Process
+	prevTime time.Time
+	prevCPU  *cpu.TimesStat
+	prevNet  *net.IOCountersStat
+}
+
+// NewResourceSampler creates a new ResourceSampler
+func NewResourceSampler() (*ResourceSampler, error) {
+	//nolint:gosec
+	proc, err := process.NewProcess(int32(os.Getpid()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the process: %w", err)
+	}
+	s := &ResourceSampler{
+		proc: proc,
+	}
+	s.Sample()
+	return s, nil
+}
+
+// Sample returns the current resource usage
+func (s *ResourceSampler) Sample() ResourceSample {
+	now := time.Now()
+	sample := ResourceSample{
+		Time:       now,
+		Goroutines: runtime.NumGoroutine(),
+	}
+	if percent, err := s.proc.Percent(0); err == nil {
+		sample.ProcessCPUPercent = percent
+	}
+	if mem, err := s.proc.MemoryInfo(); err == nil {
+		sample.RSSBytes = mem.RSS
+	}
+	if fds, err := s.proc.NumFDs(); err == nil {
+		sample.OpenFDs = int(fds)
+	}
+	if times, err := cpu.Times(false); err == nil && len(times) > 0 {
+		if s.prevCPU != nil {
+			total := times[0].Total() - s.prevCPU.Total()
+			idle := (times[0].Idle + times[0].Iowait) - (s.prevCPU.Idle + s.prevCPU.Iowait)
+			if total > 0 {
+				sample.HostCPUPercent = min(max((total-idle)/total*100, 0), 100)
+			}
+		}
+		s.prevCPU = &times[0]
+	}
+	if counters, err := net.IOCounters(false); err == nil && len(counters) > 0 {
+		// the counters are reset when the interfaces change
+		if s.prevNet != nil && counters[0].BytesSent >= s.prevNet.BytesSent &&
+			counters[0].BytesRecv >= s.prevNet.BytesRecv {
+			if elapsed := now.Sub(s.prevTime).Seconds(); elapsed > 0 {
+				sample.NetSentBytesPerSec = float64(counters[0].BytesSent-s.prevNet.BytesSent) / elapsed
+				sample.NetRecvBytesPerSec = float64(counters[0].BytesRecv-s.prevNet.BytesRecv) / elapsed
+			}
+		}
+		s.prevNet = &counters[0]
+	}
+	s.prevTime = now
+	return sample
+}
+
+// resourceMonitor samples the master, receives the samples streamed from the slaves,
+// writes them and warns about the load generators exceeding the CPU threshold
+type resourceMonitor struct {
+	mu           *sync.Mutex
+	interval     time.Duration
+	cpuThreshold float64
+	outputs      []output.Output
+	outputRoot   string
+	exceeded     map[string]bool // Key: node
+	stopChan     chan struct{}
+	stopOnce     *sync.Once
+	doneChan     chan struct{}
+}
+
+// newResourceMonitor creates a new resourceMonitor
+func newResourceMonitor(resources ValidSlaveConnectResources, outputRoot string) *resourceMonitor {
+	return &resourceMonitor{
+		mu:           &sync.Mutex{},
+		interval:     resources.Interval,
+		cpuThreshold: resources.CPUThreshold,
+		outputs:      resources.Output,
+		outputRoot:   outputRoot,
+		exceeded:     make(map[string]bool),
+		stopChan:     make(chan struct{}),
+		stopOnce:     &sync.Once{},
+		doneChan:     make(chan struct{}),
+	}
+}
+
+// run samples the master on the interval until the monitor is stopped or the context is done
+func (m *resourceMonitor) run(ctx context.Context, log logger.Logger) {
+	defer close(m.doneChan)
+	sampler, err := NewResourceSampler()
+	if err != nil {
+		log.Warn(ctx, "failed to sample the master resources",
+			logger.Value("error", err), logger.Value("on", "resourceMonitor.run"))
+		return
+	}
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.stopChan:
+			return
+		case <-ticker.C:
+		}
+		sample := sampler.Sample()
+		sample.Node = ResourceNodeMaster
+		m.record(ctx, log, sample)
+	}
+}
+
+// record checks the sample against the CPU threshold and writes it
+func (m *resourceMonitor) record(ctx context.Context, log logger.Logger, sample ResourceSample) {
+	m.mu.Lock()
+	exceeded := sample.HostCPUPercent > m.cpuThreshold
+	changed := m.exceeded[sample.Node] != exceeded
+	m.exceeded[sample.Node] = exceeded
+	m.mu.Unlock()
+
+	switch {
+	case changed && exceeded:
+		log.Warn(ctx, "load generator exceeds the CPU threshold, the results may be limited by the generator",
+			logger.Value("node", sample.Node), logger.Value("hostCPUPercent", sample.HostCPUPercent),
+			logger.Value("processCPUPercent", sample.ProcessCPUPercent),
+			logger.Value("threshold", m.cpuThreshold), logger.Value("on", "resourceMonitor.record"))
+		fmt.Println(sample.String())
+	case changed:
+		log.Info(ctx, "load generator is back under the CPU threshold",
+			logger.Value("node", sample.Node), logger.Value("hostCPUPercent", sample.HostCPUPercent),
+			logger.Value("threshold", m.cpuThreshold), logger.Value("on", "resourceMonitor.record"))
+	}
+	if err := m.write(context.WithoutCancel(ctx), log, sample); err != nil {
+		log.Error(ctx, "failed to write resource usage",
+			logger.Value("error", err), logger.Value("on", "resourceMonitor.record"))
+	}
+}
+
+// write appends the sample to the outputs
+func (m *resourceMonitor) write(ctx context.Context, log logger.Logger, sample ResourceSample) error {
+	if len(m.outputs) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(sample)
+	if err != nil {
+		return fmt.Errorf("failed to marshal resource usage: %w", err)
+	}
+	for _, o := range m.outputs {
+		if err := o.ResourcesWrite(ctx, log, m.outputRoot, data); err != nil {
+			return fmt.Errorf("failed to write resource usage: %w", err)
+		}
+	}
+	return nil
+}
+
+// stop stops sampling the master
+func (m *resourceMonitor) stop() {
+	m.stopOnce.Do(func() {
+		close(m.stopChan)
+	})
+	<-m.doneChan
+}
+
+// streamResources receives the resource samples of the session from the slave until the session ends
+func (d *ConnectionMapData) streamResources(ctx context.Context, log logger.Logger, connectionID string) {
+	stream, err := d.Cli.StreamResources(ctx, &pb.StreamResourcesRequest{
+		ConnectionId: connectionID,
+		IntervalMs:   d.resourceMonitor.interval.Milliseconds(),
+	})
+	for err == nil {
+		var res *pb.StreamResourcesResponse
+		if res, err = stream.Recv(); err != nil {
+			break
+		}
+		d.resourceMonitor.record(ctx, log, ResourceSample{
+			Time:               time.UnixMilli(res.TimestampMs),
+			Node:               d.SlaveID,
+			ProcessCPUPercent:  res.ProcessCpuPercent,
+			HostCPUPercent:     res.HostCpuPercent,
+			RSSBytes:           res.RssBytes,
+			Goroutines:         int(res.Goroutines),
+			OpenFDs:            int(res.OpenFds),
+			NetSentBytesPerSec: res.NetSentBytesPerSec,
+			NetRecvBytesPerSec: res.NetRecvBytesPerSec,
+		})
+	}
+	switch {
+	case ctx.Err() != nil:
+	case status.Code(err) == codes.Unimplemented:
+		log.Warn(ctx, "slave does not support the resource usage reporting",
+			logger.Value("slaveID", d.SlaveID), logger.Value("on", "ConnectionMapData.streamResources"))
+	default:
+		log.Warn(ctx, "failed to receive resource usage",
+			logger.Value("error", err), logger.Value("slaveID", d.SlaveID),
+			logger.Value("on", "ConnectionMapData.streamResources"))
+	}
+}
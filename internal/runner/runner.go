@@ -8,15 +8,38 @@ import (
 	"time"
 
 	"github.com/ablankz/bloader/internal/container"
+	"github.com/ablankz/bloader/internal/master"
+	"github.com/ablankz/bloader/internal/metrics"
 	"github.com/ablankz/bloader/internal/output"
 	"github.com/ablankz/bloader/internal/prompt"
+	"github.com/ablankz/bloader/internal/tracing"
 )
 
-// Run runs the load test
-func Run(ctr *container.Container, filename string, data map[string]any) error {
+// Run runs the load test.
+// The inprocServe starts the in-process slaves of SlaveConnect, nil when they are not supported.
+func Run(ctr *container.Container, filename string, data map[string]any, inprocServe InprocServe) error {
 	ctx, cancel := context.WithCancel(ctr.Ctx)
 	defer cancel()
 
+	ctx, recorder := WithThresholdRecorder(ctx)
+
+	if ctr.Config.Tracing.Enabled {
+		stopTracing, err := tracing.Start(ctx, ctr.Logger, ctr.Config.Tracing, BuildVersion)
+		if err != nil {
+			return fmt.Errorf("failed to start the tracing: %w", err)
+		}
+		defer stopTracing()
+	}
+
+	if ctr.Config.Server.Metrics.Active() {
+		m, stopMetrics, err := metrics.Start(ctx, ctr.Logger, ctr.Config.Server.Metrics)
+		if err != nil {
+			return fmt.Errorf("failed to start the metrics: %w", err)
+		}
+		defer stopMetrics()
+		ctx = metrics.With(ctx, m)
+	}
+
 	var err error
 	if filename == "" {
 		filename, err = prompt.Text(
@@ -39,7 +62,21 @@ func Run(ctr *container.Container, filename string, data map[string]any) error {
 		globalStore.Store(k, v)
 	}
 
-	slCtr := NewConnectionContainer()
+	var registry *master.Registry
+	if ctr.Config.Server.Registration.Enabled {
+		registry = master.NewRegistry()
+		if err := master.Serve(
+			ctx,
+			ctr.Logger,
+			ctr.Config.Server.Port,
+			ctr.Config.Server.Registration,
+			registry,
+		); err != nil {
+			return fmt.Errorf("failed to start the registration server: %w", err)
+		}
+	}
+
+	slCtr := NewConnectionContainer(registry, inprocServe)
 	defer slCtr.AllDisconnect(ctx)
 
 	eventCaster := NewDefaultEventCaster()
@@ -70,5 +107,9 @@ func Run(ctr *container.Container, filename string, data map[string]any) error {
 		return fmt.Errorf("failed to execute the load test: %w", err)
 	}
 
+	if violations := recorder.Violations(); len(violations) > 0 {
+		return fmt.Errorf("%w: %d threshold(s) not satisfied", ErrThresholdViolated, len(violations))
+	}
+
 	return nil
 }
@@ -1,7 +1,11 @@
 package runner
 
 import (
+	"context"
 	"fmt"
+	"time"
+
+	"github.com/ablankz/bloader/internal/output"
 )
 
 const (
@@ -11,13 +15,82 @@ const (
 	SlaveConnectRunnerEventConnected Event = "slaveConnect:connected"
 )
 
+// SlaveFailurePolicy represents the behavior when the slave stops responding to the heartbeat
+type SlaveFailurePolicy string
+
+const (
+	// SlaveFailurePolicyFail represents the policy that fails the flow
+	SlaveFailurePolicyFail SlaveFailurePolicy = "fail"
+	// SlaveFailurePolicyContinue represents the policy that continues the flow without the slave
+	SlaveFailurePolicyContinue SlaveFailurePolicy = "continue"
+	// SlaveFailurePolicyReconnect represents the policy that reconnects to the slave with backoff
+	SlaveFailurePolicyReconnect SlaveFailurePolicy = "reconnect"
+)
+
+// SlaveCompatibility represents the behavior when the slave is incompatible with the master
+type SlaveCompatibility string
+
+const (
+	// SlaveCompatibilityStrict represents refusing the incompatible slave
+	SlaveCompatibilityStrict SlaveCompatibility = "strict"
+	// SlaveCompatibilityWarn represents connecting to the incompatible slave with a warning
+	SlaveCompatibilityWarn SlaveCompatibility = "warn"
+
+	// DefaultSlaveCompatibility represents the default slave compatibility
+	DefaultSlaveCompatibility = SlaveCompatibilityStrict
+)
+
+const (
+	// DefaultHeartbeatInterval represents the default interval of the heartbeat
+	DefaultHeartbeatInterval = 5 * time.Second
+	// DefaultHeartbeatTimeout represents the default timeout of a single heartbeat
+	DefaultHeartbeatTimeout = 3 * time.Second
+	// DefaultHeartbeatFailureThreshold represents the default number of consecutive failures to apply the policy
+	DefaultHeartbeatFailureThreshold = 3
+	// DefaultSlaveFailurePolicy represents the default slave failure policy
+	DefaultSlaveFailurePolicy = SlaveFailurePolicyFail
+	// DefaultReconnectMaxRetries represents the default maximum number of reconnection attempts
+	DefaultReconnectMaxRetries = 5
+	// DefaultReconnectInitialBackoff represents the default backoff before the first reconnection attempt
+	DefaultReconnectInitialBackoff = 1 * time.Second
+	// DefaultReconnectMaxBackoff represents the default upper bound of the backoff
+	DefaultReconnectMaxBackoff = 30 * time.Second
+)
+
+const (
+	// DefaultSlaveSelectorWaitTimeout represents the default wait for the slaves matching the selector to register
+	DefaultSlaveSelectorWaitTimeout = 30 * time.Second
+	// DefaultSlaveCapacity represents the capacity of the slave connected by the URI
+	DefaultSlaveCapacity = 1
+)
+
+// DefaultLiveMetricsInterval represents the default interval of the live metrics
+const DefaultLiveMetricsInterval = 5 * time.Second
+
+const (
+	// DefaultResourcesInterval represents the default interval of the resource usage samples
+	DefaultResourcesInterval = 5 * time.Second
+	// DefaultResourcesCPUThreshold represents the default host CPU usage in percent
+	// above which the load generator is warned as saturated
+	DefaultResourcesCPUThreshold = 80.0
+)
+
+// DefaultCancelGracePeriod represents the default time the slave waits for the canceled command to stop
+// before forcing it to stop
+const DefaultCancelGracePeriod = 10 * time.Second
+
+// cancelCommandTimeoutMargin is the time added to the grace period to wait for the canceled command on the slave
+const cancelCommandTimeoutMargin = 5 * time.Second
+
 // SlaveConnect represents the SlaveConnect runner
 type SlaveConnect struct {
-	Slaves []SlaveConnectData `yaml:"slaves"`
+	Slaves    []SlaveConnectData    `yaml:"slaves"`
+	Metrics   SlaveConnectMetrics   `yaml:"metrics"`
+	Resources SlaveConnectResources `yaml:"resources"`
 }
 
 // Validate validates the SlaveConnect
-func (r SlaveConnect) Validate() (ValidSlaveConnect, error) {
+func (r SlaveConnect) Validate(ctx context.Context, outFactor OutputFactor) (ValidSlaveConnect, error) {
 	var validSlaves []ValidSlaveConnectData
 	for i, d := range r.Slaves {
 		valid, err := d.Validate()
@@ -26,17 +99,105 @@ func (r SlaveConnect) Validate() (ValidSlaveConnect, error) {
 		}
 		validSlaves = append(validSlaves, valid)
 	}
+	validMetrics, err := r.Metrics.Validate(ctx, outFactor)
+	if err != nil {
+		return ValidSlaveConnect{}, fmt.Errorf("failed to validate metrics: %w", err)
+	}
+	validResources, err := r.Resources.Validate(ctx, outFactor)
+	if err != nil {
+		return ValidSlaveConnect{}, fmt.Errorf("failed to validate resources: %w", err)
+	}
 	return ValidSlaveConnect{
-		Slaves: validSlaves,
+		Slaves:    validSlaves,
+		Metrics:   validMetrics,
+		Resources: validResources,
 	}, nil
 }
 
+// SlaveConnectMetrics represents the live metrics streamed from the slaves
+type SlaveConnectMetrics struct {
+	Enabled  bool           `yaml:"enabled"`
+	Interval *string        `yaml:"interval"`
+	Output   MassExecOutput `yaml:"output"`
+}
+
+// Validate validates the SlaveConnectMetrics
+func (m SlaveConnectMetrics) Validate(ctx context.Context, outFactor OutputFactor) (ValidSlaveConnectMetrics, error) {
+	if !m.Enabled {
+		return ValidSlaveConnectMetrics{}, nil
+	}
+	valid := ValidSlaveConnectMetrics{
+		Enabled:  true,
+		Interval: DefaultLiveMetricsInterval,
+	}
+	var err error
+	if m.Interval != nil {
+		if valid.Interval, err = time.ParseDuration(*m.Interval); err != nil {
+			return ValidSlaveConnectMetrics{}, fmt.Errorf("failed to parse interval: %w", err)
+		}
+		if valid.Interval < time.Millisecond {
+			return ValidSlaveConnectMetrics{}, fmt.Errorf("interval must be at least 1ms")
+		}
+	}
+	if valid.Output, err = m.Output.Validate(ctx, outFactor); err != nil {
+		return ValidSlaveConnectMetrics{}, fmt.Errorf("failed to validate output: %w", err)
+	}
+	return valid, nil
+}
+
+// SlaveConnectResources represents the resource usage sampled on the master and the slaves
+type SlaveConnectResources struct {
+	Enabled      bool           `yaml:"enabled"`
+	Interval     *string        `yaml:"interval"`
+	CPUThreshold *float64       `yaml:"cpu_threshold"`
+	Output       MassExecOutput `yaml:"output"`
+}
+
+// Validate validates the SlaveConnectResources
+func (r SlaveConnectResources) Validate(
+	ctx context.Context,
+	outFactor OutputFactor,
+) (ValidSlaveConnectResources, error) {
+	if !r.Enabled {
+		return ValidSlaveConnectResources{}, nil
+	}
+	valid := ValidSlaveConnectResources{
+		Enabled:      true,
+		Interval:     DefaultResourcesInterval,
+		CPUThreshold: DefaultResourcesCPUThreshold,
+	}
+	var err error
+	if r.Interval != nil {
+		if valid.Interval, err = time.ParseDuration(*r.Interval); err != nil {
+			return ValidSlaveConnectResources{}, fmt.Errorf("failed to parse interval: %w", err)
+		}
+		if valid.Interval < 100*time.Millisecond {
+			return ValidSlaveConnectResources{}, fmt.Errorf("interval must be at least 100ms")
+		}
+	}
+	if r.CPUThreshold != nil {
+		if *r.CPUThreshold <= 0 || *r.CPUThreshold > 100 {
+			return ValidSlaveConnectResources{}, fmt.Errorf("cpu_threshold must be greater than 0 and at most 100")
+		}
+		valid.CPUThreshold = *r.CPUThreshold
+	}
+	if valid.Output, err = r.Output.Validate(ctx, outFactor); err != nil {
+		return ValidSlaveConnectResources{}, fmt.Errorf("failed to validate output: %w", err)
+	}
+	return valid, nil
+}
+
 // SlaveConnectData represents the data for the SlaveConnect
 type SlaveConnectData struct {
-	ID          *string                 `yaml:"id"`
-	URI         *string                 `yaml:"uri"`
-	Certificate SlaveConnectCertificate `yaml:"certificate"`
-	Encrypt     CredentialEncryptConfig `yaml:"encrypt"`
+	ID                *string                 `yaml:"id"`
+	URI               *string                 `yaml:"uri"`
+	Selector          *SlaveConnectSelector   `yaml:"selector"`
+	Certificate       SlaveConnectCertificate `yaml:"certificate"`
+	Encrypt           CredentialEncryptConfig `yaml:"encrypt"`
+	Auth              SlaveConnectAuth        `yaml:"auth"`
+	Heartbeat         SlaveConnectHeartbeat   `yaml:"heartbeat"`
+	CancelGracePeriod *string                 `yaml:"cancel_grace_period"`
+	Compatibility     *string                 `yaml:"compatibility"`
 }
 
 // Validate validates the SlaveConnectData
@@ -46,10 +207,28 @@ func (d SlaveConnectData) Validate() (ValidSlaveConnectData, error) {
 		return ValidSlaveConnectData{}, fmt.Errorf("id is required")
 	}
 	valid.ID = *d.ID
-	if d.URI == nil {
-		return ValidSlaveConnectData{}, fmt.Errorf("uri is required")
+	switch {
+	case d.URI != nil && d.Selector != nil:
+		return ValidSlaveConnectData{}, fmt.Errorf("uri and selector cannot be used together")
+	case d.URI != nil:
+		valid.URI = *d.URI
+		if name, ok := inprocName(valid.URI); ok {
+			if name == "" {
+				return ValidSlaveConnectData{}, fmt.Errorf("name of the inproc uri is required")
+			}
+			if d.Certificate.Enabled || d.Encrypt.Enabled {
+				return ValidSlaveConnectData{}, fmt.Errorf("certificate and encrypt cannot be used with the inproc uri")
+			}
+		}
+	case d.Selector != nil:
+		validSelector, err := d.Selector.Validate()
+		if err != nil {
+			return ValidSlaveConnectData{}, fmt.Errorf("failed to validate selector: %w", err)
+		}
+		valid.Selector = validSelector
+	default:
+		return ValidSlaveConnectData{}, fmt.Errorf("uri or selector is required")
 	}
-	valid.URI = *d.URI
 	validCertificate, err := d.Certificate.Validate()
 	if err != nil {
 		return ValidSlaveConnectData{}, fmt.Errorf("failed to validate certificate: %w", err)
@@ -60,6 +239,159 @@ func (d SlaveConnectData) Validate() (ValidSlaveConnectData, error) {
 		return ValidSlaveConnectData{}, fmt.Errorf("failed to validate encrypt: %w", err)
 	}
 	valid.Encrypt = ValidCredentialEncryptConfig(validEncrypt)
+	validAuth, err := d.Auth.Validate()
+	if err != nil {
+		return ValidSlaveConnectData{}, fmt.Errorf("failed to validate auth: %w", err)
+	}
+	valid.Auth = validAuth
+	validHeartbeat, err := d.Heartbeat.Validate()
+	if err != nil {
+		return ValidSlaveConnectData{}, fmt.Errorf("failed to validate heartbeat: %w", err)
+	}
+	valid.Heartbeat = validHeartbeat
+	valid.CancelGracePeriod = DefaultCancelGracePeriod
+	if d.CancelGracePeriod != nil {
+		if valid.CancelGracePeriod, err = time.ParseDuration(*d.CancelGracePeriod); err != nil {
+			return ValidSlaveConnectData{}, fmt.Errorf("failed to parse cancel_grace_period: %w", err)
+		}
+		if valid.CancelGracePeriod <= 0 {
+			return ValidSlaveConnectData{}, fmt.Errorf("cancel_grace_period must be greater than 0")
+		}
+	}
+	valid.Compatibility = DefaultSlaveCompatibility
+	if d.Compatibility != nil {
+		switch SlaveCompatibility(*d.Compatibility) {
+		case SlaveCompatibilityStrict, SlaveCompatibilityWarn:
+			valid.Compatibility = SlaveCompatibility(*d.Compatibility)
+		default:
+			return ValidSlaveConnectData{}, fmt.Errorf("invalid compatibility value: %s", *d.Compatibility)
+		}
+	}
+	return valid, nil
+}
+
+// SlaveConnectSelector represents the selector of the slaves registered on the master
+type SlaveConnectSelector struct {
+	Labels      map[string]string `yaml:"labels"`
+	Count       *int              `yaml:"count"`
+	WaitTimeout *string           `yaml:"wait_timeout"`
+}
+
+// Validate validates the SlaveConnectSelector
+func (s SlaveConnectSelector) Validate() (ValidSlaveConnectSelector, error) {
+	valid := ValidSlaveConnectSelector{
+		Enabled:     true,
+		Labels:      s.Labels,
+		WaitTimeout: DefaultSlaveSelectorWaitTimeout,
+	}
+	if s.Count != nil {
+		if *s.Count <= 0 {
+			return ValidSlaveConnectSelector{}, fmt.Errorf("count must be greater than 0")
+		}
+		valid.Count = *s.Count
+	}
+	if s.WaitTimeout != nil {
+		var err error
+		if valid.WaitTimeout, err = time.ParseDuration(*s.WaitTimeout); err != nil {
+			return ValidSlaveConnectSelector{}, fmt.Errorf("failed to parse wait_timeout: %w", err)
+		}
+		if valid.WaitTimeout < 0 {
+			return ValidSlaveConnectSelector{}, fmt.Errorf("wait_timeout must not be negative")
+		}
+	}
+	return valid, nil
+}
+
+// SlaveConnectHeartbeat represents the heartbeat for the Slave
+type SlaveConnectHeartbeat struct {
+	Disabled         bool                  `yaml:"disabled"`
+	Interval         *string               `yaml:"interval"`
+	Timeout          *string               `yaml:"timeout"`
+	FailureThreshold *int                  `yaml:"failure_threshold"`
+	OnFailure        *string               `yaml:"on_failure"`
+	Reconnect        SlaveConnectReconnect `yaml:"reconnect"`
+}
+
+// Validate validates the SlaveConnectHeartbeat
+func (h SlaveConnectHeartbeat) Validate() (ValidSlaveConnectHeartbeat, error) {
+	valid := ValidSlaveConnectHeartbeat{
+		Enabled:          !h.Disabled,
+		Interval:         DefaultHeartbeatInterval,
+		Timeout:          DefaultHeartbeatTimeout,
+		FailureThreshold: DefaultHeartbeatFailureThreshold,
+		OnFailure:        DefaultSlaveFailurePolicy,
+	}
+	var err error
+	if h.Interval != nil {
+		if valid.Interval, err = time.ParseDuration(*h.Interval); err != nil {
+			return ValidSlaveConnectHeartbeat{}, fmt.Errorf("failed to parse interval: %w", err)
+		}
+		if valid.Interval <= 0 {
+			return ValidSlaveConnectHeartbeat{}, fmt.Errorf("interval must be greater than 0")
+		}
+	}
+	if h.Timeout != nil {
+		if valid.Timeout, err = time.ParseDuration(*h.Timeout); err != nil {
+			return ValidSlaveConnectHeartbeat{}, fmt.Errorf("failed to parse timeout: %w", err)
+		}
+		if valid.Timeout <= 0 {
+			return ValidSlaveConnectHeartbeat{}, fmt.Errorf("timeout must be greater than 0")
+		}
+	}
+	if h.FailureThreshold != nil {
+		if *h.FailureThreshold <= 0 {
+			return ValidSlaveConnectHeartbeat{}, fmt.Errorf("failure_threshold must be greater than 0")
+		}
+		valid.FailureThreshold = *h.FailureThreshold
+	}
+	if h.OnFailure != nil {
+		switch SlaveFailurePolicy(*h.OnFailure) {
+		case SlaveFailurePolicyFail, SlaveFailurePolicyContinue, SlaveFailurePolicyReconnect:
+			valid.OnFailure = SlaveFailurePolicy(*h.OnFailure)
+		default:
+			return ValidSlaveConnectHeartbeat{}, fmt.Errorf("invalid on_failure value: %s", *h.OnFailure)
+		}
+	}
+	if valid.Reconnect, err = h.Reconnect.Validate(); err != nil {
+		return ValidSlaveConnectHeartbeat{}, fmt.Errorf("failed to validate reconnect: %w", err)
+	}
+	return valid, nil
+}
+
+// SlaveConnectReconnect represents the reconnection for the Slave
+type SlaveConnectReconnect struct {
+	MaxRetries     *int    `yaml:"max_retries"`
+	InitialBackoff *string `yaml:"initial_backoff"`
+	MaxBackoff     *string `yaml:"max_backoff"`
+}
+
+// Validate validates the SlaveConnectReconnect
+func (r SlaveConnectReconnect) Validate() (ValidSlaveConnectReconnect, error) {
+	valid := ValidSlaveConnectReconnect{
+		MaxRetries:     DefaultReconnectMaxRetries,
+		InitialBackoff: DefaultReconnectInitialBackoff,
+		MaxBackoff:     DefaultReconnectMaxBackoff,
+	}
+	var err error
+	if r.MaxRetries != nil {
+		if *r.MaxRetries <= 0 {
+			return ValidSlaveConnectReconnect{}, fmt.Errorf("max_retries must be greater than 0")
+		}
+		valid.MaxRetries = *r.MaxRetries
+	}
+	if r.InitialBackoff != nil {
+		if valid.InitialBackoff, err = time.ParseDuration(*r.InitialBackoff); err != nil {
+			return ValidSlaveConnectReconnect{}, fmt.Errorf("failed to parse initial_backoff: %w", err)
+		}
+	}
+	if r.MaxBackoff != nil {
+		if valid.MaxBackoff, err = time.ParseDuration(*r.MaxBackoff); err != nil {
+			return ValidSlaveConnectReconnect{}, fmt.Errorf("failed to parse max_backoff: %w", err)
+		}
+	}
+	if valid.InitialBackoff <= 0 || valid.MaxBackoff < valid.InitialBackoff {
+		return ValidSlaveConnectReconnect{}, fmt.Errorf("backoff must satisfy 0 < initial_backoff <= max_backoff")
+	}
 	return valid, nil
 }
 
@@ -69,6 +401,8 @@ type SlaveConnectCertificate struct {
 	CACert             *string `yaml:"ca_cert"`
 	ServerNameOverride string  `yaml:"server_name_override"`
 	InsecureSkipVerify bool    `yaml:"insecure_skip_verify"`
+	ClientCert         *string `yaml:"client_cert"`
+	ClientKey          *string `yaml:"client_key"`
 }
 
 // Validate validates the SlaveConnectCertificate
@@ -79,25 +413,101 @@ func (c SlaveConnectCertificate) Validate() (ValidSlaveConnectCertificate, error
 	if c.CACert == nil {
 		return ValidSlaveConnectCertificate{}, fmt.Errorf("ca_cert is required")
 	}
-	return ValidSlaveConnectCertificate{
+	valid := ValidSlaveConnectCertificate{
 		Enabled:            c.Enabled,
 		CACert:             *c.CACert,
 		ServerNameOverride: c.ServerNameOverride,
 		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+	switch {
+	case c.ClientCert != nil && c.ClientKey != nil:
+		valid.ClientCert = *c.ClientCert
+		valid.ClientKey = *c.ClientKey
+	case c.ClientCert != nil || c.ClientKey != nil:
+		return ValidSlaveConnectCertificate{}, fmt.Errorf("client_cert and client_key must be set together")
+	}
+	return valid, nil
+}
+
+// SlaveConnectAuth represents the bearer token sent to the Slave
+type SlaveConnectAuth struct {
+	Enabled bool    `yaml:"enabled"`
+	Token   *string `yaml:"token"`
+}
+
+// Validate validates the SlaveConnectAuth
+func (a SlaveConnectAuth) Validate() (ValidSlaveConnectAuth, error) {
+	if !a.Enabled {
+		return ValidSlaveConnectAuth{}, nil
+	}
+	if a.Token == nil || *a.Token == "" {
+		return ValidSlaveConnectAuth{}, fmt.Errorf("token is required")
+	}
+	return ValidSlaveConnectAuth{
+		Enabled: true,
+		Token:   *a.Token,
 	}, nil
 }
 
 // ValidSlaveConnect represents the valid ValidSlaveConnect runner
 type ValidSlaveConnect struct {
-	Slaves []ValidSlaveConnectData
+	Slaves    []ValidSlaveConnectData
+	Metrics   ValidSlaveConnectMetrics
+	Resources ValidSlaveConnectResources
+}
+
+// ValidSlaveConnectMetrics represents the valid live metrics
+type ValidSlaveConnectMetrics struct {
+	Enabled  bool
+	Interval time.Duration
+	Output   []output.Output
+}
+
+// ValidSlaveConnectResources represents the valid resource usage sampling
+type ValidSlaveConnectResources struct {
+	Enabled      bool
+	Interval     time.Duration
+	CPUThreshold float64
+	Output       []output.Output
 }
 
 // ValidSlaveConnectData represents the valid data for the ValidSlaveConnect
 type ValidSlaveConnectData struct {
-	ID          string
-	URI         string
-	Certificate ValidSlaveConnectCertificate
-	Encrypt     ValidCredentialEncryptConfig
+	ID                string
+	URI               string
+	Selector          ValidSlaveConnectSelector
+	Certificate       ValidSlaveConnectCertificate
+	Encrypt           ValidCredentialEncryptConfig
+	Auth              ValidSlaveConnectAuth
+	Heartbeat         ValidSlaveConnectHeartbeat
+	CancelGracePeriod time.Duration
+	Compatibility     SlaveCompatibility
+}
+
+// ValidSlaveConnectSelector represents the valid selector of the registered slaves.
+// Count is 0 when all the matching slaves are selected.
+type ValidSlaveConnectSelector struct {
+	Enabled     bool
+	Labels      map[string]string
+	Count       int
+	WaitTimeout time.Duration
+}
+
+// ValidSlaveConnectHeartbeat represents the valid heartbeat for the Slave
+type ValidSlaveConnectHeartbeat struct {
+	Enabled          bool
+	Interval         time.Duration
+	Timeout          time.Duration
+	FailureThreshold int
+	OnFailure        SlaveFailurePolicy
+	Reconnect        ValidSlaveConnectReconnect
+}
+
+// ValidSlaveConnectReconnect represents the valid reconnection for the Slave
+type ValidSlaveConnectReconnect struct {
+	MaxRetries     int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
 }
 
 // ValidSlaveConnectCertificate represents the valid certificate for the Slave
@@ -106,4 +516,12 @@ type ValidSlaveConnectCertificate struct {
 	CACert             string
 	ServerNameOverride string
 	InsecureSkipVerify bool
+	ClientCert         string
+	ClientKey          string
+}
+
+// ValidSlaveConnectAuth represents the valid bearer token sent to the Slave
+type ValidSlaveConnectAuth struct {
+	Enabled bool
+	Token   string
 }
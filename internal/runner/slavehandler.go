@@ -6,10 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 
-	rpc "buf.build/gen/go/cresplanex/bloader/grpc/go/cresplanex/bloader/v1/bloaderv1grpc"
-	pb "buf.build/gen/go/cresplanex/bloader/protocolbuffers/go/cresplanex/bloader/v1"
 	"google.golang.org/protobuf/proto"
 
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
 	"github.com/ablankz/bloader/internal/logger"
 )
 
@@ -18,11 +17,17 @@ type SlaveRequestHandler struct {
 	// resChan is a channel.
 	resChan <-chan *pb.ReceiveChanelConnectResponse
 	// cli is a client.
-	cli rpc.BloaderSlaveServiceClient
+	cli pb.BloaderSlaveServiceClient
 	// chunkSize is an integer.
 	chunkSize int
 	// receiveTermChan is a channel for receiving term.
 	receiveTermChan <-chan ReceiveTermType
+	// slaveID is the ID of the slave.
+	slaveID string
+	// lostChan is a channel closed when the slave is lost.
+	lostChan <-chan struct{}
+	// failurePolicy is the policy applied when the slave is lost.
+	failurePolicy SlaveFailurePolicy
 	// dataBufferMap is a map.
 	dataBufferMap map[string]*bytes.Buffer
 }
@@ -31,16 +36,15 @@ type SlaveRequestHandler struct {
 const DefaultChunkSize = 1024
 
 // NewSlaveRequestHandler creates a new ResponseHandler.
-func NewSlaveRequestHandler(
-	resChan <-chan *pb.ReceiveChanelConnectResponse,
-	cli rpc.BloaderSlaveServiceClient,
-	termChan <-chan ReceiveTermType,
-) *SlaveRequestHandler {
+func NewSlaveRequestHandler(mapData *ConnectionMapData) *SlaveRequestHandler {
 	return &SlaveRequestHandler{
-		resChan:         resChan,
-		cli:             cli,
+		resChan:         mapData.ReqChan,
+		cli:             mapData.Cli,
 		chunkSize:       DefaultChunkSize,
-		receiveTermChan: termChan,
+		receiveTermChan: mapData.ReceiveTermChan,
+		slaveID:         mapData.SlaveID,
+		lostChan:        mapData.Lost(),
+		failurePolicy:   mapData.FailurePolicy(),
 		dataBufferMap:   make(map[string]*bytes.Buffer),
 	}
 }
@@ -58,6 +62,13 @@ func (rh *SlaveRequestHandler) HandleResponse(
 		select {
 		case <-ctx.Done():
 			return nil
+		case <-rh.lostChan:
+			if rh.failurePolicy == SlaveFailurePolicyContinue {
+				log.Warn(ctx, "continue without the lost slave",
+					logger.Value("slaveID", rh.slaveID))
+				return nil
+			}
+			return fmt.Errorf("slave is lost: %s", rh.slaveID)
 		case termType := <-rh.receiveTermChan:
 			switch termType {
 			case ReceiveTermTypeReceiveTermTypeEOF:
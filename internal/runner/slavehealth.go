@@ -0,0 +1,194 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+// SlaveHealthState represents the health state of the slave
+type SlaveHealthState string
+
+const (
+	// SlaveHealthStateHealthy represents the slave responding to the heartbeat
+	SlaveHealthStateHealthy SlaveHealthState = "healthy"
+	// SlaveHealthStateUnhealthy represents the slave failing the heartbeat below the failure threshold
+	SlaveHealthStateUnhealthy SlaveHealthState = "unhealthy"
+	// SlaveHealthStateReconnecting represents the slave being reconnected
+	SlaveHealthStateReconnecting SlaveHealthState = "reconnecting"
+	// SlaveHealthStateLost represents the slave given up
+	SlaveHealthStateLost SlaveHealthState = "lost"
+)
+
+// SlaveHealth represents the health of the slave tracked by the heartbeat
+type SlaveHealth struct {
+	State               SlaveHealthState
+	LastHeartbeat       time.Time
+	ConsecutiveFailures int
+	Reconnects          int
+	LastError           string
+}
+
+// Health returns the snapshot of the health of the slave
+func (d *ConnectionMapData) Health() SlaveHealth {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.health
+}
+
+// setHealthState changes the health state and logs the transition
+func (d *ConnectionMapData) setHealthState(ctx context.Context, log logger.Logger, state SlaveHealthState) {
+	d.mu.Lock()
+	prev := d.health.State
+	d.health.State = state
+	health := d.health
+	d.mu.Unlock()
+	if prev == state {
+		return
+	}
+
+	values := []logger.KeyVal{
+		logger.Value("slaveID", d.SlaveID),
+		logger.Value("from", prev),
+		logger.Value("to", state),
+		logger.Value("consecutiveFailures", health.ConsecutiveFailures),
+		logger.Value("reconnects", health.Reconnects),
+		logger.Value("lastError", health.LastError),
+		logger.Value("on", "ConnectionMapData.setHealthState"),
+	}
+	switch state {
+	case SlaveHealthStateHealthy:
+		log.Info(ctx, "slave health changed", values...)
+	case SlaveHealthStateLost:
+		log.Error(ctx, "slave health changed", values...)
+	default:
+		log.Warn(ctx, "slave health changed", values...)
+	}
+}
+
+// recordHeartbeat records the result of the heartbeat, and returns the number of consecutive failures
+func (d *ConnectionMapData) recordHeartbeat(ctx context.Context, log logger.Logger, err error) int {
+	d.mu.Lock()
+	if err == nil {
+		d.health.LastHeartbeat = time.Now()
+		d.health.ConsecutiveFailures = 0
+		d.health.LastError = ""
+	} else {
+		d.health.ConsecutiveFailures++
+		d.health.LastError = err.Error()
+	}
+	failures := d.health.ConsecutiveFailures
+	d.mu.Unlock()
+
+	if err == nil {
+		d.setHealthState(ctx, log, SlaveHealthStateHealthy)
+	} else {
+		d.setHealthState(ctx, log, SlaveHealthStateUnhealthy)
+	}
+	return failures
+}
+
+// markLost gives up the slave
+func (d *ConnectionMapData) markLost(ctx context.Context, log logger.Logger) {
+	d.abandonSession()
+	d.setHealthState(ctx, log, SlaveHealthStateLost)
+	d.lostOnce.Do(func() {
+		close(d.lostChan)
+	})
+}
+
+// runHeartbeat sends the heartbeat on the interval, and applies the failure policy
+// when the heartbeat fails the threshold times in a row
+func (d *ConnectionMapData) runHeartbeat(ctx context.Context, log logger.Logger, env string) {
+	ticker := time.NewTicker(d.heartbeat.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.termChan:
+			return
+		case <-ticker.C:
+		}
+
+		hbCtx, cancel := context.WithTimeout(ctx, d.heartbeat.Timeout)
+		_, err := d.Cli.Heartbeat(hbCtx, &pb.HeartbeatRequest{
+			ConnectionId: d.ConnectionID(),
+		})
+		cancel()
+		if status.Code(err) == codes.Unimplemented {
+			log.Warn(ctx, "slave does not support the heartbeat",
+				logger.Value("slaveID", d.SlaveID), logger.Value("on", "ConnectionMapData.runHeartbeat"))
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if failures := d.recordHeartbeat(ctx, log, err); failures < d.heartbeat.FailureThreshold {
+			continue
+		}
+
+		switch d.heartbeat.OnFailure {
+		case SlaveFailurePolicyReconnect:
+			if err := d.reconnect(ctx, log, env); err != nil {
+				log.Error(ctx, "failed to reconnect to slave",
+					logger.Value("error", err), logger.Value("slaveID", d.SlaveID),
+					logger.Value("on", "ConnectionMapData.runHeartbeat"))
+				d.markLost(ctx, log)
+				return
+			}
+		default:
+			d.markLost(ctx, log)
+			return
+		}
+	}
+}
+
+// reconnect opens a new session on the slave with the exponential backoff.
+// The slave requests the loader, auth and target of the new session from the master again.
+func (d *ConnectionMapData) reconnect(ctx context.Context, log logger.Logger, env string) error {
+	d.abandonSession()
+	d.setHealthState(ctx, log, SlaveHealthStateReconnecting)
+
+	backoff := d.heartbeat.Reconnect.InitialBackoff
+	for attempt := 1; attempt <= d.heartbeat.Reconnect.MaxRetries; attempt++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-d.termChan:
+			return fmt.Errorf("connection is disconnected")
+		case <-time.After(backoff):
+		}
+
+		err := d.open(ctx, log, env)
+		if err == nil {
+			d.mu.Lock()
+			d.health.Reconnects++
+			d.mu.Unlock()
+			d.recordHeartbeat(ctx, log, nil)
+			log.Info(ctx, "reconnected to slave",
+				logger.Value("slaveID", d.SlaveID), logger.Value("attempt", attempt),
+				logger.Value("connectionID", d.ConnectionID()), logger.Value("on", "ConnectionMapData.reconnect"))
+			return nil
+		}
+		log.Warn(ctx, "failed to reconnect to slave",
+			logger.Value("error", err), logger.Value("slaveID", d.SlaveID),
+			logger.Value("attempt", attempt), logger.Value("backoff", backoff),
+			logger.Value("on", "ConnectionMapData.reconnect"))
+
+		backoff *= 2
+		if backoff > d.heartbeat.Reconnect.MaxBackoff {
+			backoff = d.heartbeat.Reconnect.MaxBackoff
+		}
+	}
+
+	return fmt.Errorf("gave up after %d attempts", d.heartbeat.Reconnect.MaxRetries)
+}
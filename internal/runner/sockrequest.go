@@ -0,0 +1,264 @@
+package runner
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ablankz/bloader/internal/config"
+	"github.com/ablankz/bloader/internal/executor/httpexec"
+	"github.com/ablankz/bloader/internal/executor/sockexec"
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+// SocketPayloadType represents the encoding of the payload sent on the socket
+type SocketPayloadType string
+
+const (
+	// SocketPayloadTypeText represents the payload sent as is
+	SocketPayloadTypeText SocketPayloadType = "text"
+	// SocketPayloadTypeHex represents the hex encoded binary payload
+	SocketPayloadTypeHex SocketPayloadType = "hex"
+	// SocketPayloadTypeBase64 represents the base64 encoded binary payload
+	SocketPayloadTypeBase64 SocketPayloadType = "base64"
+
+	// DefaultSocketPayloadType represents the default socket payload type
+	DefaultSocketPayloadType = SocketPayloadTypeText
+)
+
+const (
+	// DefaultSocketTimeout represents the default timeout of a single exchange
+	DefaultSocketTimeout = 30 * time.Second
+	// DefaultSocketResponseType represents the default response type of the socket reply
+	DefaultSocketResponseType = httpexec.ResponseTypeText
+	// DefaultSocketDelimiter represents the default delimiter of the reply
+	DefaultSocketDelimiter = "\n"
+)
+
+// SocketFraming represents the reply framing of the socket request
+type SocketFraming struct {
+	Type       *string `yaml:"type"`
+	Delimiter  *string `yaml:"delimiter"`
+	Length     *int    `yaml:"length"`
+	PrefixSize *int    `yaml:"prefix_size"`
+	ByteOrder  *string `yaml:"byte_order"`
+}
+
+// Validate validates the SocketFraming
+func (f SocketFraming) Validate() (sockexec.Framing, error) {
+	valid := sockexec.Framing{
+		Type:      sockexec.FramingTypeDelimiter,
+		ByteOrder: binary.BigEndian,
+	}
+	if f.Type != nil {
+		switch sockexec.FramingType(*f.Type) {
+		case sockexec.FramingTypeNone,
+			sockexec.FramingTypeDelimiter,
+			sockexec.FramingTypeFixed,
+			sockexec.FramingTypeLengthPrefix:
+			valid.Type = sockexec.FramingType(*f.Type)
+		default:
+			return sockexec.Framing{}, fmt.Errorf("invalid type value: %s", *f.Type)
+		}
+	}
+	switch valid.Type {
+	case sockexec.FramingTypeDelimiter:
+		valid.Delimiter = []byte(DefaultSocketDelimiter)
+		if f.Delimiter != nil {
+			if *f.Delimiter == "" {
+				return sockexec.Framing{}, fmt.Errorf("delimiter must not be empty")
+			}
+			valid.Delimiter = []byte(*f.Delimiter)
+		}
+	case sockexec.FramingTypeFixed:
+		if f.Length == nil {
+			return sockexec.Framing{}, fmt.Errorf("length is required")
+		}
+		if *f.Length <= 0 {
+			return sockexec.Framing{}, fmt.Errorf("length must be greater than 0")
+		}
+		valid.Length = *f.Length
+	case sockexec.FramingTypeLengthPrefix:
+		if f.PrefixSize == nil {
+			return sockexec.Framing{}, fmt.Errorf("prefix_size is required")
+		}
+		switch *f.PrefixSize {
+		case 1, 2, 4, 8:
+			valid.PrefixSize = *f.PrefixSize
+		default:
+			return sockexec.Framing{}, fmt.Errorf("prefix_size must be one of 1, 2, 4 or 8")
+		}
+		if f.ByteOrder != nil {
+			switch *f.ByteOrder {
+			case "big":
+				valid.ByteOrder = binary.BigEndian
+			case "little":
+				valid.ByteOrder = binary.LittleEndian
+			default:
+				return sockexec.Framing{}, fmt.Errorf("invalid byte_order value: %s", *f.ByteOrder)
+			}
+		}
+	}
+	return valid, nil
+}
+
+// ValidSocketOptions represents the valid options of the socket request
+type ValidSocketOptions struct {
+	Network     sockexec.Network
+	Address     string
+	PayloadType SocketPayloadType
+	Framing     sockexec.Framing
+	Timeout     time.Duration
+}
+
+// validateSocketOptions validates the options shared by the socket requests of the runners.
+// The network is decided by the type of the target.
+func validateSocketOptions(
+	ctx context.Context,
+	targetFactor TargetFactor,
+	targetID string,
+	bodyType *string,
+	body any,
+	framing SocketFraming,
+	timeout *string,
+) (ValidSocketOptions, error) {
+	var valid ValidSocketOptions
+	var err error
+	tg, err := targetFactor.Factorize(ctx, targetID)
+	if err != nil {
+		return ValidSocketOptions{}, fmt.Errorf("failed to factorize target: %w", err)
+	}
+	switch tg.Type {
+	case config.TargetTypeTCP:
+		valid.Network = sockexec.NetworkTCP
+	case config.TargetTypeUDP:
+		valid.Network = sockexec.NetworkUDP
+	default:
+		return ValidSocketOptions{}, fmt.Errorf("target %s is not a tcp or udp target", targetID)
+	}
+	valid.Address = sockexec.Address(tg.URL)
+	valid.PayloadType = DefaultSocketPayloadType
+	if bodyType != nil {
+		switch SocketPayloadType(*bodyType) {
+		case SocketPayloadTypeText, SocketPayloadTypeHex, SocketPayloadTypeBase64:
+			valid.PayloadType = SocketPayloadType(*bodyType)
+		default:
+			return ValidSocketOptions{}, fmt.Errorf("invalid body_type value: %s", *bodyType)
+		}
+	}
+	if _, err := newSocketPayload(valid.PayloadType, body); err != nil {
+		return ValidSocketOptions{}, fmt.Errorf("failed to validate body: %w", err)
+	}
+	if valid.Framing, err = framing.Validate(); err != nil {
+		return ValidSocketOptions{}, fmt.Errorf("failed to validate framing: %w", err)
+	}
+	valid.Timeout = DefaultSocketTimeout
+	if timeout != nil {
+		if valid.Timeout, err = time.ParseDuration(*timeout); err != nil {
+			return ValidSocketOptions{}, fmt.Errorf("failed to parse timeout: %w", err)
+		}
+		if valid.Timeout <= 0 {
+			return ValidSocketOptions{}, fmt.Errorf("timeout must be greater than 0")
+		}
+	}
+	return valid, nil
+}
+
+// validateSocketResponseType validates the response type of the socket reply, text by default
+func validateSocketResponseType(responseType *string) (string, error) {
+	if responseType == nil {
+		return string(DefaultSocketResponseType), nil
+	}
+	if httpexec.ResponseType(*responseType).IsStream() {
+		return "", fmt.Errorf("response_type %s cannot be used with socket type", *responseType)
+	}
+	return *responseType, nil
+}
+
+// newSocketPayload encodes the body into the payload
+func newSocketPayload(payloadType SocketPayloadType, body any) ([]byte, error) {
+	if body == nil {
+		return nil, nil
+	}
+	str, ok := body.(string)
+	if !ok {
+		return nil, fmt.Errorf("body must be a string")
+	}
+	switch payloadType {
+	case SocketPayloadTypeHex:
+		data, err := hex.DecodeString(strings.Join(strings.Fields(str), ""))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode hex: %w", err)
+		}
+		return data, nil
+	case SocketPayloadTypeBase64:
+		data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(str))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode base64: %w", err)
+		}
+		return data, nil
+	}
+	return []byte(str), nil
+}
+
+// SocketRequest represents the payload sent on the socket
+type SocketRequest struct {
+	PayloadType  SocketPayloadType
+	Body         any
+	IsMass       bool
+	TmplStr      string
+	ReplaceData  *sync.Map
+	OutputFactor OutputFactor
+	AuthFactor   AuthenticatorFactor
+	TargetFactor TargetFactor
+	ReqIndex     int
+}
+
+// CreatePayload creates the payload of the count and stage
+func (r SocketRequest) CreatePayload(
+	ctx context.Context,
+	log logger.Logger,
+	count, stage int,
+) (*sockexec.Payload, error) {
+	if r.IsMass {
+		replaceData, _ := newDynamicReplaceData(r.ReplaceData, count, stage)
+		buffer, err := executeTmpl(r.TmplStr, replaceData)
+		if err != nil {
+			return nil, err
+		}
+		var massExec MassExec
+		if err := yaml.Unmarshal(buffer, &massExec); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal json: %w", err)
+		}
+		validMassExec, err := massExec.Validate(
+			ctx,
+			log,
+			r.AuthFactor,
+			r.OutputFactor,
+			r.TargetFactor,
+			r.TmplStr,
+			replaceData,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to validate mass exec: %w", err)
+		}
+		request := validMassExec.Requests[r.ReqIndex]
+		r.PayloadType = request.Socket.PayloadType
+		r.Body = request.Body
+	}
+
+	data, err := newSocketPayload(r.PayloadType, r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payload: %w", err)
+	}
+	return &sockexec.Payload{Data: data}, nil
+}
+
+var _ sockexec.ExecReq = (*SocketRequest)(nil)
@@ -0,0 +1,128 @@
+package runner
+
+import (
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/ablankz/bloader/internal/executor/sockexec"
+)
+
+// TestSocketFramingValidate tests the validation of the reply framing of the socket request.
+func TestSocketFramingValidate(t *testing.T) {
+	str := func(s string) *string { return &s }
+	num := func(n int) *int { return &n }
+	tests := []struct {
+		name    string
+		framing SocketFraming
+		want    sockexec.Framing
+		wantErr bool
+	}{
+		{
+			name:    "Default",
+			framing: SocketFraming{},
+			want:    sockexec.Framing{Type: sockexec.FramingTypeDelimiter, Delimiter: []byte("\n"), ByteOrder: binary.BigEndian},
+		},
+		{
+			name:    "Delimiter",
+			framing: SocketFraming{Type: str("delimiter"), Delimiter: str("\r\n")},
+			want:    sockexec.Framing{Type: sockexec.FramingTypeDelimiter, Delimiter: []byte("\r\n"), ByteOrder: binary.BigEndian},
+		},
+		{
+			name:    "EmptyDelimiter",
+			framing: SocketFraming{Type: str("delimiter"), Delimiter: str("")},
+			wantErr: true,
+		},
+		{
+			name:    "None",
+			framing: SocketFraming{Type: str("none")},
+			want:    sockexec.Framing{Type: sockexec.FramingTypeNone, ByteOrder: binary.BigEndian},
+		},
+		{
+			name:    "Fixed",
+			framing: SocketFraming{Type: str("fixed"), Length: num(16)},
+			want:    sockexec.Framing{Type: sockexec.FramingTypeFixed, Length: 16, ByteOrder: binary.BigEndian},
+		},
+		{
+			name:    "FixedWithoutLength",
+			framing: SocketFraming{Type: str("fixed")},
+			wantErr: true,
+		},
+		{
+			name:    "FixedZeroLength",
+			framing: SocketFraming{Type: str("fixed"), Length: num(0)},
+			wantErr: true,
+		},
+		{
+			name:    "LengthPrefix",
+			framing: SocketFraming{Type: str("length_prefix"), PrefixSize: num(4)},
+			want:    sockexec.Framing{Type: sockexec.FramingTypeLengthPrefix, PrefixSize: 4, ByteOrder: binary.BigEndian},
+		},
+		{
+			name:    "LengthPrefixLittle",
+			framing: SocketFraming{Type: str("length_prefix"), PrefixSize: num(2), ByteOrder: str("little")},
+			want:    sockexec.Framing{Type: sockexec.FramingTypeLengthPrefix, PrefixSize: 2, ByteOrder: binary.LittleEndian},
+		},
+		{
+			name:    "LengthPrefixInvalidSize",
+			framing: SocketFraming{Type: str("length_prefix"), PrefixSize: num(3)},
+			wantErr: true,
+		},
+		{
+			name:    "LengthPrefixInvalidByteOrder",
+			framing: SocketFraming{Type: str("length_prefix"), PrefixSize: num(2), ByteOrder: str("middle")},
+			wantErr: true,
+		},
+		{
+			name:    "InvalidType",
+			framing: SocketFraming{Type: str("chunked")},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			got, err := test.framing.Validate()
+			if (err != nil) != test.wantErr {
+				tt.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+			if test.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				tt.Errorf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+// TestNewSocketPayload tests the encoding of the body into the socket payload.
+func TestNewSocketPayload(t *testing.T) {
+	tests := []struct {
+		name        string
+		payloadType SocketPayloadType
+		body        any
+		want        []byte
+		wantErr     bool
+	}{
+		{name: "Nil", payloadType: SocketPayloadTypeText, body: nil, want: nil},
+		{name: "Text", payloadType: SocketPayloadTypeText, body: "PING\r\n", want: []byte("PING\r\n")},
+		{name: "Hex", payloadType: SocketPayloadTypeHex, body: "de ad\nbe ef", want: []byte{0xde, 0xad, 0xbe, 0xef}},
+		{name: "InvalidHex", payloadType: SocketPayloadTypeHex, body: "zz", wantErr: true},
+		{name: "Base64", payloadType: SocketPayloadTypeBase64, body: " AAEC\n", want: []byte{0, 1, 2}},
+		{name: "InvalidBase64", payloadType: SocketPayloadTypeBase64, body: "!!", wantErr: true},
+		{name: "NotString", payloadType: SocketPayloadTypeText, body: map[string]any{"a": 1}, wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			got, err := newSocketPayload(test.payloadType, test.body)
+			if (err != nil) != test.wantErr {
+				tt.Fatalf("expected error %v, got %v", test.wantErr, err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				tt.Errorf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
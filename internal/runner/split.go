@@ -0,0 +1,147 @@
+package runner
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// SplitBy represents the way the total is divided across the executors
+type SplitBy string
+
+const (
+	// SplitByWeight represents dividing by the weight of the executors
+	SplitByWeight SplitBy = "weight"
+	// SplitByCapacity represents dividing by the capacity the slaves report at connect time
+	SplitByCapacity SplitBy = "capacity"
+
+	// DefaultSplitBy represents the default way of the split
+	DefaultSplitBy = SplitByWeight
+	// DefaultExecutorWeight represents the default weight of the executor
+	DefaultExecutorWeight = 1.0
+)
+
+// FlowStepFlowSplit represents the totals divided across the executors of the slave command
+type FlowStepFlowSplit struct {
+	By     *string                  `yaml:"by"`
+	Values []FlowStepFlowSplitValue `yaml:"values"`
+}
+
+// FlowStepFlowSplitValue represents a total divided across the executors
+type FlowStepFlowSplitValue struct {
+	Key   *string `yaml:"key"`
+	Total *int    `yaml:"total"`
+}
+
+// ValidFlowStepFlowSplit represents the valid split of the slave command
+type ValidFlowStepFlowSplit struct {
+	Enabled bool
+	By      SplitBy
+	Values  []ValidFlowStepFlowSplitValue
+}
+
+// ValidFlowStepFlowSplitValue represents the valid total divided across the executors
+type ValidFlowStepFlowSplitValue struct {
+	Key   string
+	Total int
+}
+
+// Validate validates the FlowStepFlowSplit
+func (s FlowStepFlowSplit) Validate() (ValidFlowStepFlowSplit, error) {
+	if len(s.Values) == 0 {
+		return ValidFlowStepFlowSplit{}, nil
+	}
+	valid := ValidFlowStepFlowSplit{
+		Enabled: true,
+		By:      DefaultSplitBy,
+	}
+	if s.By != nil {
+		switch SplitBy(*s.By) {
+		case SplitByWeight, SplitByCapacity:
+			valid.By = SplitBy(*s.By)
+		default:
+			return ValidFlowStepFlowSplit{}, fmt.Errorf("invalid by value: %s", *s.By)
+		}
+	}
+	keys := make(map[string]struct{}, len(s.Values)*2)
+	for i, v := range s.Values {
+		if v.Key == nil {
+			return ValidFlowStepFlowSplit{}, fmt.Errorf("key is required at values[%d]", i)
+		}
+		if v.Total == nil {
+			return ValidFlowStepFlowSplit{}, fmt.Errorf("total is required at values[%d]", i)
+		}
+		if *v.Total < 0 {
+			return ValidFlowStepFlowSplit{}, fmt.Errorf("total must be greater than or equal to 0 at values[%d]", i)
+		}
+		for _, k := range []string{*v.Key, splitOffsetKey(*v.Key)} {
+			if k == "SlaveID" || k == "Index" {
+				return ValidFlowStepFlowSplit{}, fmt.Errorf("key %s is reserved at values[%d]", *v.Key, i)
+			}
+			if _, ok := keys[k]; ok {
+				return ValidFlowStepFlowSplit{}, fmt.Errorf("key %s is duplicated at values[%d]", k, i)
+			}
+			keys[k] = struct{}{}
+		}
+		valid.Values = append(valid.Values, ValidFlowStepFlowSplitValue{
+			Key:   *v.Key,
+			Total: *v.Total,
+		})
+	}
+	return valid, nil
+}
+
+// splitOffsetKey returns the key of the offset of the share, the sum of the shares of the preceding executors
+func splitOffsetKey(key string) string {
+	return key + "Offset"
+}
+
+// Shares returns the slave values of each executor, the share of every total and its offset.
+// The weights are the weights of the executors, or the capacities of the slaves when split by capacity.
+func (s ValidFlowStepFlowSplit) Shares(weights []float64) []map[string]any {
+	values := make([]map[string]any, len(weights))
+	for i := range values {
+		values[i] = make(map[string]any, len(s.Values)*2)
+	}
+	for _, v := range s.Values {
+		offset := 0
+		for i, share := range divideTotal(v.Total, weights) {
+			values[i][v.Key] = share
+			values[i][splitOffsetKey(v.Key)] = offset
+			offset += share
+		}
+	}
+	return values
+}
+
+// divideTotal divides the total in proportion to the weights with the largest remainder method,
+// so that the shares are integers summing up to the total
+func divideTotal(total int, weights []float64) []int {
+	shares := make([]int, len(weights))
+	var sum float64
+	for _, w := range weights {
+		sum += w
+	}
+	if sum <= 0 {
+		return shares
+	}
+	type remainder struct {
+		index int
+		value float64
+	}
+	remainders := make([]remainder, len(weights))
+	rest := total
+	for i, w := range weights {
+		exact := float64(total) * w / sum
+		shares[i] = int(math.Floor(exact))
+		rest -= shares[i]
+		remainders[i] = remainder{index: i, value: exact - float64(shares[i])}
+	}
+	sort.SliceStable(remainders, func(i, j int) bool {
+		return remainders[i].value > remainders[j].value
+	})
+	for i := 0; i < rest && i < len(remainders); i++ {
+		shares[remainders[i].index]++
+	}
+	return shares
+}
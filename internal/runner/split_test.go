@@ -0,0 +1,80 @@
+package runner
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestDivideTotal tests the division of the total in proportion to the weights.
+func TestDivideTotal(t *testing.T) {
+	tests := []struct {
+		name    string
+		total   int
+		weights []float64
+		want    []int
+	}{
+		{name: "Even", total: 10, weights: []float64{1, 1}, want: []int{5, 5}},
+		{name: "Proportional", total: 100, weights: []float64{1, 3}, want: []int{25, 75}},
+		{name: "RemainderToFirst", total: 10, weights: []float64{1, 1, 1}, want: []int{4, 3, 3}},
+		{name: "LargestRemainder", total: 7, weights: []float64{0.5, 0.25, 0.25}, want: []int{3, 2, 2}},
+		{name: "ZeroWeight", total: 3, weights: []float64{1, 0, 2}, want: []int{1, 0, 2}},
+		{name: "ZeroTotal", total: 0, weights: []float64{1, 2}, want: []int{0, 0}},
+		{name: "NoWeight", total: 5, weights: []float64{0, 0}, want: []int{0, 0}},
+		{name: "NoExecutor", total: 5, weights: []float64{}, want: []int{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			got := divideTotal(tc.total, tc.weights)
+			if !reflect.DeepEqual(got, tc.want) {
+				tt.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+// TestShares tests the shares and the offsets of the slave values.
+func TestShares(t *testing.T) {
+	split := ValidFlowStepFlowSplit{
+		Values: []ValidFlowStepFlowSplitValue{
+			{Key: "Threads", Total: 10},
+			{Key: "Rows", Total: 7},
+		},
+	}
+	tests := []struct {
+		name    string
+		weights []float64
+		want    []map[string]any
+	}{
+		{
+			name:    "Even",
+			weights: []float64{1, 1},
+			want: []map[string]any{
+				{"Threads": 5, "ThreadsOffset": 0, "Rows": 4, "RowsOffset": 0},
+				{"Threads": 5, "ThreadsOffset": 5, "Rows": 3, "RowsOffset": 4},
+			},
+		},
+		{
+			name:    "Weighted",
+			weights: []float64{4, 1},
+			want: []map[string]any{
+				{"Threads": 8, "ThreadsOffset": 0, "Rows": 6, "RowsOffset": 0},
+				{"Threads": 2, "ThreadsOffset": 8, "Rows": 1, "RowsOffset": 6},
+			},
+		},
+		{
+			name:    "Single",
+			weights: []float64{2},
+			want: []map[string]any{
+				{"Threads": 10, "ThreadsOffset": 0, "Rows": 7, "RowsOffset": 0},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			got := split.Shares(tc.weights)
+			if !reflect.DeepEqual(got, tc.want) {
+				tt.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,576 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/HdrHistogram/hdrhistogram-go"
+
+	"github.com/ablankz/bloader/internal/executor/httpexec"
+	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/output"
+	"github.com/ablankz/bloader/internal/runner/matcher"
+)
+
+const (
+	// histogramMinValue is the lowest trackable latency in microseconds
+	histogramMinValue = 1
+	// histogramMaxValue is the highest trackable latency in microseconds
+	histogramMaxValue = int64(time.Hour / time.Microsecond)
+	// histogramSigFigs is the number of significant figures of the histogram
+	histogramSigFigs = 3
+)
+
+// ExecStats represents the in-memory aggregation of the responses
+type ExecStats struct {
+	mu          sync.Mutex
+	histogram   *hdrhistogram.Histogram
+	startTime   time.Time
+	endTime     time.Time
+	total       int64
+	success     int64
+	statusCodes map[int]int64
+	errors      map[matcher.TerminateType]int64
+	operations  map[string]*OperationSummary
+	stream      *streamStats
+}
+
+// streamStats represents the aggregation of the stream responses
+type streamStats struct {
+	responses  int64
+	events     int64
+	ttfb       *hdrhistogram.Histogram
+	firstEvent *hdrhistogram.Histogram
+	eventGap   *hdrhistogram.Histogram
+}
+
+func newHistogram() *hdrhistogram.Histogram {
+	return hdrhistogram.New(histogramMinValue, histogramMaxValue, histogramSigFigs)
+}
+
+func newStreamStats() *streamStats {
+	return &streamStats{
+		ttfb:       newHistogram(),
+		firstEvent: newHistogram(),
+		eventGap:   newHistogram(),
+	}
+}
+
+// recordDuration records the duration in microseconds, clamped to the trackable range
+func recordDuration(h *hdrhistogram.Histogram, d time.Duration) {
+	v := d.Microseconds()
+	if v < histogramMinValue {
+		v = histogramMinValue
+	}
+	if v > histogramMaxValue {
+		v = histogramMaxValue
+	}
+	// the value is clamped to the trackable range, so no error is expected
+	_ = h.RecordValue(v)
+}
+
+// NewExecStats creates a new ExecStats
+func NewExecStats() *ExecStats {
+	return &ExecStats{
+		histogram:   newHistogram(),
+		statusCodes: make(map[int]int64),
+		errors:      make(map[matcher.TerminateType]int64),
+		operations:  make(map[string]*OperationSummary),
+	}
+}
+
+// Record records the response
+func (s *ExecStats) Record(res httpexec.ResponseContent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.total++
+	if res.Success {
+		s.success++
+	}
+	if !res.StartTime.IsZero() && (s.startTime.IsZero() || res.StartTime.Before(s.startTime)) {
+		s.startTime = res.StartTime
+	}
+	if res.EndTime.After(s.endTime) {
+		s.endTime = res.EndTime
+	}
+	switch {
+	case res.ReqCreateHasErr:
+		s.errors[matcher.TerminateTypeByCreateRequestError]++
+	case res.HasSystemErr:
+		s.errors[matcher.TerminateTypeBySystemError]++
+	case res.ParseResHasErr:
+		s.errors[matcher.TerminateTypeByParseResponseError]++
+	case res.HasGraphQLErr:
+		s.errors[matcher.TerminateTypeByGraphQLError]++
+	}
+	if res.Operation != "" {
+		op, ok := s.operations[res.Operation]
+		if !ok {
+			op = &OperationSummary{}
+			s.operations[res.Operation] = op
+		}
+		op.Total++
+		if res.Success {
+			op.Success++
+		}
+		if res.HasGraphQLErr {
+			op.GraphQLErrors++
+		}
+	}
+	if res.HasSystemErr || res.StartTime.IsZero() {
+		return
+	}
+	s.statusCodes[res.StatusCode]++
+	recordDuration(s.histogram, res.EndTime.Sub(res.StartTime))
+	if res.Stream != nil {
+		if s.stream == nil {
+			s.stream = newStreamStats()
+		}
+		s.stream.responses++
+		s.stream.events += int64(len(res.Stream.Events))
+		recordDuration(s.stream.ttfb, res.Stream.TimeToFirstByte(res.StartTime))
+		if d, ok := res.Stream.TimeToFirstEvent(res.StartTime); ok {
+			recordDuration(s.stream.firstEvent, d)
+		}
+		for _, gap := range res.Stream.EventGaps() {
+			recordDuration(s.stream.eventGap, gap)
+		}
+	}
+}
+
+// RecordError records the error which is not bound to a response
+func (s *ExecStats) RecordError(termType matcher.TerminateType) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors[termType]++
+}
+
+// Merge merges the other stats into the stats
+func (s *ExecStats) Merge(other *ExecStats) {
+	other.mu.Lock()
+	defer other.mu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.histogram.Merge(other.histogram)
+	if !other.startTime.IsZero() && (s.startTime.IsZero() || other.startTime.Before(s.startTime)) {
+		s.startTime = other.startTime
+	}
+	if other.endTime.After(s.endTime) {
+		s.endTime = other.endTime
+	}
+	s.total += other.total
+	s.success += other.success
+	for k, v := range other.statusCodes {
+		s.statusCodes[k] += v
+	}
+	for k, v := range other.errors {
+		s.errors[k] += v
+	}
+	for k, v := range other.operations {
+		op, ok := s.operations[k]
+		if !ok {
+			op = &OperationSummary{}
+			s.operations[k] = op
+		}
+		op.Total += v.Total
+		op.Success += v.Success
+		op.GraphQLErrors += v.GraphQLErrors
+	}
+	if other.stream != nil {
+		if s.stream == nil {
+			s.stream = newStreamStats()
+		}
+		s.stream.responses += other.stream.responses
+		s.stream.events += other.stream.events
+		s.stream.ttfb.Merge(other.stream.ttfb)
+		s.stream.firstEvent.Merge(other.stream.firstEvent)
+		s.stream.eventGap.Merge(other.stream.eventGap)
+	}
+}
+
+// execStatsJSON represents the json of the ExecStats, with the histograms in the compressed HdrHistogram encoding
+type execStatsJSON struct {
+	Histogram   string                          `json:"histogram"`
+	StartTime   time.Time                       `json:"start_time"`
+	EndTime     time.Time                       `json:"end_time"`
+	Total       int64                           `json:"total"`
+	Success     int64                           `json:"success"`
+	StatusCodes map[int]int64                   `json:"status_codes"`
+	Errors      map[matcher.TerminateType]int64 `json:"errors"`
+	Operations  map[string]OperationSummary     `json:"operations,omitempty"`
+	Stream      *streamStatsJSON                `json:"stream,omitempty"`
+}
+
+// streamStatsJSON represents the json of the streamStats
+type streamStatsJSON struct {
+	Responses  int64  `json:"responses"`
+	Events     int64  `json:"events"`
+	TTFB       string `json:"ttfb"`
+	FirstEvent string `json:"first_event"`
+	EventGap   string `json:"event_gap"`
+}
+
+func encodeHistogram(h *hdrhistogram.Histogram) (string, error) {
+	b, err := h.Encode(hdrhistogram.V2CompressedEncodingCookieBase)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode histogram: %w", err)
+	}
+	return string(b), nil
+}
+
+func decodeHistogram(s string) (*hdrhistogram.Histogram, error) {
+	h, err := hdrhistogram.Decode([]byte(s))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode histogram: %w", err)
+	}
+	return h, nil
+}
+
+// MarshalJSON encodes the stats, so that the slaves send the stats of a command to the master
+func (s *ExecStats) MarshalJSON() ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data := execStatsJSON{
+		StartTime:   s.startTime,
+		EndTime:     s.endTime,
+		Total:       s.total,
+		Success:     s.success,
+		StatusCodes: s.statusCodes,
+		Errors:      s.errors,
+	}
+	var err error
+	if data.Histogram, err = encodeHistogram(s.histogram); err != nil {
+		return nil, err
+	}
+	if len(s.operations) > 0 {
+		data.Operations = make(map[string]OperationSummary, len(s.operations))
+		for k, v := range s.operations {
+			data.Operations[k] = *v
+		}
+	}
+	if s.stream != nil {
+		data.Stream = &streamStatsJSON{
+			Responses: s.stream.responses,
+			Events:    s.stream.events,
+		}
+		if data.Stream.TTFB, err = encodeHistogram(s.stream.ttfb); err != nil {
+			return nil, err
+		}
+		if data.Stream.FirstEvent, err = encodeHistogram(s.stream.firstEvent); err != nil {
+			return nil, err
+		}
+		if data.Stream.EventGap, err = encodeHistogram(s.stream.eventGap); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(data)
+}
+
+// UnmarshalJSON decodes the stats encoded by MarshalJSON
+func (s *ExecStats) UnmarshalJSON(b []byte) error {
+	var data execStatsJSON
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	decoded := NewExecStats()
+	var err error
+	if decoded.histogram, err = decodeHistogram(data.Histogram); err != nil {
+		return err
+	}
+	decoded.startTime = data.StartTime
+	decoded.endTime = data.EndTime
+	decoded.total = data.Total
+	decoded.success = data.Success
+	for k, v := range data.StatusCodes {
+		decoded.statusCodes[k] = v
+	}
+	for k, v := range data.Errors {
+		decoded.errors[k] = v
+	}
+	for k, v := range data.Operations {
+		op := v
+		decoded.operations[k] = &op
+	}
+	if data.Stream != nil {
+		decoded.stream = &streamStats{
+			responses: data.Stream.Responses,
+			events:    data.Stream.Events,
+		}
+		if decoded.stream.ttfb, err = decodeHistogram(data.Stream.TTFB); err != nil {
+			return err
+		}
+		if decoded.stream.firstEvent, err = decodeHistogram(data.Stream.FirstEvent); err != nil {
+			return err
+		}
+		if decoded.stream.eventGap, err = decodeHistogram(data.Stream.EventGap); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.histogram = decoded.histogram
+	s.startTime = decoded.startTime
+	s.endTime = decoded.endTime
+	s.total = decoded.total
+	s.success = decoded.success
+	s.statusCodes = decoded.statusCodes
+	s.errors = decoded.errors
+	s.operations = decoded.operations
+	s.stream = decoded.stream
+	return nil
+}
+
+// Summary returns the summary of the stats
+func (s *ExecStats) Summary(name string) ExecSummary {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	summary := ExecSummary{
+		Name:        name,
+		StartTime:   s.startTime,
+		EndTime:     s.endTime,
+		Total:       s.total,
+		Success:     s.success,
+		Failure:     s.total - s.success,
+		StatusCodes: make(map[string]int64, len(s.statusCodes)),
+		Errors:      make(map[string]int64, len(s.errors)),
+	}
+	if s.total > 0 {
+		summary.ErrorRate = float64(summary.Failure) / float64(s.total)
+	}
+	if elapsed := s.endTime.Sub(s.startTime).Seconds(); elapsed > 0 {
+		summary.RPS = float64(s.total) / elapsed
+	}
+	for k, v := range s.statusCodes {
+		summary.StatusCodes[strconv.Itoa(k)] = v
+	}
+	for k, v := range s.errors {
+		summary.Errors[k.String()] = v
+	}
+	if len(s.operations) > 0 {
+		summary.Operations = make(map[string]OperationSummary, len(s.operations))
+		for k, v := range s.operations {
+			summary.Operations[k] = *v
+		}
+	}
+	summary.Latency = newLatencySummary(s.histogram)
+	if s.stream != nil {
+		summary.Stream = &StreamSummary{
+			Responses:        s.stream.responses,
+			Events:           s.stream.events,
+			TimeToFirstByte:  newLatencySummary(s.stream.ttfb),
+			TimeToFirstEvent: newLatencySummary(s.stream.firstEvent),
+			EventGap:         newLatencySummary(s.stream.eventGap),
+		}
+	}
+	return summary
+}
+
+// newLatencySummary returns the percentiles of the histogram, zero if it is empty
+func newLatencySummary(h *hdrhistogram.Histogram) LatencySummary {
+	if h.TotalCount() == 0 {
+		return LatencySummary{}
+	}
+	return LatencySummary{
+		Min:  microToMilli(h.Min()),
+		Mean: h.Mean() / 1000,
+		P50:  microToMilli(h.ValueAtQuantile(50)),
+		P90:  microToMilli(h.ValueAtQuantile(90)),
+		P95:  microToMilli(h.ValueAtQuantile(95)),
+		P99:  microToMilli(h.ValueAtQuantile(99)),
+		Max:  microToMilli(h.Max()),
+	}
+}
+
+func microToMilli(v int64) float64 {
+	return float64(v) / 1000
+}
+
+// LatencySummary represents the latency percentiles in milliseconds
+type LatencySummary struct {
+	Min  float64 `json:"min_ms"`
+	Mean float64 `json:"mean_ms"`
+	P50  float64 `json:"p50_ms"`
+	P90  float64 `json:"p90_ms"`
+	P95  float64 `json:"p95_ms"`
+	P99  float64 `json:"p99_ms"`
+	Max  float64 `json:"max_ms"`
+}
+
+// OperationSummary represents the result of a GraphQL operation
+type OperationSummary struct {
+	Total         int64 `json:"total"`
+	Success       int64 `json:"success"`
+	GraphQLErrors int64 `json:"graphql_errors"`
+}
+
+// StreamSummary represents the timing of the stream responses (response_type sse or ndjson)
+type StreamSummary struct {
+	Responses        int64          `json:"responses"`
+	Events           int64          `json:"events"`
+	TimeToFirstByte  LatencySummary `json:"time_to_first_byte"`
+	TimeToFirstEvent LatencySummary `json:"time_to_first_event"`
+	EventGap         LatencySummary `json:"event_gap"`
+}
+
+// ArrivalRateSummary represents the scheduling of the arrivals (arrival rate mode)
+type ArrivalRateSummary struct {
+	Scheduled int64 `json:"scheduled"`
+	Sent      int64 `json:"sent"`
+	Dropped   int64 `json:"dropped"`
+	Delayed   int64 `json:"delayed"`
+}
+
+// newArrivalRateSummary creates a new ArrivalRateSummary from the stats of the schedule
+func newArrivalRateSummary(stats *httpexec.ArrivalRateStats) *ArrivalRateSummary {
+	if stats == nil {
+		return nil
+	}
+	return &ArrivalRateSummary{
+		Scheduled: stats.Scheduled.Load(),
+		Sent:      stats.Sent.Load(),
+		Dropped:   stats.Dropped.Load(),
+		Delayed:   stats.Delayed.Load(),
+	}
+}
+
+// add adds the counts of other to s
+func (s *ArrivalRateSummary) add(other ArrivalRateSummary) {
+	s.Scheduled += other.Scheduled
+	s.Sent += other.Sent
+	s.Dropped += other.Dropped
+	s.Delayed += other.Delayed
+}
+
+// ExecSummary represents the aggregated result of a request
+type ExecSummary struct {
+	Name           string                      `json:"name"`
+	StartTime      time.Time                   `json:"start_time"`
+	EndTime        time.Time                   `json:"end_time"`
+	Total          int64                       `json:"total"`
+	Success        int64                       `json:"success"`
+	Failure        int64                       `json:"failure"`
+	ErrorRate      float64                     `json:"error_rate"`
+	RPS            float64                     `json:"rps"`
+	Latency        LatencySummary              `json:"latency"`
+	StatusCodes    map[string]int64            `json:"status_codes"`
+	Errors         map[string]int64            `json:"errors"`
+	Operations     map[string]OperationSummary `json:"operations,omitempty"`
+	Stream         *StreamSummary              `json:"stream,omitempty"`
+	ArrivalRate    *ArrivalRateSummary         `json:"arrival_rate,omitempty"`
+	TerminateType  string                      `json:"terminate_type,omitempty"`
+	TerminateParam string                      `json:"terminate_param,omitempty"`
+}
+
+// RunSummary represents the summary of a MassExecute run
+type RunSummary struct {
+	Kind       Kind          `json:"kind"`
+	OutputRoot string        `json:"output_root"`
+	Requests   []ExecSummary `json:"requests"`
+	Total      ExecSummary   `json:"total"`
+}
+
+// Print prints the summary table
+func (s RunSummary) Print(w io.Writer) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tTOTAL\tSUCCESS\tFAILURE\tERROR RATE\tRPS\tMIN\tMEAN\tP50\tP90\tP95\tP99\tMAX\tTERMINATE")
+	rows := make([]ExecSummary, 0, len(s.Requests)+1)
+	rows = append(rows, s.Requests...)
+	rows = append(rows, s.Total)
+	for _, r := range rows {
+		term := r.TerminateType
+		if r.TerminateParam != "" {
+			term = fmt.Sprintf("%s(%s)", term, r.TerminateParam)
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%.2f%%\t%.2f\t%.2fms\t%.2fms\t%.2fms\t%.2fms\t%.2fms\t%.2fms\t%.2fms\t%s\n",
+			r.Name, r.Total, r.Success, r.Failure, r.ErrorRate*100, r.RPS,
+			r.Latency.Min, r.Latency.Mean, r.Latency.P50, r.Latency.P90,
+			r.Latency.P95, r.Latency.P99, r.Latency.Max, term)
+	}
+	tw.Flush()
+	fmt.Fprintf(w, "Status Codes: %s\n", formatCounters(s.Total.StatusCodes))
+	fmt.Fprintf(w, "Errors: %s\n", formatCounters(s.Total.Errors))
+	if len(s.Total.Operations) > 0 {
+		names := make([]string, 0, len(s.Total.Operations))
+		for name := range s.Total.Operations {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Fprintln(w, "Operations:")
+		for _, name := range names {
+			op := s.Total.Operations[name]
+			fmt.Fprintf(w, "  %s: total=%d, success=%d, graphqlErrors=%d\n", name, op.Total, op.Success, op.GraphQLErrors)
+		}
+	}
+	if s.Total.ArrivalRate != nil {
+		fmt.Fprintln(w, "Arrival Rate:")
+		for _, r := range rows {
+			if ar := r.ArrivalRate; ar != nil {
+				fmt.Fprintf(w, "  %s: scheduled=%d, sent=%d, dropped=%d, delayed=%d\n",
+					r.Name, ar.Scheduled, ar.Sent, ar.Dropped, ar.Delayed)
+			}
+		}
+	}
+	if st := s.Total.Stream; st != nil {
+		fmt.Fprintf(w, "Stream: responses=%d, events=%d\n", st.Responses, st.Events)
+		for _, l := range []struct {
+			name    string
+			latency LatencySummary
+		}{
+			{"Time To First Byte", st.TimeToFirstByte},
+			{"Time To First Event", st.TimeToFirstEvent},
+			{"Event Gap", st.EventGap},
+		} {
+			fmt.Fprintf(w, "  %s: p50=%.2fms, p95=%.2fms, p99=%.2fms, max=%.2fms\n",
+				l.name, l.latency.P50, l.latency.P95, l.latency.P99, l.latency.Max)
+		}
+	}
+}
+
+func formatCounters(counters map[string]int64) string {
+	if len(counters) == 0 {
+		return "-"
+	}
+	keys := make([]string, 0, len(counters))
+	for k := range counters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var str string
+	for i, k := range keys {
+		if i > 0 {
+			str += ", "
+		}
+		str += fmt.Sprintf("%s=%d", k, counters[k])
+	}
+	return str
+}
+
+// writeSummary writes the summary to the outputs
+func writeSummary(
+	ctx context.Context,
+	log logger.Logger,
+	outputs []output.Output,
+	outputRoot string,
+	summary RunSummary,
+) error {
+	if len(outputs) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(summary)
+	if err != nil {
+		return fmt.Errorf("failed to marshal summary: %w", err)
+	}
+	for _, o := range outputs {
+		if err := o.SummaryWrite(ctx, log, outputRoot, data); err != nil {
+			return fmt.Errorf("failed to write summary: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+const (
+	// DefaultSyncStartLeadTime represents the default time between the dispatch and the start of the command
+	DefaultSyncStartLeadTime = 2 * time.Second
+	// DefaultSyncStartMaxClockOffset represents the clock offset of the slave from the master above which a warning is logged,
+	// when max_clock_offset is not set
+	DefaultSyncStartMaxClockOffset = 100 * time.Millisecond
+	// clockSyncSamples is the number of the round trips to measure the clock offset,
+	// the sample with the shortest round trip is used
+	clockSyncSamples = 4
+)
+
+// FlowStepFlowSyncStart represents the synchronized start of the slave command
+type FlowStepFlowSyncStart struct {
+	Disabled       bool    `yaml:"disabled"`
+	LeadTime       *string `yaml:"lead_time"`
+	MaxClockOffset *string `yaml:"max_clock_offset"`
+}
+
+// ValidFlowStepFlowSyncStart represents the valid synchronized start of the slave command
+type ValidFlowStepFlowSyncStart struct {
+	Enabled        bool
+	LeadTime       time.Duration
+	MaxClockOffset time.Duration
+	// RejectClockOffset is set when max_clock_offset is set, rejecting the slave beyond it instead of warning
+	RejectClockOffset bool
+}
+
+// Validate validates the FlowStepFlowSyncStart
+func (s FlowStepFlowSyncStart) Validate() (ValidFlowStepFlowSyncStart, error) {
+	valid := ValidFlowStepFlowSyncStart{
+		Enabled:        !s.Disabled,
+		LeadTime:       DefaultSyncStartLeadTime,
+		MaxClockOffset: DefaultSyncStartMaxClockOffset,
+	}
+	var err error
+	if s.LeadTime != nil {
+		if valid.LeadTime, err = time.ParseDuration(*s.LeadTime); err != nil {
+			return ValidFlowStepFlowSyncStart{}, fmt.Errorf("failed to parse lead_time: %w", err)
+		}
+		if valid.LeadTime <= 0 {
+			return ValidFlowStepFlowSyncStart{}, fmt.Errorf("lead_time must be greater than 0")
+		}
+	}
+	if s.MaxClockOffset != nil {
+		if valid.MaxClockOffset, err = time.ParseDuration(*s.MaxClockOffset); err != nil {
+			return ValidFlowStepFlowSyncStart{}, fmt.Errorf("failed to parse max_clock_offset: %w", err)
+		}
+		if valid.MaxClockOffset < 0 {
+			return ValidFlowStepFlowSyncStart{}, fmt.Errorf("max_clock_offset must be greater than or equal to 0")
+		}
+		valid.RejectClockOffset = true
+	}
+	return valid, nil
+}
+
+// errClockSyncUnsupported is returned when the slave does not support the clock synchronization
+var errClockSyncUnsupported = errors.New("slave does not support the clock synchronization")
+
+// measureClockOffset measures the offset of the slave clock from the master clock.
+// The offset is positive when the slave clock is ahead of the master clock.
+func (d *ConnectionMapData) measureClockOffset(ctx context.Context, connectionID string) (time.Duration, time.Duration, error) {
+	var offset time.Duration
+	rtt := time.Duration(-1)
+	for i := 0; i < clockSyncSamples; i++ {
+		sendTime := time.Now()
+		res, err := d.Cli.SyncClock(ctx, &pb.SyncClockRequest{
+			ConnectionId: connectionID,
+		})
+		receiveTime := time.Now()
+		if status.Code(err) == codes.Unimplemented {
+			return 0, 0, errClockSyncUnsupported
+		}
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to sync clock: %w", err)
+		}
+		sampleOffset, sampleRTT := clockOffset(sendTime, receiveTime,
+			time.Unix(0, res.ReceiveTimeUnixNano), time.Unix(0, res.SendTimeUnixNano))
+		if rtt >= 0 && sampleRTT >= rtt {
+			continue
+		}
+		offset, rtt = sampleOffset, sampleRTT
+	}
+	return offset, rtt, nil
+}
+
+// clockOffset returns the offset of the slave clock and the round trip time of a sample, the same as NTP.
+// The request is sent at sendTime and its response received at receiveTime on the master clock,
+// and the slave receives the request at slaveReceiveTime and sends the response at slaveSendTime on its clock.
+func clockOffset(sendTime, receiveTime, slaveReceiveTime, slaveSendTime time.Time) (time.Duration, time.Duration) {
+	rtt := receiveTime.Sub(sendTime) - slaveSendTime.Sub(slaveReceiveTime)
+	offset := (slaveReceiveTime.Sub(sendTime) + slaveSendTime.Sub(receiveTime)) / 2
+	return offset, rtt
+}
+
+// syncStart measures the clock offset of the slaves, and sets the start time of the executors
+// on the clock of each slave. The slave whose clock drifts more than the maximum offset is rejected
+// if max_clock_offset is set, and warned otherwise.
+func syncStart(
+	ctx context.Context,
+	log logger.Logger,
+	conf ValidFlowStepFlowSyncStart,
+	executors []slaveExecutor,
+) error {
+	offsets := make([]time.Duration, len(executors))
+	errs := make([]error, len(executors))
+	var wg sync.WaitGroup
+	for i, e := range executors {
+		wg.Add(1)
+		go func(i int, e slaveExecutor) {
+			defer wg.Done()
+			offset, rtt, err := e.mapData.measureClockOffset(ctx, e.connectionID)
+			if errors.Is(err, errClockSyncUnsupported) {
+				log.Warn(ctx, "slave does not support the synchronized start, the command starts on arrival",
+					logger.Value("slaveID", e.slaveID), logger.Value("on", "syncStart"))
+				return
+			}
+			if err != nil {
+				errs[i] = fmt.Errorf("failed to measure clock offset of slave %s: %w", e.slaveID, err)
+				return
+			}
+			log.Info(ctx, "measured slave clock offset",
+				logger.Value("slaveID", e.slaveID), logger.Value("offset", offset),
+				logger.Value("rtt", rtt), logger.Value("on", "syncStart"))
+			if offset > conf.MaxClockOffset || offset < -conf.MaxClockOffset {
+				if conf.RejectClockOffset {
+					errs[i] = fmt.Errorf("clock offset of slave %s is %s, exceeds max_clock_offset %s",
+						e.slaveID, offset, conf.MaxClockOffset)
+					return
+				}
+				log.Warn(ctx, "slave clock offset is large, the start of the slave is corrected by it",
+					logger.Value("slaveID", e.slaveID), logger.Value("offset", offset),
+					logger.Value("threshold", conf.MaxClockOffset), logger.Value("on", "syncStart"))
+			}
+			offsets[i] = offset
+		}(i, e)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	startAt := time.Now().Add(conf.LeadTime)
+	for i := range executors {
+		executors[i].startAt = startAt.Add(offsets[i])
+	}
+	log.Info(ctx, "slave commands start synchronously",
+		logger.Value("startAt", startAt), logger.Value("slaves", len(executors)), logger.Value("on", "syncStart"))
+	return nil
+}
@@ -0,0 +1,53 @@
+package runner
+
+import (
+	"testing"
+	"time"
+)
+
+// TestClockOffset tests the offset of the slave clock and the round trip time measured from a sample.
+func TestClockOffset(t *testing.T) {
+	base := time.Unix(1700000000, 0)
+	at := func(ms int) time.Time {
+		return base.Add(time.Duration(ms) * time.Millisecond)
+	}
+	tests := []struct {
+		name                          string
+		send, slaveReceive, slaveSend time.Time
+		receive                       time.Time
+		wantOffset, wantRTT           time.Duration
+	}{
+		{
+			name: "Synchronized",
+			send: at(0), slaveReceive: at(10), slaveSend: at(12), receive: at(22),
+			wantOffset: 0, wantRTT: 20 * time.Millisecond,
+		},
+		{
+			name: "SlaveAhead",
+			send: at(0), slaveReceive: at(110), slaveSend: at(112), receive: at(22),
+			wantOffset: 100 * time.Millisecond, wantRTT: 20 * time.Millisecond,
+		},
+		{
+			name: "SlaveBehind",
+			send: at(0), slaveReceive: at(-40), slaveSend: at(-38), receive: at(22),
+			wantOffset: -50 * time.Millisecond, wantRTT: 20 * time.Millisecond,
+		},
+		{
+			// the offset is wrong by half the asymmetry of the paths, as for NTP
+			name: "AsymmetricPaths",
+			send: at(0), slaveReceive: at(30), slaveSend: at(30), receive: at(40),
+			wantOffset: 10 * time.Millisecond, wantRTT: 40 * time.Millisecond,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			offset, rtt := clockOffset(tc.send, tc.receive, tc.slaveReceive, tc.slaveSend)
+			if offset != tc.wantOffset {
+				tt.Errorf("expected offset %s, got %s", tc.wantOffset, offset)
+			}
+			if rtt != tc.wantRTT {
+				tt.Errorf("expected rtt %s, got %s", tc.wantRTT, rtt)
+			}
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ThresholdMetric represents the metric of a threshold
+type ThresholdMetric string
+
+const (
+	// ThresholdMetricP50 represents the 50th percentile latency
+	ThresholdMetricP50 ThresholdMetric = "p50"
+	// ThresholdMetricP90 represents the 90th percentile latency
+	ThresholdMetricP90 ThresholdMetric = "p90"
+	// ThresholdMetricP95 represents the 95th percentile latency
+	ThresholdMetricP95 ThresholdMetric = "p95"
+	// ThresholdMetricP99 represents the 99th percentile latency
+	ThresholdMetricP99 ThresholdMetric = "p99"
+	// ThresholdMetricMin represents the minimum latency
+	ThresholdMetricMin ThresholdMetric = "min"
+	// ThresholdMetricMean represents the mean latency
+	ThresholdMetricMean ThresholdMetric = "mean"
+	// ThresholdMetricMax represents the maximum latency
+	ThresholdMetricMax ThresholdMetric = "max"
+	// ThresholdMetricErrorRate represents the rate of the failed requests
+	ThresholdMetricErrorRate ThresholdMetric = "error_rate"
+	// ThresholdMetricRPS represents the requests per second
+	ThresholdMetricRPS ThresholdMetric = "rps"
+	// ThresholdMetricTotal represents the number of requests
+	ThresholdMetricTotal ThresholdMetric = "total"
+	// ThresholdMetricFailure represents the number of failed requests
+	ThresholdMetricFailure ThresholdMetric = "failure"
+	// ThresholdMetricStatusCode represents the number of responses matching the status code pattern
+	ThresholdMetricStatusCode ThresholdMetric = "status_code"
+	// ThresholdMetricErrors represents the number of errors of the terminate type
+	ThresholdMetricErrors ThresholdMetric = "errors"
+)
+
+// ThresholdOperator represents the comparison operator of a threshold
+type ThresholdOperator string
+
+const (
+	// ThresholdOperatorLT represents the less than operator
+	ThresholdOperatorLT ThresholdOperator = "<"
+	// ThresholdOperatorLE represents the less than or equal operator
+	ThresholdOperatorLE ThresholdOperator = "<="
+	// ThresholdOperatorGT represents the greater than operator
+	ThresholdOperatorGT ThresholdOperator = ">"
+	// ThresholdOperatorGE represents the greater than or equal operator
+	ThresholdOperatorGE ThresholdOperator = ">="
+	// ThresholdOperatorEQ represents the equal operator
+	ThresholdOperatorEQ ThresholdOperator = "=="
+	// ThresholdOperatorNE represents the not equal operator
+	ThresholdOperatorNE ThresholdOperator = "!="
+)
+
+var thresholdRegexp = regexp.MustCompile(`^\s*([a-z_0-9]+)(?:\[([^\]]+)\])?\s*(<=|>=|==|!=|<|>)\s*(\S+)\s*$`)
+
+// ValidThreshold represents a parsed threshold expression such as "p95 < 300ms"
+type ValidThreshold struct {
+	Expr     string
+	Metric   ThresholdMetric
+	Param    string
+	Operator ThresholdOperator
+	Value    float64
+}
+
+// ValidThresholds represents the slice of ValidThreshold
+type ValidThresholds []ValidThreshold
+
+// NewValidThresholds parses the threshold expressions
+func NewValidThresholds(exprs []string) (ValidThresholds, error) {
+	var thresholds ValidThresholds
+	for i, expr := range exprs {
+		t, err := parseThreshold(expr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse thresholds[%d]: %w", i, err)
+		}
+		thresholds = append(thresholds, t)
+	}
+	return thresholds, nil
+}
+
+func parseThreshold(expr string) (ValidThreshold, error) {
+	m := thresholdRegexp.FindStringSubmatch(expr)
+	if m == nil {
+		return ValidThreshold{}, fmt.Errorf("invalid threshold expression: %s", expr)
+	}
+	t := ValidThreshold{
+		Expr:     strings.TrimSpace(expr),
+		Metric:   ThresholdMetric(m[1]),
+		Param:    m[2],
+		Operator: ThresholdOperator(m[3]),
+	}
+	var err error
+	switch t.Metric {
+	case ThresholdMetricP50, ThresholdMetricP90, ThresholdMetricP95, ThresholdMetricP99,
+		ThresholdMetricMin, ThresholdMetricMean, ThresholdMetricMax:
+		t.Value, err = parseThresholdLatency(m[4])
+	case ThresholdMetricErrorRate:
+		t.Value, err = parseThresholdRate(m[4])
+	case ThresholdMetricRPS, ThresholdMetricTotal, ThresholdMetricFailure:
+		t.Value, err = strconv.ParseFloat(m[4], 64)
+	case ThresholdMetricStatusCode, ThresholdMetricErrors:
+		if t.Param == "" {
+			return ValidThreshold{}, fmt.Errorf("%s requires a parameter such as %s[...]: %s", t.Metric, t.Metric, expr)
+		}
+		t.Value, err = strconv.ParseFloat(m[4], 64)
+	default:
+		return ValidThreshold{}, fmt.Errorf("invalid threshold metric: %s", t.Metric)
+	}
+	if err != nil {
+		return ValidThreshold{}, fmt.Errorf("invalid threshold value %s: %w", m[4], err)
+	}
+	if t.Param != "" && t.Metric != ThresholdMetricStatusCode && t.Metric != ThresholdMetricErrors {
+		return ValidThreshold{}, fmt.Errorf("%s does not accept a parameter: %s", t.Metric, expr)
+	}
+	return t, nil
+}
+
+// parseThresholdLatency parses the latency into milliseconds, a bare number is treated as milliseconds
+func parseThresholdLatency(s string) (float64, error) {
+	if v, err := strconv.ParseFloat(s, 64); err == nil {
+		return v, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	return float64(d) / float64(time.Millisecond), nil
+}
+
+// parseThresholdRate parses the rate such as "1%" or "0.01" into a fraction
+func parseThresholdRate(s string) (float64, error) {
+	if p, ok := strings.CutSuffix(s, "%"); ok {
+		v, err := strconv.ParseFloat(p, 64)
+		if err != nil {
+			return 0, err
+		}
+		return v / 100, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// actual returns the value of the metric in the summary
+func (t ValidThreshold) actual(summary ExecSummary) float64 {
+	switch t.Metric {
+	case ThresholdMetricP50:
+		return summary.Latency.P50
+	case ThresholdMetricP90:
+		return summary.Latency.P90
+	case ThresholdMetricP95:
+		return summary.Latency.P95
+	case ThresholdMetricP99:
+		return summary.Latency.P99
+	case ThresholdMetricMin:
+		return summary.Latency.Min
+	case ThresholdMetricMean:
+		return summary.Latency.Mean
+	case ThresholdMetricMax:
+		return summary.Latency.Max
+	case ThresholdMetricErrorRate:
+		return summary.ErrorRate
+	case ThresholdMetricRPS:
+		return summary.RPS
+	case ThresholdMetricTotal:
+		return float64(summary.Total)
+	case ThresholdMetricFailure:
+		return float64(summary.Failure)
+	case ThresholdMetricStatusCode:
+		var count int64
+		for code, v := range summary.StatusCodes {
+			if matchStatusCodePattern(t.Param, code) {
+				count += v
+			}
+		}
+		return float64(count)
+	case ThresholdMetricErrors:
+		return float64(summary.Errors[t.Param])
+	}
+	return 0
+}
+
+// matchStatusCodePattern matches the status code with the pattern such as "5xx" or "404"
+func matchStatusCodePattern(pattern, code string) bool {
+	if len(pattern) != len(code) {
+		return false
+	}
+	for i := range pattern {
+		if pattern[i] != 'x' && pattern[i] != 'X' && pattern[i] != code[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Evaluate evaluates the threshold against the summary
+func (t ValidThreshold) Evaluate(summary ExecSummary) (float64, bool) {
+	actual := t.actual(summary)
+	switch t.Operator {
+	case ThresholdOperatorLT:
+		return actual, actual < t.Value
+	case ThresholdOperatorLE:
+		return actual, actual <= t.Value
+	case ThresholdOperatorGT:
+		return actual, actual > t.Value
+	case ThresholdOperatorGE:
+		return actual, actual >= t.Value
+	case ThresholdOperatorEQ:
+		return actual, actual == t.Value
+	case ThresholdOperatorNE:
+		return actual, actual != t.Value
+	}
+	return actual, false
+}
+
+// ThresholdViolation represents a violated threshold
+type ThresholdViolation struct {
+	Scope  string
+	Expr   string
+	Actual float64
+	// NoRequest is set when no request is recorded to evaluate the threshold
+	NoRequest bool
+}
+
+// String returns the string representation of the violation
+func (v ThresholdViolation) String() string {
+	if v.NoRequest {
+		return fmt.Sprintf("[%s] %s (no request recorded)", v.Scope, v.Expr)
+	}
+	return fmt.Sprintf("[%s] %s (actual: %s)", v.Scope, v.Expr, strconv.FormatFloat(v.Actual, 'f', -1, 64))
+}
+
+// Evaluate evaluates the thresholds against the summary and returns the violations.
+// All the thresholds are violated when the summary has no request, since nothing can be evaluated.
+func (ts ValidThresholds) Evaluate(scope string, summary ExecSummary) []ThresholdViolation {
+	var violations []ThresholdViolation
+	for _, t := range ts {
+		if summary.Total == 0 {
+			violations = append(violations, ThresholdViolation{
+				Scope:     scope,
+				Expr:      t.Expr,
+				NoRequest: true,
+			})
+			continue
+		}
+		actual, ok := t.Evaluate(summary)
+		if !ok {
+			violations = append(violations, ThresholdViolation{
+				Scope:  scope,
+				Expr:   t.Expr,
+				Actual: actual,
+			})
+		}
+	}
+	return violations
+}
+
+// ThresholdRecorder records the threshold violations of a run
+type ThresholdRecorder struct {
+	mu         sync.Mutex
+	violations []ThresholdViolation
+}
+
+// Violations returns the recorded violations
+func (r *ThresholdRecorder) Violations() []ThresholdViolation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]ThresholdViolation(nil), r.violations...)
+}
+
+type thresholdRecorderKey struct{}
+
+// WithThresholdRecorder returns the context which records the threshold violations
+func WithThresholdRecorder(ctx context.Context) (context.Context, *ThresholdRecorder) {
+	recorder := &ThresholdRecorder{}
+	return context.WithValue(ctx, thresholdRecorderKey{}, recorder), recorder
+}
+
+// reportThresholdViolations prints the violations and records them to the recorder of the context.
+// When the context has no recorder, an error is returned so that the caller fails.
+func reportThresholdViolations(ctx context.Context, violations []ThresholdViolation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+	for _, v := range violations {
+		fmt.Println("Threshold Violated:", v.String())
+	}
+	recorder, ok := ctx.Value(thresholdRecorderKey{}).(*ThresholdRecorder)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrThresholdViolated, violations[0].String())
+	}
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.violations = append(recorder.violations, violations...)
+	return nil
+}
+
+type statsCollector struct {
+	stats  *ExecStats
+	parent *statsCollector
+}
+
+type statsCollectorKey struct{}
+
+// WithStatsCollector returns the context which collects the stats of the executions run under it.
+// The slaves collect the stats of a command with it, and send them to the master on the end of the command.
+func WithStatsCollector(ctx context.Context, stats *ExecStats) context.Context {
+	parent, _ := ctx.Value(statsCollectorKey{}).(*statsCollector)
+	return context.WithValue(ctx, statsCollectorKey{}, &statsCollector{
+		stats:  stats,
+		parent: parent,
+	})
+}
+
+// collectStats merges the stats into all collectors of the context
+func collectStats(ctx context.Context, stats *ExecStats) {
+	collector, _ := ctx.Value(statsCollectorKey{}).(*statsCollector)
+	for ; collector != nil; collector = collector.parent {
+		collector.stats.Merge(stats)
+	}
+}
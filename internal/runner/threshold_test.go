@@ -0,0 +1,133 @@
+package runner
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestParseThreshold tests the parsing of the threshold expressions.
+func TestParseThreshold(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		want    ValidThreshold
+		wantErr bool
+	}{
+		{
+			name: "LatencyDuration",
+			expr: "p95 < 300ms",
+			want: ValidThreshold{Expr: "p95 < 300ms", Metric: ThresholdMetricP95, Operator: ThresholdOperatorLT, Value: 300},
+		},
+		{
+			name: "LatencySeconds",
+			expr: "p99<=1.5s",
+			want: ValidThreshold{Expr: "p99<=1.5s", Metric: ThresholdMetricP99, Operator: ThresholdOperatorLE, Value: 1500},
+		},
+		{
+			name: "LatencyMilliseconds",
+			expr: "  mean > 20  ",
+			want: ValidThreshold{Expr: "mean > 20", Metric: ThresholdMetricMean, Operator: ThresholdOperatorGT, Value: 20},
+		},
+		{
+			name: "ErrorRatePercent",
+			expr: "error_rate < 1%",
+			want: ValidThreshold{Expr: "error_rate < 1%", Metric: ThresholdMetricErrorRate, Operator: ThresholdOperatorLT, Value: 0.01},
+		},
+		{
+			name: "ErrorRateFraction",
+			expr: "error_rate <= 0.05",
+			want: ValidThreshold{Expr: "error_rate <= 0.05", Metric: ThresholdMetricErrorRate, Operator: ThresholdOperatorLE, Value: 0.05},
+		},
+		{
+			name: "RPS",
+			expr: "rps >= 100",
+			want: ValidThreshold{Expr: "rps >= 100", Metric: ThresholdMetricRPS, Operator: ThresholdOperatorGE, Value: 100},
+		},
+		{
+			name: "StatusCode",
+			expr: "status_code[5xx] == 0",
+			want: ValidThreshold{Expr: "status_code[5xx] == 0", Metric: ThresholdMetricStatusCode, Param: "5xx", Operator: ThresholdOperatorEQ, Value: 0},
+		},
+		{
+			name: "Errors",
+			expr: "errors[sysError] != 0",
+			want: ValidThreshold{Expr: "errors[sysError] != 0", Metric: ThresholdMetricErrors, Param: "sysError", Operator: ThresholdOperatorNE, Value: 0},
+		},
+		{name: "NoOperator", expr: "p95 300ms", wantErr: true},
+		{name: "UnknownMetric", expr: "latency < 1", wantErr: true},
+		{name: "MissingParam", expr: "status_code == 0", wantErr: true},
+		{name: "UnexpectedParam", expr: "p95[GET] < 300ms", wantErr: true},
+		{name: "InvalidLatency", expr: "p95 < 3xs", wantErr: true},
+		{name: "InvalidRate", expr: "error_rate < abc%", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			got, err := parseThreshold(tc.expr)
+			if tc.wantErr {
+				if err == nil {
+					tt.Errorf("expected an error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				tt.Errorf("expected %+v, got %+v", tc.want, got)
+			}
+		})
+	}
+}
+
+// TestValidThresholdsEvaluate tests the evaluation of the thresholds against a summary.
+func TestValidThresholdsEvaluate(t *testing.T) {
+	summary := ExecSummary{
+		Total:       100,
+		Failure:     2,
+		ErrorRate:   0.02,
+		RPS:         50,
+		Latency:     LatencySummary{Min: 5, Mean: 80, P50: 70, P90: 200, P95: 250, P99: 400, Max: 900},
+		StatusCodes: map[string]int64{"200": 98, "503": 2},
+		Errors:      map[string]int64{"sysError": 1},
+	}
+	tests := []struct {
+		name    string
+		expr    string
+		summary ExecSummary
+		want    []ThresholdViolation
+	}{
+		{name: "LatencySatisfied", expr: "p95 < 300ms", summary: summary},
+		{
+			name: "LatencyViolated", expr: "p95 < 200ms", summary: summary,
+			want: []ThresholdViolation{{Scope: "test", Expr: "p95 < 200ms", Actual: 250}},
+		},
+		{
+			name: "ErrorRateViolated", expr: "error_rate < 1%", summary: summary,
+			want: []ThresholdViolation{{Scope: "test", Expr: "error_rate < 1%", Actual: 0.02}},
+		},
+		{name: "RPSSatisfied", expr: "rps >= 50", summary: summary},
+		{
+			name: "StatusCodePatternViolated", expr: "status_code[5xx] == 0", summary: summary,
+			want: []ThresholdViolation{{Scope: "test", Expr: "status_code[5xx] == 0", Actual: 2}},
+		},
+		{name: "StatusCodePatternSatisfied", expr: "status_code[2xx] >= 98", summary: summary},
+		{name: "ErrorsSatisfied", expr: "errors[sysError] == 1", summary: summary},
+		{name: "ErrorsNotRecorded", expr: "errors[parseError] == 0", summary: summary},
+		{
+			name: "NoRequest", expr: "failure == 0", summary: ExecSummary{},
+			want: []ThresholdViolation{{Scope: "test", Expr: "failure == 0", NoRequest: true}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			thresholds, err := NewValidThresholds([]string{tc.expr})
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			got := thresholds.Evaluate("test", tc.summary)
+			if !reflect.DeepEqual(got, tc.want) {
+				tt.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
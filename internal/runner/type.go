@@ -19,6 +19,8 @@ const (
 	RunnerKindOneExecute Kind = "OneExecute"
 	// RunnerKindMassExecute represents execute multiple requests runner
 	RunnerKindMassExecute Kind = "MassExecute"
+	// RunnerKindVirtualUsers represents the virtual users runner
+	RunnerKindVirtualUsers Kind = "VirtualUsers"
 	// RunnerKindFlow represents the flow runner
 	RunnerKindFlow Kind = "Flow"
 	// RunnerKindSlaveConnect represents the slave connect runner
@@ -51,6 +53,7 @@ func (r Runner) Validate() (ValidRunner, error) {
 		RunnerKindStoreImport,
 		RunnerKindOneExecute,
 		RunnerKindMassExecute,
+		RunnerKindVirtualUsers,
 		RunnerKindFlow,
 		RunnerKindSlaveConnect:
 		kind = Kind(*r.Kind)
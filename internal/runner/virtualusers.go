@@ -0,0 +1,726 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"net/http/cookiejar"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ablankz/bloader/internal/auth"
+	"github.com/ablankz/bloader/internal/executor/httpexec"
+	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/metrics"
+	"github.com/ablankz/bloader/internal/output"
+	"github.com/ablankz/bloader/internal/runner/matcher"
+	"github.com/ablankz/bloader/internal/utils"
+)
+
+// VirtualUsersType represents the type of VirtualUsers
+type VirtualUsersType string
+
+const (
+	// VirtualUsersTypeHTTP represents the HTTP type
+	VirtualUsersTypeHTTP VirtualUsersType = "http"
+)
+
+// errVirtualUsersDurationElapsed is the cause of the context cancellation when the duration has elapsed
+var errVirtualUsersDurationElapsed = errors.New("virtual users duration elapsed")
+
+// VirtualUsers represents the VirtualUsers runner
+type VirtualUsers struct {
+	Type         *string               `yaml:"type"`
+	Output       VirtualUsersOutput    `yaml:"output"`
+	Auth         VirtualUsersAuth      `yaml:"auth"`
+	VUs          *int                  `yaml:"vus"`
+	Iterations   *int                  `yaml:"iterations"`
+	Duration     *string               `yaml:"duration"`
+	ThinkTime    VirtualUsersThinkTime `yaml:"think_time"`
+	SuccessBreak []string              `yaml:"success_break"`
+	Requests     []VirtualUsersRequest `yaml:"requests"`
+}
+
+// ValidVirtualUsers represents the valid VirtualUsers runner
+type ValidVirtualUsers struct {
+	Type       VirtualUsersType
+	Output     []output.Output
+	Auth       auth.SetAuthor
+	VUs        int
+	Iterations httpexec.RequestCountLimit
+	Duration   struct {
+		Enabled bool
+		Time    time.Duration
+	}
+	ThinkTime    ValidVirtualUsersThinkTime
+	SuccessBreak matcher.TerminateTypeAndParamsSlice
+	Requests     []ValidVirtualUsersRequest
+	TmplStr      string
+	ReplaceData  *sync.Map
+}
+
+// Validate validates the VirtualUsers
+func (r VirtualUsers) Validate(
+	ctx context.Context,
+	log logger.Logger,
+	authFactor AuthenticatorFactor,
+	outFactor OutputFactor,
+	targetFactor TargetFactor,
+	tmplStr string,
+	replaceData map[string]any,
+) (ValidVirtualUsers, error) {
+	var valid ValidVirtualUsers
+	var err error
+	if r.Type == nil {
+		return ValidVirtualUsers{}, fmt.Errorf("type is required")
+	}
+	switch VirtualUsersType(*r.Type) {
+	case VirtualUsersTypeHTTP:
+		valid.Type = VirtualUsersType(*r.Type)
+	default:
+		return ValidVirtualUsers{}, fmt.Errorf("invalid type value: %s", *r.Type)
+	}
+	if valid.Output, err = r.Output.Validate(ctx, outFactor); err != nil {
+		return ValidVirtualUsers{}, fmt.Errorf("failed to validate output: %w", err)
+	}
+	if valid.Auth, err = r.Auth.Validate(ctx, authFactor); err != nil {
+		return ValidVirtualUsers{}, fmt.Errorf("failed to validate auth: %w", err)
+	}
+	if r.VUs == nil {
+		return ValidVirtualUsers{}, fmt.Errorf("vus is required")
+	}
+	if *r.VUs <= 0 {
+		return ValidVirtualUsers{}, fmt.Errorf("vus must be greater than 0")
+	}
+	valid.VUs = *r.VUs
+	if r.Iterations == nil && r.Duration == nil {
+		return ValidVirtualUsers{}, fmt.Errorf("either iterations or duration is required")
+	}
+	if r.Iterations != nil {
+		if *r.Iterations <= 0 {
+			return ValidVirtualUsers{}, fmt.Errorf("iterations must be greater than 0")
+		}
+		valid.Iterations.Enabled = true
+		valid.Iterations.Count = *r.Iterations
+	}
+	if r.Duration != nil {
+		if valid.Duration.Time, err = time.ParseDuration(*r.Duration); err != nil {
+			return ValidVirtualUsers{}, fmt.Errorf("failed to parse duration: %w", err)
+		}
+		valid.Duration.Enabled = true
+	}
+	if valid.ThinkTime, err = r.ThinkTime.Validate(); err != nil {
+		return ValidVirtualUsers{}, fmt.Errorf("failed to validate think_time: %w", err)
+	}
+	if valid.SuccessBreak, err = matcher.NewTerminateTypeAndParamsSliceFromStringSlice(r.SuccessBreak); err != nil {
+		return ValidVirtualUsers{}, fmt.Errorf("failed to parse success break: %w", err)
+	}
+	if len(r.Requests) == 0 {
+		return ValidVirtualUsers{}, fmt.Errorf("requests is required")
+	}
+	for i, req := range r.Requests {
+		validRequest, err := req.Validate(ctx, log, targetFactor)
+		if err != nil {
+			return ValidVirtualUsers{}, fmt.Errorf("failed to validate request[%d]: %w", i, err)
+		}
+		valid.Requests = append(valid.Requests, validRequest)
+	}
+	valid.TmplStr = tmplStr
+	valid.ReplaceData = utils.NewSyncMapFromMap(replaceData)
+	return valid, nil
+}
+
+// VirtualUsersOutput represents the output configuration for the VirtualUsers runner
+type VirtualUsersOutput struct {
+	Enabled bool     `yaml:"enabled"`
+	IDs     []string `yaml:"ids"`
+}
+
+// Validate validates the VirtualUsersOutput
+func (o VirtualUsersOutput) Validate(ctx context.Context, outFactor OutputFactor) ([]output.Output, error) {
+	if !o.Enabled {
+		return nil, nil
+	}
+	var outputs []output.Output
+	for _, id := range o.IDs {
+		output, err := outFactor.Factorize(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to factorize output: %w", err)
+		}
+		outputs = append(outputs, output)
+	}
+	return outputs, nil
+}
+
+// VirtualUsersAuth represents the auth configuration for the VirtualUsers runner
+type VirtualUsersAuth struct {
+	Enabled bool    `yaml:"enabled"`
+	AuthID  *string `yaml:"auth_id"`
+}
+
+// Validate validates the VirtualUsersAuth
+func (a VirtualUsersAuth) Validate(ctx context.Context, authFactor AuthenticatorFactor) (auth.SetAuthor, error) {
+	if !a.Enabled {
+		return nil, nil
+	}
+	var authID string
+	var isDefault bool
+	if a.AuthID == nil {
+		isDefault = true
+	} else {
+		authID = *a.AuthID
+	}
+	auth, err := authFactor.Factorize(
+		ctx,
+		authID,
+		isDefault,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to factorize auth: %w", err)
+	}
+
+	return auth, nil
+}
+
+// VirtualUsersThinkTime represents the think time configuration for the VirtualUsers runner
+type VirtualUsersThinkTime struct {
+	Min *string `yaml:"min"`
+	Max *string `yaml:"max"`
+}
+
+// ValidVirtualUsersThinkTime represents the valid think time configuration for the VirtualUsers runner
+type ValidVirtualUsersThinkTime struct {
+	Enabled bool
+	Min     time.Duration
+	Max     time.Duration
+}
+
+// Validate validates the VirtualUsersThinkTime
+func (t VirtualUsersThinkTime) Validate() (ValidVirtualUsersThinkTime, error) {
+	var valid ValidVirtualUsersThinkTime
+	var err error
+	if t.Min == nil {
+		if t.Max != nil {
+			return ValidVirtualUsersThinkTime{}, fmt.Errorf("min is required when max is specified")
+		}
+		return ValidVirtualUsersThinkTime{}, nil
+	}
+	if valid.Min, err = time.ParseDuration(*t.Min); err != nil {
+		return ValidVirtualUsersThinkTime{}, fmt.Errorf("failed to parse min: %w", err)
+	}
+	valid.Max = valid.Min
+	if t.Max != nil {
+		if valid.Max, err = time.ParseDuration(*t.Max); err != nil {
+			return ValidVirtualUsersThinkTime{}, fmt.Errorf("failed to parse max: %w", err)
+		}
+		if valid.Max < valid.Min {
+			return ValidVirtualUsersThinkTime{}, fmt.Errorf("max must be greater than or equal to min")
+		}
+	}
+	valid.Enabled = true
+	return valid, nil
+}
+
+// Duration returns the think time, chosen uniformly between min and max
+func (t ValidVirtualUsersThinkTime) Duration() time.Duration {
+	if !t.Enabled {
+		return 0
+	}
+	if t.Max <= t.Min {
+		return t.Min
+	}
+	return t.Min + time.Duration(rand.Int64N(int64(t.Max-t.Min)+1))
+}
+
+// VirtualUsersRequestBreak represents the break configuration for the VirtualUsers runner
+type VirtualUsersRequestBreak struct {
+	SysError     bool                         `yaml:"sys_error"`
+	ParseError   bool                         `yaml:"parse_error"`
+	WriteError   bool                         `yaml:"write_error"`
+	GraphQLError bool                         `yaml:"graphql_error"`
+	StatusCode   matcher.StatusCodeConditions `yaml:"status_code"`
+	ResponseBody matcher.BodyConditions       `yaml:"response_body"`
+}
+
+// ValidVirtualUsersRequestBreak represents the valid break configuration for the VirtualUsers runner
+type ValidVirtualUsersRequestBreak struct {
+	SysError            bool
+	ParseError          bool
+	WriteError          bool
+	GraphQLError        bool
+	StatusCodeMatcher   matcher.StatusCodeConditionsMatcher
+	ResponseBodyMatcher matcher.BodyConditionsMatcher
+}
+
+// Validate validates the VirtualUsersRequestBreak
+func (b VirtualUsersRequestBreak) Validate(
+	ctx context.Context,
+	log logger.Logger,
+) (ValidVirtualUsersRequestBreak, error) {
+	var valid ValidVirtualUsersRequestBreak
+	var err error
+	valid.SysError = b.SysError
+	valid.ParseError = b.ParseError
+	valid.WriteError = b.WriteError
+	valid.GraphQLError = b.GraphQLError
+	if valid.StatusCodeMatcher, err = b.StatusCode.MatcherGenerate(ctx, log); err != nil {
+		return ValidVirtualUsersRequestBreak{}, fmt.Errorf("failed to generate status code matcher: %w", err)
+	}
+	if valid.ResponseBodyMatcher, err = b.ResponseBody.MatcherGenerate(ctx, log); err != nil {
+		return ValidVirtualUsersRequestBreak{}, fmt.Errorf("failed to generate response body matcher: %w", err)
+	}
+	return valid, nil
+}
+
+// VirtualUsersRequest represents the request configuration for the VirtualUsers runner
+type VirtualUsersRequest struct {
+	TargetID      *string                  `yaml:"target_id"`
+	Endpoint      *string                  `yaml:"endpoint"`
+	Method        *string                  `yaml:"method"`
+	QueryParam    map[string]any           `yaml:"query_param"`
+	PathVariables map[string]string        `yaml:"path_variables"`
+	Headers       map[string]any           `yaml:"headers"`
+	BodyType      *string                  `yaml:"body_type"`
+	Body          any                      `yaml:"body"`
+	ResponseType  *string                  `yaml:"response_type"`
+	Data          []ExecRequestData        `yaml:"data"`
+	MemoryData    []ExecRequestData        `yaml:"memory_data"`
+	ThinkTime     VirtualUsersThinkTime    `yaml:"think_time"`
+	Break         VirtualUsersRequestBreak `yaml:"break"`
+}
+
+// ValidVirtualUsersRequest represents the valid request configuration for the VirtualUsers runner
+type ValidVirtualUsersRequest struct {
+	// TargetID and Endpoint label the metrics of the request
+	TargetID      string
+	Endpoint      string
+	URL           string
+	Method        string
+	QueryParams   map[string]any
+	PathVariables map[string]string
+	Headers       map[string]any
+	BodyType      HTTPRequestBodyType
+	Body          any
+	ResponseType  string
+	Data          ValidExecRequestDataSlice
+	MemoryData    ValidExecRequestDataSlice
+	ThinkTime     ValidVirtualUsersThinkTime
+	Break         ValidVirtualUsersRequestBreak
+}
+
+// Validate validates the VirtualUsersRequest
+func (r VirtualUsersRequest) Validate(
+	ctx context.Context,
+	log logger.Logger,
+	targetFactor TargetFactor,
+) (ValidVirtualUsersRequest, error) {
+	var valid ValidVirtualUsersRequest
+	var err error
+	if r.TargetID == nil {
+		return ValidVirtualUsersRequest{}, fmt.Errorf("target_id is required")
+	}
+	if r.Endpoint == nil {
+		return ValidVirtualUsersRequest{}, fmt.Errorf("endpoint is required")
+	}
+	tg, err := targetFactor.Factorize(ctx, *r.TargetID)
+	if err != nil {
+		return ValidVirtualUsersRequest{}, fmt.Errorf("failed to factorize target: %w", err)
+	}
+	valid.TargetID = *r.TargetID
+	valid.Endpoint = *r.Endpoint
+	valid.URL = fmt.Sprintf("%s%s", tg.URL, *r.Endpoint)
+	if r.Method == nil {
+		return ValidVirtualUsersRequest{}, fmt.Errorf("method is required")
+	}
+	valid.Method = *r.Method
+	valid.QueryParams = r.QueryParam
+	valid.PathVariables = r.PathVariables
+	valid.Headers = r.Headers
+	valid.Body = r.Body
+	if r.BodyType == nil {
+		valid.BodyType = DefaultHTTPRequestBodyType
+	} else {
+		switch HTTPRequestBodyType(*r.BodyType) {
+		case HTTPRequestBodyTypeJSON, HTTPRequestBodyTypeForm, HTTPRequestBodyTypeMultipart:
+			valid.BodyType = HTTPRequestBodyType(*r.BodyType)
+		case HTTPRequestBodyTypeGraphQL:
+			if _, err := NewGraphQLBody(r.Body); err != nil {
+				return ValidVirtualUsersRequest{}, fmt.Errorf("failed to validate graphql body: %w", err)
+			}
+			valid.BodyType = HTTPRequestBodyTypeGraphQL
+		default:
+			return ValidVirtualUsersRequest{}, fmt.Errorf("invalid body_type value: %s", *r.BodyType)
+		}
+	}
+	if r.ResponseType == nil {
+		return ValidVirtualUsersRequest{}, fmt.Errorf("response_type is required")
+	}
+	valid.ResponseType = *r.ResponseType
+	for i, d := range r.Data {
+		validData, err := d.Validate()
+		if err != nil {
+			return ValidVirtualUsersRequest{}, fmt.Errorf("failed to validate data[%d]: %w", i, err)
+		}
+		valid.Data = append(valid.Data, validData)
+	}
+	for i, d := range r.MemoryData {
+		validData, err := d.Validate()
+		if err != nil {
+			return ValidVirtualUsersRequest{}, fmt.Errorf("failed to validate memory_data[%d]: %w", i, err)
+		}
+		valid.MemoryData = append(valid.MemoryData, validData)
+	}
+	if valid.ThinkTime, err = r.ThinkTime.Validate(); err != nil {
+		return ValidVirtualUsersRequest{}, fmt.Errorf("failed to validate think_time: %w", err)
+	}
+	if valid.Break, err = r.Break.Validate(ctx, log); err != nil {
+		return ValidVirtualUsersRequest{}, fmt.Errorf("failed to validate break: %w", err)
+	}
+	return valid, nil
+}
+
+// Run runs the VirtualUsers runner
+func (r ValidVirtualUsers) Run(
+	ctx context.Context,
+	log logger.Logger,
+	outputRoot string,
+	authFactor AuthenticatorFactor,
+	outFactor OutputFactor,
+	targetFactor TargetFactor,
+) error {
+	switch r.Type {
+	case VirtualUsersTypeHTTP:
+		return r.runHTTP(ctx, log, outputRoot, authFactor, outFactor, targetFactor)
+	}
+	return nil
+}
+
+// virtualUserWriter represents the writer shared by all virtual users for a request
+type virtualUserWriter struct {
+	mu      sync.Mutex
+	writers []output.HTTPDataWrite
+}
+
+// write writes the data to all writers
+func (w *virtualUserWriter) write(ctx context.Context, log logger.Logger, data []any) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, writer := range w.writers {
+		if err := writer(ctx, log, data); err != nil {
+			return fmt.Errorf("failed to write data: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r ValidVirtualUsers) runHTTP(
+	ctx context.Context,
+	log logger.Logger,
+	outputRoot string,
+	authFactor AuthenticatorFactor,
+	outFactor OutputFactor,
+	targetFactor TargetFactor,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if r.Duration.Enabled {
+		var timeoutCancel context.CancelFunc
+		ctx, timeoutCancel = context.WithTimeoutCause(ctx, r.Duration.Time, errVirtualUsersDurationElapsed)
+		defer timeoutCancel()
+	}
+	uniqueName := fmt.Sprintf("%s/%s", outputRoot, utils.GenerateUniqueID())
+
+	writers := make([]*virtualUserWriter, len(r.Requests))
+	stats := make([]*ExecStats, len(r.Requests))
+	for i, request := range r.Requests {
+		writers[i] = &virtualUserWriter{}
+		stats[i] = NewExecStats()
+		header := []string{
+			"Success",
+			"SendDatetime",
+			"ReceivedDatetime",
+			"Count",
+			"ResponseTime",
+			"StatusCode",
+			"VUID",
+		}
+		if request.BodyType == HTTPRequestBodyTypeGraphQL {
+			header = append(header, "Operation")
+		}
+		for _, o := range r.Output {
+			writer, closer, err := o.HTTPDataWriteFactory(
+				output.WithRequestIndex(ctx, i),
+				log,
+				true,
+				fmt.Sprintf("%s_%d", uniqueName, i),
+				append(header, request.Data.ExtractHeader()...),
+			)
+			if err != nil {
+				return fmt.Errorf("failed to create writer: %w", err)
+			}
+			defer func() {
+				if err := closer(); err != nil {
+					log.Error(ctx, "failed to close writer",
+						logger.Value("error", err), logger.Value("on", "ValidVirtualUsers.runHTTP"))
+				}
+			}()
+			writers[i].writers = append(writers[i].writers, writer)
+		}
+	}
+
+	transport := &utils.DelayedTransport{
+		Transport: &http.Transport{
+			MaxIdleConns:        200,
+			MaxIdleConnsPerHost: 180,
+			IdleConnTimeout:     5 * time.Minute,
+		},
+	}
+
+	var wg sync.WaitGroup
+	var atomicErr atomic.Pointer[syncError]
+	for id := 0; id < r.VUs; id++ {
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			return fmt.Errorf("failed to create cookie jar: %w", err)
+		}
+		vu := &virtualUser{
+			ID: id,
+			client: &http.Client{
+				Timeout:   10 * time.Minute,
+				Transport: transport,
+				Jar:       jar,
+			},
+			values: &sync.Map{},
+		}
+		wg.Add(1)
+		go func(vu *virtualUser) {
+			defer wg.Done()
+			defer liveMetricsFrom(ctx).ThreadStarted()()
+			defer metrics.From(ctx).ThreadStarted()()
+			log.Info(ctx, "Virtual User Start",
+				logger.Value("VUID", vu.ID))
+			termType := r.runVirtualUser(ctx, log, vu, writers, stats, authFactor, outFactor, targetFactor)
+			success := r.SuccessBreak.Match(termType.termType, termType.param)
+			if termType.termType != matcher.TerminateTypeByContext {
+				metrics.From(ctx).BreakEvent(string(RunnerKindVirtualUsers), termType.termType.String(), success)
+			}
+			if success {
+				log.Info(ctx, "Virtual User End For Success Break",
+					logger.Value("VUID", vu.ID), logger.Value("termType", termType.termType))
+				return
+			}
+			if termType.termType == matcher.TerminateTypeByContext {
+				log.Debug(ctx, "Virtual User End For Context", logger.Value("VUID", vu.ID))
+				return
+			}
+			err := fmt.Errorf("virtual user[%d] end for fail break: %v(%v)", vu.ID, termType.termType, termType.param)
+			atomicErr.Store(&syncError{Err: err})
+			log.Error(ctx, "failed to execute",
+				logger.Value("error", err), logger.Value("VUID", vu.ID))
+			cancel()
+		}(vu)
+	}
+	wg.Wait()
+
+	summary, totalStats := r.summarize(outputRoot, stats)
+	summary.Print(os.Stdout)
+	if err := writeSummary(ctx, log, r.Output, outputRoot, summary); err != nil {
+		log.Error(ctx, "failed to write summary",
+			logger.Value("error", err), logger.Value("on", "ValidVirtualUsers.runHTTP"))
+	}
+	collectStats(ctx, totalStats)
+
+	if syncErr := atomicErr.Load(); syncErr != nil {
+		log.Error(ctx, "failed to find error",
+			logger.Value("error", syncErr.Err), logger.Value("on", "ValidVirtualUsers.runHTTP"))
+		return syncErr.Err
+	}
+
+	return nil
+}
+
+// summarize builds the summary of the stats of the requests and returns it with the merged stats
+func (r ValidVirtualUsers) summarize(outputRoot string, stats []*ExecStats) (RunSummary, *ExecStats) {
+	summary := RunSummary{
+		Kind:       RunnerKindVirtualUsers,
+		OutputRoot: outputRoot,
+	}
+	total := NewExecStats()
+	for i, s := range stats {
+		total.Merge(s)
+		summary.Requests = append(summary.Requests, s.Summary(fmt.Sprintf("request[%d]", i)))
+	}
+	summary.Total = total.Summary("total")
+	return summary, total
+}
+
+// virtualUser represents a virtual user with its own http client and memory
+type virtualUser struct {
+	ID     int
+	client *http.Client
+	values *sync.Map
+}
+
+// contextTermType returns the terminate type for the context termination
+func contextTermType(ctx context.Context) TermChanType {
+	if errors.Is(context.Cause(ctx), errVirtualUsersDurationElapsed) {
+		return NewTermChanType(matcher.TerminateTypeByTimeout, "")
+	}
+	return NewTermChanType(matcher.TerminateTypeByContext, "")
+}
+
+// runVirtualUser runs the requests in order until a break condition is met
+func (r ValidVirtualUsers) runVirtualUser(
+	ctx context.Context,
+	log logger.Logger,
+	vu *virtualUser,
+	writers []*virtualUserWriter,
+	stats []*ExecStats,
+	authFactor AuthenticatorFactor,
+	outFactor OutputFactor,
+	targetFactor TargetFactor,
+) TermChanType {
+	live := liveMetricsFrom(ctx)
+	m := metrics.From(ctx)
+	for iteration := 0; !r.Iterations.Enabled || iteration < r.Iterations.Count; iteration++ {
+		for i, request := range r.Requests {
+			if ctx.Err() != nil {
+				return contextTermType(ctx)
+			}
+			req := HTTPRequest{
+				Method:        request.Method,
+				URL:           request.URL,
+				Headers:       request.Headers,
+				QueryParams:   request.QueryParams,
+				PathVariables: request.PathVariables,
+				BodyType:      request.BodyType,
+				Body:          request.Body,
+				AttachRequestInfo: func(ctx context.Context, req *http.Request) error {
+					if r.Auth == nil {
+						return nil
+					}
+					r.Auth.SetOnRequest(ctx, req)
+					return nil
+				},
+				IsVirtualUser: true,
+				VUID:          vu.ID,
+				VUValues:      vu.values,
+				TmplStr:       r.TmplStr,
+				ReplaceData:   r.ReplaceData,
+				OutputFactor:  outFactor,
+				AuthFactor:    authFactor,
+				TargetFactor:  targetFactor,
+				ReqIndex:      i,
+			}
+			exe := httpexec.RequestContent[HTTPRequest]{
+				Req:          req,
+				ResponseType: httpexec.ResponseType(request.ResponseType),
+				Count:        iteration,
+				Client:       vu.client,
+			}
+			done := m.RequestStarted(request.TargetID, request.Endpoint)
+			resp, err := exe.RequestExecute(ctx, log)
+			done()
+			if ctx.Err() != nil {
+				return contextTermType(ctx)
+			}
+			resp.Count = iteration
+			if err != nil {
+				stats[i].RecordError(matcher.TerminateTypeByCreateRequestError)
+				log.Warn(ctx, "Term Condition: Request Creation Error",
+					logger.Value("VUID", vu.ID), logger.Value("error", err), logger.Value("on", "runVirtualUser"))
+				return NewTermChanType(matcher.TerminateTypeByCreateRequestError, "")
+			}
+			live.Record(resp)
+			stats[i].Record(resp)
+			m.Record(request.TargetID, request.Endpoint, resp)
+			if resp.HasSystemErr && request.Break.SysError {
+				log.Warn(ctx, "Term Condition: System Error",
+					logger.Value("VUID", vu.ID), logger.Value("on", "runVirtualUser"))
+				return NewTermChanType(matcher.TerminateTypeBySystemError, "")
+			}
+			if resp.ParseResHasErr && request.Break.ParseError {
+				log.Warn(ctx, "Term Condition: Response Parse Error",
+					logger.Value("VUID", vu.ID), logger.Value("on", "runVirtualUser"))
+				return NewTermChanType(matcher.TerminateTypeByParseResponseError, "")
+			}
+			if resp.HasGraphQLErr && request.Break.GraphQLError {
+				log.Warn(ctx, "Term Condition: GraphQL Error",
+					logger.Value("VUID", vu.ID), logger.Value("on", "runVirtualUser"))
+				return NewTermChanType(matcher.TerminateTypeByGraphQLError, resp.Operation)
+			}
+
+			data := make([]any, len(request.Data))
+			if resp.Success {
+				for j, d := range request.Data {
+					result, err := d.Extractor.Extract(resp.Res)
+					if err != nil {
+						log.Warn(ctx, "Term Condition: Response Body Data Extractor Error",
+							logger.Value("VUID", vu.ID), logger.Value("error", err), logger.Value("on", "runVirtualUser"))
+						return NewTermChanType(matcher.TerminateTypeByResponseBodyDataExtractorError, d.Key)
+					}
+					data[j] = result
+				}
+				for _, d := range request.MemoryData {
+					result, err := d.Extractor.Extract(resp.Res)
+					if err != nil {
+						log.Warn(ctx, "Term Condition: Response Body Data Extractor Error",
+							logger.Value("VUID", vu.ID), logger.Value("error", err), logger.Value("on", "runVirtualUser"))
+						return NewTermChanType(matcher.TerminateTypeByResponseBodyDataExtractorError, d.Key)
+					}
+					vu.values.Store(d.Key, result)
+				}
+			}
+			writeData := newWriteData(resp, responseRecord{body: resp.Res})
+			row := append(writeData.ToSlice(), vu.ID)
+			if request.BodyType == HTTPRequestBodyTypeGraphQL {
+				row = append(row, writeData.Operation)
+			}
+			if err := writers[i].write(ctx, log, append(row, data...)); err != nil {
+				log.Error(ctx, "failed to write data",
+					logger.Value("VUID", vu.ID), logger.Value("error", err), logger.Value("on", "runVirtualUser"))
+				if request.Break.WriteError {
+					log.Warn(ctx, "Term Condition: Write Error",
+						logger.Value("VUID", vu.ID), logger.Value("on", "runVirtualUser"))
+					return NewTermChanType(matcher.TerminateTypeByWriteError, "")
+				}
+			}
+
+			if matchID, isMatch := request.Break.StatusCodeMatcher(resp.StatusCode); isMatch {
+				log.Info(ctx, "Term Condition: Status Code",
+					logger.Value("VUID", vu.ID), logger.Value("on", "runVirtualUser"))
+				return NewTermChanType(matcher.TerminateTypeByStatusCode, matchID)
+			}
+			matchID, isMatch, err := request.Break.ResponseBodyMatcher(resp.Res)
+			if err != nil {
+				log.Info(ctx, "Term Condition: Response Body Break Filter Error",
+					logger.Value("VUID", vu.ID), logger.Value("error", err), logger.Value("on", "runVirtualUser"))
+				return NewTermChanType(matcher.TerminateTypeByResponseBodyBreakFilterError, matchID)
+			}
+			if isMatch {
+				log.Info(ctx, "Term Condition: Response Body",
+					logger.Value("VUID", vu.ID), logger.Value("on", "runVirtualUser"))
+				return NewTermChanType(matcher.TerminateTypeByResponseBody, matchID)
+			}
+
+			thinkTime := r.ThinkTime.Duration()
+			if request.ThinkTime.Enabled {
+				thinkTime = request.ThinkTime.Duration()
+			}
+			if thinkTime > 0 {
+				select {
+				case <-ctx.Done():
+					return contextTermType(ctx)
+				case <-time.After(thinkTime):
+				}
+			}
+		}
+	}
+	log.Info(ctx, "Term Condition: Count Limit",
+		logger.Value("VUID", vu.ID), logger.Value("on", "runVirtualUser"))
+	return NewTermChanType(matcher.TerminateTypeByCount, "")
+}
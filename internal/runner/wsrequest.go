@@ -0,0 +1,127 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ablankz/bloader/internal/auth"
+	"github.com/ablankz/bloader/internal/executor/wsexec"
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+// WebSocketRequest represents the message sent on the WebSocket connection
+type WebSocketRequest struct {
+	MessageType  WebSocketMessageType
+	Body         any
+	TmplStr      string
+	ReplaceData  *sync.Map
+	OutputFactor OutputFactor
+	AuthFactor   AuthenticatorFactor
+	TargetFactor TargetFactor
+	ReqIndex     int
+}
+
+// CreateMessage creates the message of the count sent on the connection.
+// The loader is rendered again with .Dynamic.ConnectionID set to the index of the connection.
+func (r WebSocketRequest) CreateMessage(
+	ctx context.Context,
+	log logger.Logger,
+	count, connID int,
+) (*wsexec.Message, error) {
+	replaceData, dynamicData := newDynamicReplaceData(r.ReplaceData, count, 0)
+	dynamicData["ConnectionID"] = connID
+	buffer, err := executeTmpl(r.TmplStr, replaceData)
+	if err != nil {
+		return nil, err
+	}
+	var massExec MassExec
+	if err := yaml.Unmarshal(buffer, &massExec); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal json: %w", err)
+	}
+	validMassExec, err := massExec.Validate(
+		ctx,
+		log,
+		r.AuthFactor,
+		r.OutputFactor,
+		r.TargetFactor,
+		r.TmplStr,
+		replaceData,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate mass exec: %w", err)
+	}
+	request := validMassExec.Requests[r.ReqIndex]
+	r.MessageType = request.MessageType
+	r.Body = request.Body
+
+	switch r.MessageType {
+	case WebSocketMessageTypeText:
+		var data string
+		if r.Body != nil {
+			data = fmt.Sprint(r.Body)
+		}
+		return &wsexec.Message{Type: websocket.TextMessage, Data: []byte(data)}, nil
+	default:
+		data, err := json.Marshal(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal message: %w", err)
+		}
+		return &wsexec.Message{Type: websocket.TextMessage, Data: data}, nil
+	}
+}
+
+// webSocketURL builds the URL of the connection, the http(s) scheme is replaced with ws(s)
+func webSocketURL(request ValidMassExecRequest) (string, error) {
+	fullURL, err := url.Parse(solvePathVariables(request.URL, request.PathVariables))
+	if err != nil {
+		return "", fmt.Errorf("failed to construct URL: %w", err)
+	}
+	switch fullURL.Scheme {
+	case "http":
+		fullURL.Scheme = "ws"
+	case "https":
+		fullURL.Scheme = "wss"
+	}
+	queryParams := fullURL.Query()
+	for key, value := range request.QueryParams {
+		if arr, ok := value.([]any); ok {
+			for _, v := range arr {
+				queryParams.Add(key, fmt.Sprint(v))
+			}
+			continue
+		}
+		queryParams.Set(key, fmt.Sprint(value))
+	}
+	fullURL.RawQuery = queryParams.Encode()
+	return fullURL.String(), nil
+}
+
+// webSocketHeader builds the header of the opening handshake
+func webSocketHeader(ctx context.Context, request ValidMassExecRequest, author auth.SetAuthor) (http.Header, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create header carrier: %w", err)
+	}
+	if author != nil {
+		author.SetOnRequest(ctx, req)
+	}
+	for key, value := range request.Headers {
+		if arr, ok := value.([]any); ok {
+			for _, v := range arr {
+				req.Header.Add(key, fmt.Sprint(v))
+			}
+			continue
+		}
+		req.Header.Set(key, fmt.Sprint(value))
+	}
+	return req.Header, nil
+}
+
+var _ wsexec.ExecReq = (*WebSocketRequest)(nil)
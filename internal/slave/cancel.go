@@ -0,0 +1,117 @@
+package slave
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
+	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/runner"
+)
+
+// commandRun represents the running command, which can be canceled by the master node
+type commandRun struct {
+	mu         *sync.Mutex
+	cancel     context.CancelFunc
+	cancelOnce *sync.Once
+	reason     string
+	force      chan struct{}
+	forceOnce  *sync.Once
+	forced     bool
+	done       chan struct{}
+	termReason pb.CommandTermReason
+}
+
+// newCommandRun creates a new commandRun canceled by the cancel function
+func newCommandRun(cancel context.CancelFunc) *commandRun {
+	return &commandRun{
+		mu:         &sync.Mutex{},
+		cancel:     cancel,
+		cancelOnce: &sync.Once{},
+		force:      make(chan struct{}),
+		forceOnce:  &sync.Once{},
+		done:       make(chan struct{}),
+	}
+}
+
+// requestCancel cancels the context of the command, the command shuts down gracefully
+func (r *commandRun) requestCancel(reason string) {
+	r.cancelOnce.Do(func() {
+		r.mu.Lock()
+		r.reason = reason
+		r.mu.Unlock()
+		r.cancel()
+	})
+}
+
+// forceStop stops waiting for the command, the command is abandoned
+func (r *commandRun) forceStop() {
+	r.forceOnce.Do(func() {
+		r.mu.Lock()
+		r.forced = true
+		r.mu.Unlock()
+		close(r.force)
+	})
+}
+
+// finish decides the term reason of the command by the error of the execution, and returns it with the message
+func (r *commandRun) finish(err error) (pb.CommandTermReason, string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	defer close(r.done)
+
+	var message string
+	switch {
+	case r.forced:
+		r.termReason = pb.CommandTermReason_COMMAND_TERM_REASON_FORCED
+		message = r.reason
+	case r.reason != "":
+		r.termReason = pb.CommandTermReason_COMMAND_TERM_REASON_CANCELED
+		message = r.reason
+	case err != nil:
+		r.termReason = pb.CommandTermReason_COMMAND_TERM_REASON_FAILED
+		message = err.Error()
+	default:
+		r.termReason = pb.CommandTermReason_COMMAND_TERM_REASON_COMPLETED
+	}
+	return r.termReason, message
+}
+
+// CancelCommand handles the cancel request from the master node.
+// The command is canceled gracefully, and abandoned if it does not stop within the grace period.
+// The response is returned after the command stops.
+func (s *Server) CancelCommand(ctx context.Context, req *pb.CancelCommandRequest) (*pb.CancelCommandResponse, error) {
+	s.mu.RLock()
+	run, ok := s.cmdRunMap[req.CommandId]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, ErrCommandNotRunning
+	}
+
+	gracePeriod := time.Duration(req.GracePeriodMs) * time.Millisecond
+	if gracePeriod <= 0 {
+		gracePeriod = runner.DefaultCancelGracePeriod
+	}
+	s.log.Info(ctx, "canceling the command",
+		logger.Value("ConnectionID", req.ConnectionId), logger.Value("CommandID", req.CommandId),
+		logger.Value("Reason", req.Reason), logger.Value("GracePeriod", gracePeriod))
+	run.requestCancel(req.Reason)
+
+	timer := time.NewTimer(gracePeriod)
+	defer timer.Stop()
+	select {
+	case <-run.done:
+	case <-timer.C:
+		s.log.Warn(ctx, "command did not stop within the grace period, forcing it to stop",
+			logger.Value("ConnectionID", req.ConnectionId), logger.Value("CommandID", req.CommandId))
+		run.forceStop()
+		<-run.done
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return &pb.CancelCommandResponse{
+		Reason: run.termReason,
+	}, nil
+}
@@ -17,4 +17,6 @@ var (
 	ErrFailedToSendLoaderResourceRequest = fmt.Errorf("failed to send loader resource request")
 	// ErrCommandNotFound represents an error when the command is not found
 	ErrCommandNotFound = fmt.Errorf("command not found")
+	// ErrCommandNotRunning represents an error when the command to cancel is not running
+	ErrCommandNotRunning = fmt.Errorf("command is not running")
 )
@@ -0,0 +1,44 @@
+package slave
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
+	"github.com/ablankz/bloader/internal/container"
+	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/metrics"
+	"github.com/ablankz/bloader/internal/runner"
+)
+
+// NewInprocServe returns the function serving the in-process slave for the "inproc://" URI of SlaveConnect.
+// The slave shares the container of the master, and no certificate, token or encryption is applied
+// on the in-memory connection.
+func NewInprocServe(ctr *container.Container) runner.InprocServe {
+	return func(ctx context.Context, name string, lis net.Listener) error {
+		grpcServer := grpc.NewServer()
+		slCtr := runner.NewConnectionContainer(nil, nil)
+		pb.RegisterBloaderSlaveServiceServer(grpcServer, NewServer(ctr, slCtr, metrics.From(ctx)))
+
+		go func() {
+			<-ctx.Done()
+			ctr.Logger.Info(ctr.Ctx, "Shutting down the in-process worker node",
+				logger.Value("name", name))
+			grpcServer.Stop()
+			if err := slCtr.AllDisconnect(ctr.Ctx); err != nil {
+				ctr.Logger.Error(ctr.Ctx, "failed to disconnect",
+					logger.Value("error", err), logger.Value("name", name))
+			}
+		}()
+		go func() {
+			if err := grpcServer.Serve(lis); err != nil {
+				ctr.Logger.Error(ctr.Ctx, "failed to serve the in-process worker node",
+					logger.Value("error", err), logger.Value("name", name))
+			}
+		}()
+
+		return nil
+	}
+}
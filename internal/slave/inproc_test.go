@@ -0,0 +1,226 @@
+package slave_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/ablankz/bloader/internal/config"
+	"github.com/ablankz/bloader/internal/container"
+	"github.com/ablankz/bloader/internal/output"
+	"github.com/ablankz/bloader/internal/runner"
+	"github.com/ablankz/bloader/internal/slave"
+)
+
+const inprocConfig = `
+type: master
+env: test
+loader:
+  base_path: %q
+targets:
+  - id: api
+    type: http
+    values:
+      - env: test
+        url: %q
+outputs: []
+store:
+  file:
+    - env: test
+      path: %q
+  buckets: ["bucket"]
+auth:
+  - id: auth
+    default: true
+    type: apiKey
+    api_key:
+      header_name: X-API-KEY
+      key: key
+server:
+  port: 9800
+logging:
+  output:
+    - type: stdout
+      format: text
+      level: error
+clock:
+  format: "2006-01-02T15:04:05Z"
+language:
+  default: en
+override: []
+encrypts: []
+`
+
+const inprocConnectLoader = `
+kind: SlaveConnect
+slaves:
+  - id: slave1
+    uri: inproc://slave1
+  - id: slave2
+    uri: inproc://slave2
+`
+
+const inprocFlowLoader = `
+kind: Flow
+thresholds:
+  - %s
+step:
+  concurrency: 0
+  flows:
+    - id: request
+      type: slaveCmd
+      file: mass.yaml
+      executors:
+        - slave_id: slave1
+        - slave_id: slave2
+`
+
+const inprocMassLoader = `
+kind: MassExecute
+type: http
+output:
+  enabled: false
+requests:
+  - target_id: api
+    endpoint: /
+    method: GET
+    response_type: json
+    interval: 10ms
+    break:
+      count: 3
+    success_break:
+      - count
+`
+
+// newInprocContainer creates the container of the master running the loaders of the directory against the url
+func newInprocContainer(t *testing.T, dir, url string) *container.Container {
+	t.Helper()
+	v := viper.New()
+	v.SetConfigType("yaml")
+	cfgYAML := fmt.Sprintf(inprocConfig, dir, url, filepath.Join(dir, "store.db"))
+	if err := v.ReadConfig(bytes.NewBufferString(cfgYAML)); err != nil {
+		t.Fatalf("failed to read config: %v", err)
+	}
+	var cfg config.Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		t.Fatalf("failed to unmarshal config: %v", err)
+	}
+	validCfg, err := cfg.Validate()
+	if err != nil {
+		t.Fatalf("failed to validate config: %v", err)
+	}
+	ctr := container.NewContainer()
+	if err := ctr.Init(validCfg); err != nil {
+		t.Fatalf("failed to init container: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := ctr.Close(); err != nil {
+			t.Errorf("failed to close container: %v", err)
+		}
+	})
+	return ctr
+}
+
+// TestInprocFlow tests a slaveCmd flow run on the in-process slaves,
+// with the thresholds of the flow evaluated on the requests of the slaves.
+func TestInprocFlow(t *testing.T) {
+	tests := []struct {
+		name       string
+		threshold  string
+		violations int
+	}{
+		{name: "Satisfied", threshold: "total == 6", violations: 0},
+		{name: "Violated", threshold: "total == 3", violations: 1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(tt *testing.T) {
+			var hits atomic.Int64
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				hits.Add(1)
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"ok":true}`))
+			}))
+			defer srv.Close()
+
+			dir := tt.TempDir()
+			loaders := map[string]string{
+				"connect.yaml": inprocConnectLoader,
+				"flow.yaml":    fmt.Sprintf(inprocFlowLoader, tc.threshold),
+				"mass.yaml":    inprocMassLoader,
+			}
+			for name, content := range loaders {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+					tt.Fatalf("failed to write %s: %v", name, err)
+				}
+			}
+			ctr := newInprocContainer(tt, dir, srv.URL)
+
+			ctx, recorder := runner.WithThresholdRecorder(ctr.Ctx)
+			slCtr := runner.NewConnectionContainer(nil, slave.NewInprocServe(ctr))
+			defer slCtr.AllDisconnect(ctx)
+			executor := runner.BaseExecutor{
+				Logger:                ctr.Logger,
+				Env:                   ctr.Config.Env,
+				EncryptCtr:            ctr.EncypterContainer,
+				SlaveConnectContainer: slCtr,
+				TmplFactor:            runner.NewLocalTmplFactor(ctr.Config.Loader.BasePath),
+				Store:                 runner.NewLocalStore(ctr.EncypterContainer, ctr.Store),
+				AuthFactor:            runner.NewLocalAuthenticatorFactor(ctr.AuthenticatorContainer),
+				OutputFactor:          runner.NewLocalOutputFactor(output.NewContainer(ctr.Config.Env, ctr.Config.Outputs)),
+				TargetFactor:          runner.NewLocalTargetFactor(ctr.TargetContainer),
+			}
+			eventCaster := runner.NewDefaultEventCaster()
+			outputRoot := time.Now().Format("20060102_150405")
+
+			// SlaveConnect serves the requests of the slaves until the connection ends
+			connCtx, connCancel := context.WithCancel(ctx)
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := executor.Execute(connCtx, "connect.yaml", &sync.Map{}, &sync.Map{},
+					outputRoot, 0, 0, map[string]any{}, eventCaster); err != nil {
+					tt.Errorf("failed to connect: %v", err)
+				}
+			}()
+			defer func() {
+				connCancel()
+				wg.Wait()
+			}()
+			deadline := time.Now().Add(10 * time.Second)
+			for {
+				_, ok1 := slCtr.Find("slave1")
+				_, ok2 := slCtr.Find("slave2")
+				if ok1 && ok2 {
+					break
+				}
+				if time.Now().After(deadline) {
+					tt.Fatal("timed out waiting for the slaves")
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			if err := executor.Execute(ctx, "flow.yaml", &sync.Map{}, &sync.Map{},
+				outputRoot, 0, 0, map[string]any{}, eventCaster); err != nil {
+				tt.Fatalf("failed to execute flow: %v", err)
+			}
+
+			if got := hits.Load(); got != 6 {
+				tt.Errorf("expected 6 requests, got %d", got)
+			}
+			if got := recorder.Violations(); len(got) != tc.violations {
+				tt.Errorf("expected %d violations, got %v", tc.violations, got)
+			}
+		})
+	}
+}
@@ -2,15 +2,63 @@ package slave
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
+	"strings"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/ablankz/bloader/internal/encrypt"
 )
 
+// verifyToken verifies the bearer token in the metadata of the request
+func verifyToken(ctx context.Context, token string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var got string
+	if values := md.Get("authorization"); len(values) > 0 {
+		got = strings.TrimPrefix(values[0], "Bearer ")
+	}
+	if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid token")
+	}
+	return nil
+}
+
+// UnaryServerTokenInterceptor is a server-side interceptor that rejects the request without the bearer token.
+func UnaryServerTokenInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		_ *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if err := verifyToken(ctx, token); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerTokenInterceptor is a server-side interceptor that rejects the stream without the bearer token.
+func StreamServerTokenInterceptor(token string) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		_ *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if err := verifyToken(ss.Context(), token); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
 // UnaryServerEncryptInterceptor is a server-side interceptor that encrypts the request and decrypts the response.
 func UnaryServerEncryptInterceptor(encrypter encrypt.Encrypter) grpc.UnaryServerInterceptor {
 	return func(
@@ -3,8 +3,7 @@ package slave
 import (
 	"context"
 
-	pb "buf.build/gen/go/cresplanex/bloader/protocolbuffers/go/cresplanex/bloader/v1"
-
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
 	"github.com/ablankz/bloader/internal/logger"
 	"github.com/ablankz/bloader/internal/output"
 	"github.com/ablankz/bloader/internal/runner"
@@ -16,13 +15,25 @@ type Output struct {
 	OutputID string
 	// outputChan represents the output channel
 	outputChan chan<- *pb.CallExecResponse
+	// done is closed when the stream to the master is closed.
+	// The context of the command is not used, so that the output is delivered while the command shuts down gracefully.
+	done <-chan struct{}
+	// typed represents whether the master reads the http data with the types of the values
+	typed bool
 }
 
 // NewSlaveOutput creates a new SlaveOutput
-func NewSlaveOutput(outputID string, outputChan chan<- *pb.CallExecResponse) Output {
+func NewSlaveOutput(
+	outputID string,
+	outputChan chan<- *pb.CallExecResponse,
+	done <-chan struct{},
+	typed bool,
+) Output {
 	return Output{
 		OutputID:   outputID,
 		outputChan: outputChan,
+		done:       done,
+		typed:      typed,
 	}
 }
 
@@ -35,7 +46,7 @@ func (o Output) HTTPDataWriteFactory(
 	header []string,
 ) (output.HTTPDataWrite, output.Close, error) {
 	select {
-	case <-ctx.Done():
+	case <-o.done:
 		return nil, nil, nil
 	case o.outputChan <- &pb.CallExecResponse{
 		OutputId:   o.OutputID,
@@ -43,31 +54,39 @@ func (o Output) HTTPDataWriteFactory(
 		OutputRoot: uniqueName,
 		Output: &pb.CallExecResponse_OutputHttp{
 			OutputHttp: &pb.CallExecOutputHTTP{
-				Data: header,
+				Data:   header,
+				Source: runner.OutputSourceToProto(output.SourceFrom(ctx)),
 			},
 		},
 	}: // do nothing
 	}
 
 	return func(
-			ctx context.Context,
+			_ context.Context,
 			_ logger.Logger,
-			data []string,
+			data []any,
 		) error {
 			if !enabled {
 				return nil
 			}
+			httpOut := &pb.CallExecOutputHTTP{}
+			if o.typed {
+				httpOut.Values = runner.OutputValuesToProto(data)
+			} else {
+				httpOut.Data = make([]string, len(data))
+				for i, v := range data {
+					httpOut.Data[i] = output.ValueString(v)
+				}
+			}
 			select {
-			case <-ctx.Done():
+			case <-o.done:
 				return nil
 			case o.outputChan <- &pb.CallExecResponse{
 				OutputId:   o.OutputID,
 				OutputType: pb.CallExecOutputType_CALL_EXEC_OUTPUT_TYPE_HTTP,
 				OutputRoot: uniqueName,
 				Output: &pb.CallExecResponse_OutputHttp{
-					OutputHttp: &pb.CallExecOutputHTTP{
-						Data: data,
-					},
+					OutputHttp: httpOut,
 				},
 			}: // do nothing
 			}
@@ -77,16 +96,61 @@ func (o Output) HTTPDataWriteFactory(
 		}, nil
 }
 
+// SummaryWrite sends the summary to the master
+func (o Output) SummaryWrite(
+	_ context.Context,
+	_ logger.Logger,
+	outputRoot string,
+	data []byte,
+) error {
+	select {
+	case <-o.done:
+	case o.outputChan <- &pb.CallExecResponse{
+		OutputId:   o.OutputID,
+		OutputType: pb.CallExecOutputType_CALL_EXEC_OUTPUT_TYPE_SUMMARY,
+		OutputRoot: outputRoot,
+		Output: &pb.CallExecResponse_OutputSummary{
+			OutputSummary: &pb.CallExecOutputSummary{
+				Data: data,
+			},
+		},
+	}: // do nothing
+	}
+	return nil
+}
+
+// MetricsWrite does nothing, the live metrics of the slave are streamed to the master by StreamMetrics
+func (o Output) MetricsWrite(
+	_ context.Context,
+	_ logger.Logger,
+	_ string,
+	_ []byte,
+) error {
+	return nil
+}
+
+// ResourcesWrite does nothing, the resource usage of the slave is streamed to the master by StreamResources
+func (o Output) ResourcesWrite(
+	_ context.Context,
+	_ logger.Logger,
+	_ string,
+	_ []byte,
+) error {
+	return nil
+}
+
 var _ output.Output = Output{}
 
 // OutputFactor represents the factory
 type OutputFactor struct {
 	outputChan chan<- *pb.CallExecResponse
+	done       <-chan struct{}
+	typed      bool
 }
 
 // Factorize returns the factorized output
 func (f *OutputFactor) Factorize(_ context.Context, outputID string) (output.Output, error) {
-	o := NewSlaveOutput(outputID, f.outputChan)
+	o := NewSlaveOutput(outputID, f.outputChan, f.done, f.typed)
 	return o, nil
 }
 
@@ -0,0 +1,117 @@
+package slave
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
+	"github.com/ablankz/bloader/internal/config"
+	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/utils"
+)
+
+// registerLeaseFactor is the number of refresh intervals the registration is kept without the refresh
+const registerLeaseFactor = 3
+
+// deregisterTimeout is the timeout of the deregistration on shutdown
+const deregisterTimeout = 3 * time.Second
+
+// registerToken is the bearer token sent to the master, gRPC refuses to send it without the transport security
+type registerToken string
+
+// GetRequestMetadata returns the authorization header of the token
+func (t registerToken) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity reports that the token must not be sent in plaintext
+func (t registerToken) RequireTransportSecurity() bool {
+	return true
+}
+
+var _ credentials.PerRPCCredentials = registerToken("")
+
+// runRegistration registers the slave on the master, and refreshes the registration on the interval.
+// The master accepts the registration only while it is running, so the failure is retried on the next refresh.
+// The slave is deregistered when the context is done.
+func runRegistration(ctx context.Context, log logger.Logger, conf config.ValidSlaveRegisterConfig) error {
+	tlsConfig, err := utils.ClientTLSConfig(
+		conf.Certificate.CACert,
+		conf.Certificate.ServerNameOverride,
+		conf.Certificate.ClientCert,
+		conf.Certificate.ClientKey,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to load certificate: %w", err)
+	}
+	conn, err := grpc.NewClient(
+		conf.MasterAddress,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithPerRPCCredentials(registerToken(conf.Token)),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to connect to master: %w", err)
+	}
+	cli := pb.NewBloaderMasterServiceClient(conn)
+	req := &pb.RegisterRequest{
+		SlaveId:      conf.ID,
+		Uri:          conf.URI,
+		Labels:       conf.Labels,
+		Capacity:     int32(conf.Capacity),
+		LeaseSeconds: int64((registerLeaseFactor * conf.Interval).Seconds()),
+	}
+	if req.LeaseSeconds < 1 {
+		req.LeaseSeconds = 1
+	}
+
+	go func() {
+		defer conn.Close()
+		ticker := time.NewTicker(conf.Interval)
+		defer ticker.Stop()
+
+		var registered bool
+		for {
+			regCtx, cancel := context.WithTimeout(ctx, conf.Interval)
+			_, err := cli.Register(regCtx, req)
+			cancel()
+			if ctx.Err() == nil {
+				switch {
+				case err != nil && registered:
+					log.Warn(ctx, "failed to refresh the registration on master",
+						logger.Value("error", err), logger.Value("master", conf.MasterAddress),
+						logger.Value("on", "runRegistration"))
+				case err != nil:
+					log.Debug(ctx, "master is not accepting the registration",
+						logger.Value("error", err), logger.Value("master", conf.MasterAddress),
+						logger.Value("on", "runRegistration"))
+				case !registered:
+					log.Info(ctx, "registered on master",
+						logger.Value("slaveID", conf.ID), logger.Value("master", conf.MasterAddress),
+						logger.Value("on", "runRegistration"))
+				}
+				registered = err == nil
+			}
+
+			select {
+			case <-ctx.Done():
+				if !registered {
+					return
+				}
+				deregCtx, cancel := context.WithTimeout(context.Background(), deregisterTimeout)
+				defer cancel()
+				if _, err := cli.Deregister(deregCtx, &pb.DeregisterRequest{SlaveId: conf.ID}); err != nil {
+					log.Warn(ctx, "failed to deregister from master",
+						logger.Value("error", err), logger.Value("on", "runRegistration"))
+				}
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
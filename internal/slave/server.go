@@ -7,15 +7,19 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"sync"
+	"time"
 
-	pb "buf.build/gen/go/cresplanex/bloader/protocolbuffers/go/cresplanex/bloader/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
 	"github.com/ablankz/bloader/internal/container"
 	"github.com/ablankz/bloader/internal/encrypt"
 	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/metrics"
+	"github.com/ablankz/bloader/internal/output"
 	"github.com/ablankz/bloader/internal/runner"
 	"github.com/ablankz/bloader/internal/slave/slcontainer"
 	"github.com/ablankz/bloader/internal/utils"
@@ -24,6 +28,10 @@ import (
 // commandTermData represents the command term data
 type commandTermData struct {
 	Success bool
+	Reason  pb.CommandTermReason
+	Message string
+	// Stats is the json of the stats of the requests of the command
+	Stats []byte
 }
 
 // Server represents the server for the worker node
@@ -32,39 +40,60 @@ type Server struct {
 	mu          *sync.RWMutex
 	encryptCtr  encrypt.Container
 	env         string
+	capacity    int
 	log         logger.Logger
 	slaveConCtr *runner.ConnectionContainer
 	slCtrMap    map[string]*slcontainer.SlaveContainer
 	reqConMap   *slcontainer.RequestConnectionMapper
 	cmdTermMap  map[string]chan commandTermData
+	cmdRunMap   map[string]*commandRun
+	metrics     *metrics.Metrics
 }
 
-// NewServer creates a new server for the worker node
-func NewServer(ctr *container.Container, slaveConCtr *runner.ConnectionContainer) *Server {
+// NewServer creates a new server for the worker node.
+// The executions are recorded to the metrics, nil when the metrics are not collected.
+func NewServer(ctr *container.Container, slaveConCtr *runner.ConnectionContainer, m *metrics.Metrics) *Server {
 	return &Server{
 		globalCtx:   ctr.Ctx,
 		mu:          &sync.RWMutex{},
 		encryptCtr:  ctr.EncypterContainer,
 		env:         ctr.Config.Env,
+		capacity:    ctr.Config.SlaveSetting.Capacity,
 		log:         ctr.Logger,
 		slaveConCtr: slaveConCtr,
 		slCtrMap:    make(map[string]*slcontainer.SlaveContainer),
 		reqConMap:   slcontainer.NewRequestConnectionMapper(),
 		cmdTermMap:  make(map[string]chan commandTermData),
+		cmdRunMap:   make(map[string]*commandRun),
+		metrics:     m,
 	}
 }
 
-// Connect handles the connection request from the master node
-func (s *Server) Connect(_ context.Context, req *pb.ConnectRequest) (*pb.ConnectResponse, error) {
+// Connect handles the connection request from the master node.
+// The capabilities of the slave are returned, the master decides whether the slave is compatible.
+func (s *Server) Connect(ctx context.Context, req *pb.ConnectRequest) (*pb.ConnectResponse, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	response := &pb.ConnectResponse{}
+	response := &pb.ConnectResponse{
+		Capabilities: runner.LocalCapabilities().ToProto(),
+		Capacity:     int32(s.capacity),
+	}
 	if req.Environment != s.env {
 		return nil, ErrInvalidEnvironment
 	}
+	slCtr := slcontainer.NewSlaveContainer()
+	if master, ok := runner.NewCapabilitiesFromProto(req.Capabilities); ok {
+		slCtr.TypedOutput = slices.Contains(master.Features, string(runner.FeatureTypedOutput))
+		if master.ProtocolVersion != runner.ProtocolVersion {
+			s.log.Warn(ctx, "protocol version of the master does not match",
+				logger.Value("MasterProtocolVersion", master.ProtocolVersion),
+				logger.Value("ProtocolVersion", runner.ProtocolVersion),
+				logger.Value("MasterBuildVersion", master.BuildVersion))
+		}
+	}
 	uid := utils.GenerateUniqueID()
-	s.slCtrMap[uid] = slcontainer.NewSlaveContainer()
+	s.slCtrMap[uid] = slCtr
 	response.ConnectionId = uid
 
 	return response, nil
@@ -216,14 +245,27 @@ func (s *Server) CallExec(req *pb.CallExecRequest, stream grpc.ServerStreamingSe
 	s.mu.Lock()
 	slCtr, ok := s.slCtrMap[req.ConnectionId]
 	if !ok {
+		s.mu.Unlock()
 		return ErrInvalidConnectionID
 	}
 	data, ok := slCtr.GetCommandMap(req.CommandId)
 	if !ok {
+		s.mu.Unlock()
 		return ErrCommandNotFound
 	}
+	execCtx, cancel := context.WithCancel(
+		metrics.With(runner.WithLiveMetrics(stream.Context(), slCtr.Metrics), s.metrics))
+	if req.FlowId != "" {
+		execCtx = output.WithFlowID(execCtx, req.FlowId)
+	}
+	stats := runner.NewExecStats()
+	execCtx = runner.WithStatsCollector(execCtx, stats)
+	defer cancel()
+	run := newCommandRun(cancel)
+	s.cmdRunMap[req.CommandId] = run
 	s.mu.Unlock()
 	var err error
+	var term commandTermData
 	defer func() {
 		s.mu.Lock()
 		defer s.mu.Unlock()
@@ -231,14 +273,8 @@ func (s *Server) CallExec(req *pb.CallExecRequest, stream grpc.ServerStreamingSe
 		if !ok {
 			return
 		}
-		var success bool
-		if err == nil {
-			success = true
-		}
 		select {
-		case cmdTerm <- commandTermData{
-			Success: success,
-		}:
+		case cmdTerm <- term:
 		case <-s.globalCtx.Done():
 			s.log.Debug(s.globalCtx, "global context done",
 				logger.Value("ConnectionID", req.ConnectionId), logger.Value("Error", s.globalCtx.Err()))
@@ -249,6 +285,18 @@ func (s *Server) CallExec(req *pb.CallExecRequest, stream grpc.ServerStreamingSe
 
 		close(cmdTerm)
 	}()
+	defer func() {
+		s.mu.Lock()
+		delete(s.cmdRunMap, req.CommandId)
+		s.mu.Unlock()
+		term.Reason, term.Message = run.finish(err)
+		term.Success = term.Reason == pb.CommandTermReason_COMMAND_TERM_REASON_COMPLETED
+		var statsErr error
+		if term.Stats, statsErr = json.Marshal(stats); statsErr != nil {
+			s.log.Error(s.globalCtx, "failed to marshal stats",
+				logger.Value("ConnectionID", req.ConnectionId), logger.Value("Error", statsErr))
+		}
+	}()
 	tmplFactor := &TmplFactor{
 		loader:                        slCtr.Loader,
 		connectionID:                  req.ConnectionId,
@@ -277,6 +325,8 @@ func (s *Server) CallExec(req *pb.CallExecRequest, stream grpc.ServerStreamingSe
 	outputChan := make(chan *pb.CallExecResponse)
 	outputFactor := &OutputFactor{
 		outputChan: outputChan,
+		done:       stream.Context().Done(),
+		typed:      slCtr.TypedOutput,
 	}
 
 	go func(st grpc.ServerStreamingServer[pb.CallExecResponse]) {
@@ -310,17 +360,31 @@ func (s *Server) CallExec(req *pb.CallExecRequest, stream grpc.ServerStreamingSe
 		Store:                 store,
 		OutputFactor:          outputFactor,
 	}
-	if err = exec.Execute(
-		stream.Context(),
-		data.LoaderID,
-		data.StrMap,
-		data.ThreadOnlyStrMap,
-		data.OutputRoot,
-		0,
-		0,
-		data.SlaveValues,
-		runner.NewDefaultEventCaster(),
-	); err != nil {
+	if req.StartAtUnixNano > 0 {
+		if err = s.waitStartAt(execCtx, req.ConnectionId, time.Unix(0, req.StartAtUnixNano)); err != nil {
+			return err
+		}
+	}
+	result := make(chan error, 1)
+	go func() {
+		result <- exec.Execute(
+			execCtx,
+			data.LoaderID,
+			data.StrMap,
+			data.ThreadOnlyStrMap,
+			data.OutputRoot,
+			0,
+			0,
+			data.SlaveValues,
+			runner.NewDefaultEventCaster(),
+		)
+	}()
+	select {
+	case err = <-result:
+	case <-run.force:
+		err = fmt.Errorf("command is forced to stop")
+	}
+	if err != nil {
 		return fmt.Errorf("failed to execute: %w", err)
 	}
 
@@ -521,6 +585,9 @@ func (s *Server) ReceiveLoadTermChannel(
 	case data := <-cmdTermChan:
 		return &pb.ReceiveLoadTermChannelResponse{
 			Success: data.Success,
+			Reason:  data.Reason,
+			Message: data.Message,
+			Stats:   data.Stats,
 		}, nil
 	case <-s.globalCtx.Done():
 		return nil, nil
@@ -528,3 +595,154 @@ func (s *Server) ReceiveLoadTermChannel(
 		return nil, nil
 	}
 }
+
+// Heartbeat handles the heartbeat request from the master node.
+// It fails when the connection is unknown, such as after the slave is restarted.
+func (s *Server) Heartbeat(_ context.Context, req *pb.HeartbeatRequest) (*pb.HeartbeatResponse, error) {
+	s.mu.RLock()
+	_, ok := s.slCtrMap[req.ConnectionId]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, ErrInvalidConnectionID
+	}
+
+	return &pb.HeartbeatResponse{}, nil
+}
+
+// SyncClock returns the wall clock of the slave node, used by the master node to measure the clock offset
+func (s *Server) SyncClock(_ context.Context, req *pb.SyncClockRequest) (*pb.SyncClockResponse, error) {
+	receiveTime := time.Now()
+	s.mu.RLock()
+	_, ok := s.slCtrMap[req.ConnectionId]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, ErrInvalidConnectionID
+	}
+
+	return &pb.SyncClockResponse{
+		ReceiveTimeUnixNano: receiveTime.UnixNano(),
+		SendTimeUnixNano:    time.Now().UnixNano(),
+	}, nil
+}
+
+// waitStartAt waits until the start time given by the master node.
+// The command starts immediately if the start time has already passed.
+func (s *Server) waitStartAt(ctx context.Context, connectionID string, startAt time.Time) error {
+	delay := time.Until(startAt)
+	if delay <= 0 {
+		s.log.Warn(ctx, "start time has already passed",
+			logger.Value("ConnectionID", connectionID), logger.Value("Late", -delay))
+		return nil
+	}
+	s.log.Info(ctx, "waiting for the start time",
+		logger.Value("ConnectionID", connectionID), logger.Value("StartAt", startAt), logger.Value("Delay", delay))
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-s.globalCtx.Done():
+		return fmt.Errorf("context done: %w", s.globalCtx.Err())
+	case <-ctx.Done():
+		return fmt.Errorf("context done: %w", ctx.Err())
+	}
+}
+
+// StreamMetrics sends the live metrics of the connection to the master node on the interval
+func (s *Server) StreamMetrics(
+	req *pb.StreamMetricsRequest,
+	stream grpc.ServerStreamingServer[pb.StreamMetricsResponse],
+) error {
+	s.mu.RLock()
+	slCtr, ok := s.slCtrMap[req.ConnectionId]
+	s.mu.RUnlock()
+	if !ok {
+		return ErrInvalidConnectionID
+	}
+	if req.IntervalMs <= 0 {
+		return fmt.Errorf("interval must be greater than 0")
+	}
+
+	ticker := time.NewTicker(time.Duration(req.IntervalMs) * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.globalCtx.Done():
+			return nil
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+		s.mu.RLock()
+		_, ok := s.slCtrMap[req.ConnectionId]
+		s.mu.RUnlock()
+		if !ok {
+			return nil
+		}
+
+		snapshot := slCtr.Metrics.Snapshot()
+		if err := stream.Send(&pb.StreamMetricsResponse{
+			TimestampMs:     snapshot.Time.UnixMilli(),
+			Requests:        snapshot.Requests,
+			Success:         snapshot.Success,
+			Errors:          snapshot.Errors,
+			LatencyBoundsMs: runner.LiveLatencyBounds,
+			LatencyBuckets:  snapshot.Buckets,
+			ActiveThreads:   int32(snapshot.ActiveThreads),
+		}); err != nil {
+			return fmt.Errorf("failed to send metrics: %w", err)
+		}
+	}
+}
+
+// StreamResources sends the resource usage of the slave to the master node on the interval
+func (s *Server) StreamResources(
+	req *pb.StreamResourcesRequest,
+	stream grpc.ServerStreamingServer[pb.StreamResourcesResponse],
+) error {
+	s.mu.RLock()
+	_, ok := s.slCtrMap[req.ConnectionId]
+	s.mu.RUnlock()
+	if !ok {
+		return ErrInvalidConnectionID
+	}
+	if req.IntervalMs <= 0 {
+		return fmt.Errorf("interval must be greater than 0")
+	}
+	sampler, err := runner.NewResourceSampler()
+	if err != nil {
+		return fmt.Errorf("failed to create resource sampler: %w", err)
+	}
+
+	ticker := time.NewTicker(time.Duration(req.IntervalMs) * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.globalCtx.Done():
+			return nil
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+		s.mu.RLock()
+		_, ok := s.slCtrMap[req.ConnectionId]
+		s.mu.RUnlock()
+		if !ok {
+			return nil
+		}
+
+		sample := sampler.Sample()
+		if err := stream.Send(&pb.StreamResourcesResponse{
+			TimestampMs:        sample.Time.UnixMilli(),
+			ProcessCpuPercent:  sample.ProcessCPUPercent,
+			HostCpuPercent:     sample.HostCPUPercent,
+			RssBytes:           sample.RSSBytes,
+			Goroutines:         int32(sample.Goroutines),
+			OpenFds:            int32(sample.OpenFDs),
+			NetSentBytesPerSec: sample.NetSentBytesPerSec,
+			NetRecvBytesPerSec: sample.NetRecvBytesPerSec,
+		}); err != nil {
+			return fmt.Errorf("failed to send resource usage: %w", err)
+		}
+	}
+}
@@ -5,47 +5,75 @@ import (
 	"fmt"
 	"net"
 
-	rpc "buf.build/gen/go/cresplanex/bloader/grpc/go/cresplanex/bloader/v1/bloaderv1grpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
+	"github.com/ablankz/bloader/internal/config"
 	"github.com/ablankz/bloader/internal/container"
 	"github.com/ablankz/bloader/internal/logger"
+	"github.com/ablankz/bloader/internal/metrics"
 	"github.com/ablankz/bloader/internal/runner"
+	"github.com/ablankz/bloader/internal/tracing"
+	"github.com/ablankz/bloader/internal/utils"
 )
 
 // Run runs the slave node
 func Run(ctr *container.Container) error {
 	var grpcServerOptions []grpc.ServerOption
 	if ctr.Config.SlaveSetting.Certificate.Enabled {
-		creds, err := credentials.NewServerTLSFromFile(
-			ctr.Config.SlaveSetting.Certificate.SlaveCert,
-			ctr.Config.SlaveSetting.Certificate.SlaveKey,
-		)
+		creds, err := serverCredentials(ctr.Config.SlaveSetting.Certificate)
 		if err != nil {
 			return fmt.Errorf("failed to load certificate: %w", err)
 		}
 		grpcServerOptions = append(grpcServerOptions, grpc.Creds(creds))
 	}
 
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+	if ctr.Config.SlaveSetting.Auth.Enabled {
+		unaryInterceptors = append(unaryInterceptors, UnaryServerTokenInterceptor(ctr.Config.SlaveSetting.Auth.Token))
+		streamInterceptors = append(streamInterceptors, StreamServerTokenInterceptor(ctr.Config.SlaveSetting.Auth.Token))
+	}
 	if ctr.Config.SlaveSetting.Encrypt.Enabled {
 		encrypter, ok := ctr.EncypterContainer[ctr.Config.SlaveSetting.Encrypt.EncryptID]
 		if !ok {
 			return fmt.Errorf("encrypter not found: %s", ctr.Config.SlaveSetting.Encrypt.EncryptID)
 		}
-		grpcServerOptions = append(
-			grpcServerOptions,
-			grpc.UnaryInterceptor(UnaryServerEncryptInterceptor(encrypter)),
-			grpc.StreamInterceptor(StreamServerInterceptor(encrypter)),
-		)
+		unaryInterceptors = append(unaryInterceptors, UnaryServerEncryptInterceptor(encrypter))
+		streamInterceptors = append(streamInterceptors, StreamServerInterceptor(encrypter))
 	}
+	grpcServerOptions = append(
+		grpcServerOptions,
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 
 	grpcServer := grpc.NewServer(grpcServerOptions...)
 
-	slCtr := runner.NewConnectionContainer()
+	slCtr := runner.NewConnectionContainer(nil, nil)
 	defer slCtr.AllDisconnect(ctr.Ctx)
 
-	rpc.RegisterBloaderSlaveServiceServer(grpcServer, NewServer(ctr, slCtr))
+	if ctr.Config.Tracing.Enabled {
+		stopTracing, err := tracing.Start(ctr.Ctx, ctr.Logger, ctr.Config.Tracing, runner.BuildVersion)
+		if err != nil {
+			return fmt.Errorf("failed to start the tracing: %w", err)
+		}
+		defer stopTracing()
+	}
+
+	var m *metrics.Metrics
+	if ctr.Config.SlaveSetting.Metrics.Active() {
+		var stopMetrics func()
+		var err error
+		m, stopMetrics, err = metrics.Start(ctr.Ctx, ctr.Logger, ctr.Config.SlaveSetting.Metrics)
+		if err != nil {
+			return fmt.Errorf("failed to start the metrics: %w", err)
+		}
+		defer stopMetrics()
+	}
+
+	pb.RegisterBloaderSlaveServiceServer(grpcServer, NewServer(ctr, slCtr, m))
 	lister, err := net.Listen("tcp", fmt.Sprintf(":%d", ctr.Config.SlaveSetting.Port))
 	if err != nil {
 		return fmt.Errorf("failed to listen: %w", err)
@@ -54,6 +82,12 @@ func Run(ctr *container.Container) error {
 	ctr.Logger.Info(ctr.Ctx, "Starting the worker node",
 		logger.Value("port", ctr.Config.SlaveSetting.Port))
 
+	if ctr.Config.SlaveSetting.Register.Enabled {
+		if err := runRegistration(ctr.Ctx, ctr.Logger, ctr.Config.SlaveSetting.Register); err != nil {
+			return fmt.Errorf("failed to register on master: %w", err)
+		}
+	}
+
 	go func() {
 		<-ctr.Ctx.Done()
 		ctr.Logger.Info(ctr.Ctx, "Shutting down the worker node")
@@ -66,3 +100,13 @@ func Run(ctr *container.Container) error {
 
 	return nil
 }
+
+// serverCredentials loads the certificate of the slave.
+// When the client CA is set, the master must present the client certificate signed by it.
+func serverCredentials(conf config.ValidSlaveCertificateConfig) (credentials.TransportCredentials, error) {
+	tlsConfig, err := utils.ServerTLSConfig(conf.SlaveCert, conf.SlaveKey, conf.ClientCACert)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
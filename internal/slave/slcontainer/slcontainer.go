@@ -4,6 +4,8 @@ package slcontainer
 import (
 	"fmt"
 	"sync"
+
+	"github.com/ablankz/bloader/internal/runner"
 )
 
 // SlaveContainer represents the container for the slave node
@@ -14,6 +16,9 @@ type SlaveContainer struct {
 	Loader                        *Loader
 	CommandMap                    *sync.Map
 	ReceiveChanelRequestContainer *ReceiveChanelRequestContainer
+	Metrics                       *runner.LiveMetrics
+	// TypedOutput represents whether the master reads the http data with the types of the values
+	TypedOutput bool
 }
 
 // NewSlaveContainer creates a new container for the slave node
@@ -25,6 +30,7 @@ func NewSlaveContainer() *SlaveContainer {
 		Loader:                        NewLoader(),                        // DON'T CHANGE POINTER TO VALUE
 		CommandMap:                    &sync.Map{},                        // DON'T CHANGE POINTER TO VALUE
 		ReceiveChanelRequestContainer: NewReceiveChanelRequestContainer(), // DON'T CHANGE POINTER TO VALUE
+		Metrics:                       runner.NewLiveMetrics(),            // DON'T CHANGE POINTER TO VALUE
 	}
 }
 
@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"sync"
 
-	pb "buf.build/gen/go/cresplanex/bloader/protocolbuffers/go/cresplanex/bloader/v1"
 	"google.golang.org/protobuf/proto"
 
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
 	"github.com/ablankz/bloader/internal/runner"
 	"github.com/ablankz/bloader/internal/utils"
 )
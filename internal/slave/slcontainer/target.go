@@ -4,8 +4,7 @@ import (
 	"fmt"
 	"sync"
 
-	pb "buf.build/gen/go/cresplanex/bloader/protocolbuffers/go/cresplanex/bloader/v1"
-
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
 	"github.com/ablankz/bloader/internal/config"
 	"github.com/ablankz/bloader/internal/target"
 )
@@ -58,6 +57,24 @@ func (t Target) AddFromProto(id string, pbT *pb.Target) error {
 			URL:  pbT.GetHttp().Url,
 		})
 		return nil
+	case pb.TargetType_TARGET_TYPE_GRPC:
+		t.Add(id, target.Target{
+			Type: config.TargetTypeGRPC,
+			URL:  pbT.GetGrpc().Url,
+		})
+		return nil
+	case pb.TargetType_TARGET_TYPE_TCP:
+		t.Add(id, target.Target{
+			Type: config.TargetTypeTCP,
+			URL:  pbT.GetTcp().Url,
+		})
+		return nil
+	case pb.TargetType_TARGET_TYPE_UDP:
+		t.Add(id, target.Target{
+			Type: config.TargetTypeUDP,
+			URL:  pbT.GetUdp().Url,
+		})
+		return nil
 	case pb.TargetType_TARGET_TYPE_UNSPECIFIED:
 		return fmt.Errorf("invalid target type: %v", pbT.Type)
 	}
@@ -2,8 +2,7 @@
 package target
 
 import (
-	pb "buf.build/gen/go/cresplanex/bloader/protocolbuffers/go/cresplanex/bloader/v1"
-
+	pb "github.com/ablankz/bloader/gen/cresplanex/bloader/v1"
 	"github.com/ablankz/bloader/internal/config"
 )
 
@@ -27,6 +26,33 @@ func (t Target) GetTarget() *pb.Target {
 				},
 			},
 		}
+	case config.TargetTypeGRPC:
+		return &pb.Target{
+			Type: pb.TargetType_TARGET_TYPE_GRPC,
+			Target: &pb.Target_Grpc{
+				Grpc: &pb.TargetGRPCData{
+					Url: t.URL,
+				},
+			},
+		}
+	case config.TargetTypeTCP:
+		return &pb.Target{
+			Type: pb.TargetType_TARGET_TYPE_TCP,
+			Target: &pb.Target_Tcp{
+				Tcp: &pb.TargetTCPData{
+					Url: t.URL,
+				},
+			},
+		}
+	case config.TargetTypeUDP:
+		return &pb.Target{
+			Type: pb.TargetType_TARGET_TYPE_UDP,
+			Target: &pb.Target_Udp{
+				Udp: &pb.TargetUDPData{
+					Url: t.URL,
+				},
+			},
+		}
 	}
 
 	return nil
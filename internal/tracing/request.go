@@ -0,0 +1,101 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// FlowIDKey represents the attribute of the ID of the flow step running the loader
+	FlowIDKey = attribute.Key("bloader.flow_id")
+	// LoaderKey represents the attribute of the loader file of the runner
+	LoaderKey = attribute.Key("bloader.loader")
+	// RequestIndexKey represents the attribute of the index of the request in the runner
+	RequestIndexKey = attribute.Key("bloader.request_index")
+	// CountKey represents the attribute of the loop count of the request
+	CountKey = attribute.Key("bloader.count")
+	// VUIDKey represents the attribute of the ID of the virtual user sending the request
+	VUIDKey = attribute.Key("bloader.vu_id")
+)
+
+// StartRequestSpan starts the client span of the request, and injects the traceparent header of the span.
+// The span is a child of the one in ctx, and is carried by the context of the returned request,
+// which keeps the cancellation of the context of req. The span is ended by Do.
+func StartRequestSpan(ctx context.Context, req *http.Request, attrs ...attribute.KeyValue) *http.Request {
+	ctx, span := Tracer().Start(ctx, req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.URLFull(req.URL.String()),
+			semconv.ServerAddress(req.URL.Hostname()),
+		),
+		trace.WithAttributes(attrs...),
+	)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+	return req.WithContext(trace.ContextWithSpan(req.Context(), span))
+}
+
+// Do sends the request with the client.
+// The span of the request is ended when the body of the response is read to the end or closed.
+func Do(client *http.Client, req *http.Request) (*http.Response, error) {
+	span := trace.SpanFromContext(req.Context())
+	resp, err := client.Do(req)
+	if !span.IsRecording() {
+		return resp, err
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
+		return resp, err
+	}
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+	resp.Body = &spanBody{ReadCloser: resp.Body, span: span, once: &sync.Once{}}
+	return resp, nil
+}
+
+// spanBody represents the body of the response ending the span
+type spanBody struct {
+	io.ReadCloser
+	span trace.Span
+	once *sync.Once
+}
+
+// Read reads the body, and ends the span at the end of it
+func (b *spanBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		b.end(err)
+	}
+	return n, err
+}
+
+// Close closes the body, and ends the span
+func (b *spanBody) Close() error {
+	b.end(nil)
+	return b.ReadCloser.Close()
+}
+
+// end ends the span once, with the error of the read other than the end of the body
+func (b *spanBody) end(err error) {
+	b.once.Do(func() {
+		if err != nil && !errors.Is(err, io.EOF) {
+			b.span.RecordError(err)
+			b.span.SetStatus(codes.Error, err.Error())
+		}
+		b.span.End()
+	})
+}
@@ -0,0 +1,150 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// setupTestTracer sets the tracer provider exporting to the returned in-memory exporter,
+// and restores the global provider and propagator at the end of the test
+func setupTestTracer(t *testing.T) *tracetest.InMemoryExporter {
+	t.Helper()
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		_ = provider.Shutdown(context.Background())
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+	return exporter
+}
+
+// TestStartRequestSpan tests the traceparent header sent to the server, the attributes of the span
+// and the end of the span on the end of the body and on its close.
+func TestStartRequestSpan(t *testing.T) {
+	exporter := setupTestTracer(t)
+	traceparents := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparents <- r.Header.Get("traceparent")
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		_, _ = w.Write([]byte("body"))
+	}))
+	defer srv.Close()
+
+	tests := []struct {
+		name       string
+		path       string
+		consume    func(body io.ReadCloser) error
+		wantStatus int
+	}{
+		{
+			name: "ReadToEOF",
+			path: "/ok",
+			consume: func(body io.ReadCloser) error {
+				_, err := io.ReadAll(body)
+				return err
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name: "Close",
+			path: "/missing",
+			consume: func(body io.ReadCloser) error {
+				return body.Close()
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			exporter.Reset()
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, srv.URL+test.path, nil)
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			req = StartRequestSpan(context.Background(), req,
+				FlowIDKey.String("flow1"),
+				LoaderKey.String("loader.yaml"),
+				RequestIndexKey.Int(i),
+				CountKey.Int(3),
+			)
+			sc := trace.SpanContextFromContext(req.Context())
+			if !sc.IsValid() {
+				tt.Fatalf("expected the request to carry the span")
+			}
+
+			resp, err := Do(http.DefaultClient, req)
+			if err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+			defer resp.Body.Close()
+
+			want := "00-" + sc.TraceID().String() + "-" + sc.SpanID().String() + "-01"
+			if got := <-traceparents; got != want {
+				tt.Errorf("expected %v, got %v", want, got)
+			}
+			if got := len(exporter.GetSpans()); got != 0 {
+				tt.Errorf("expected the span not to end before the body, got %v spans", got)
+			}
+			if err := test.consume(resp.Body); err != nil {
+				tt.Fatalf("unexpected error: %v", err)
+			}
+
+			spans := exporter.GetSpans()
+			if len(spans) != 1 {
+				tt.Fatalf("expected %v, got %v", 1, len(spans))
+			}
+			span := spans[0]
+			if span.SpanKind != trace.SpanKindClient {
+				tt.Errorf("expected %v, got %v", trace.SpanKindClient, span.SpanKind)
+			}
+			attrs := make(map[attribute.Key]attribute.Value)
+			for _, kv := range span.Attributes {
+				attrs[kv.Key] = kv.Value
+			}
+			for key, want := range map[attribute.Key]attribute.Value{
+				FlowIDKey:                   attribute.StringValue("flow1"),
+				LoaderKey:                   attribute.StringValue("loader.yaml"),
+				RequestIndexKey:             attribute.IntValue(i),
+				CountKey:                    attribute.IntValue(3),
+				"http.response.status_code": attribute.IntValue(test.wantStatus),
+			} {
+				if got := attrs[key]; got != want {
+					tt.Errorf("%s: expected %v, got %v", key, want.Emit(), got.Emit())
+				}
+			}
+		})
+	}
+}
+
+// TestStartRequestSpanCancel tests that the returned request keeps the cancellation of its own context.
+func TestStartRequestSpanCancel(t *testing.T) {
+	setupTestTracer(t)
+	reqCtx, cancel := context.WithCancel(context.Background())
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodGet, "http://127.0.0.1", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req = StartRequestSpan(context.Background(), req)
+	cancel()
+	if err := req.Context().Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+}
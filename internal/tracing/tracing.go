@@ -0,0 +1,132 @@
+// Package tracing provides the OpenTelemetry traces of the requests sent by the node.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/ablankz/bloader/internal/config"
+	"github.com/ablankz/bloader/internal/logger"
+)
+
+// tracerName represents the name of the tracer creating the spans of the requests
+const tracerName = "github.com/ablankz/bloader"
+
+// shutdownTimeout represents the time to wait for the export of the remaining spans on the shutdown
+const shutdownTimeout = 10 * time.Second
+
+// otlpHTTPTracesPath represents the default path of the traces on the OTLP over HTTP
+const otlpHTTPTracesPath = "/v1/traces"
+
+// Start sets up the tracer provider of the configuration as the global one, with the W3C trace context propagator.
+// The returned function flushes the spans not exported yet and shuts the provider down, and is called when the run ends.
+func Start(
+	ctx context.Context,
+	log logger.Logger,
+	conf config.ValidTracingConfig,
+	version string,
+) (func(), error) {
+	exporter, closer, err := newExporter(ctx, conf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create exporter: %w", err)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(conf.ServiceName),
+		semconv.ServiceVersion(version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(conf.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	log.Info(ctx, "Starting the tracing",
+		logger.Value("exporter", conf.Exporter), logger.Value("sampleRatio", conf.SampleRatio))
+
+	return func() {
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+		defer cancel()
+		if err := provider.Shutdown(shutdownCtx); err != nil {
+			log.Warn(ctx, "failed to shut down the tracer provider",
+				logger.Value("error", err), logger.Value("on", "Start"))
+		}
+		if closer != nil {
+			if err := closer(); err != nil {
+				log.Warn(ctx, "failed to close the trace file",
+					logger.Value("error", err), logger.Value("on", "Start"))
+			}
+		}
+	}, nil
+}
+
+// newExporter creates the exporter of the configuration, the closer is not nil for the file exporter
+func newExporter(ctx context.Context, conf config.ValidTracingConfig) (sdktrace.SpanExporter, func() error, error) {
+	switch conf.Exporter {
+	case config.TracingExporterTypeFile:
+		if err := os.MkdirAll(filepath.Dir(conf.File.Path), 0o755); err != nil {
+			return nil, nil, fmt.Errorf("failed to create directory: %w", err)
+		}
+		f, err := os.OpenFile(filepath.Clean(conf.File.Path), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open file: %w", err)
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			_ = f.Close()
+			return nil, nil, err
+		}
+		return exporter, f.Close, nil
+	case config.TracingExporterTypeOTLP:
+		if conf.OTLP.Protocol == config.TracingOTLPProtocolGRPC {
+			opts := []otlptracegrpc.Option{otlptracegrpc.WithHeaders(conf.OTLP.Headers)}
+			if conf.OTLP.Endpoint != "" {
+				opts = append(opts, otlptracegrpc.WithEndpointURL(conf.OTLP.Endpoint))
+			}
+			exporter, err := otlptracegrpc.New(ctx, opts...)
+			return exporter, nil, err
+		}
+		opts := []otlptracehttp.Option{otlptracehttp.WithHeaders(conf.OTLP.Headers)}
+		if conf.OTLP.Endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(otlpHTTPEndpoint(conf.OTLP.Endpoint)))
+		}
+		exporter, err := otlptracehttp.New(ctx, opts...)
+		return exporter, nil, err
+	}
+	return nil, nil, fmt.Errorf("unsupported exporter: %s", conf.Exporter)
+}
+
+// otlpHTTPEndpoint returns the endpoint with the default path of the traces when it has no path
+func otlpHTTPEndpoint(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || strings.Trim(u.Path, "/") != "" {
+		return endpoint
+	}
+	u.Path = otlpHTTPTracesPath
+	return u.String()
+}
+
+// Tracer returns the tracer of the requests, which creates no span until the provider is started
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}